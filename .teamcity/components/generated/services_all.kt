@@ -59,6 +59,7 @@ val services = mapOf(
     "dlm" to ServiceSpec("DLM (Data Lifecycle Manager)"),
     "dms" to ServiceSpec("DMS (Database Migration)", vpcLock = true),
     "docdb" to ServiceSpec("DocDB (DocumentDB)", vpcLock = true),
+    "drs" to ServiceSpec("DRS (Elastic Disaster Recovery)"),
     "ds" to ServiceSpec("DS (Directory Service)", vpcLock = true),
     "dynamodb" to ServiceSpec("DynamoDB"),
     "ec2" to ServiceSpec("EC2 (Elastic Compute Cloud)", vpcLock = true),
@@ -112,6 +113,7 @@ val services = mapOf(
     "lightsail" to ServiceSpec("Lightsail"),
     "location" to ServiceSpec("Location"),
     "logs" to ServiceSpec("CloudWatch Logs"),
+    "m2" to ServiceSpec("Mainframe Modernization", vpcLock = true),
     "macie" to ServiceSpec("Macie Classic"),
     "macie2" to ServiceSpec("Macie"),
     "mediaconnect" to ServiceSpec("Elemental MediaConnect"),
@@ -131,6 +133,7 @@ val services = mapOf(
     "outposts" to ServiceSpec("Outposts"),
     "pinpoint" to ServiceSpec("Pinpoint"),
     "pricing" to ServiceSpec("Pricing Calculator"),
+    "proton" to ServiceSpec("Proton"),
     "qldb" to ServiceSpec("QLDB (Quantum Ledger Database)"),
     "quicksight" to ServiceSpec("QuickSight"),
     "ram" to ServiceSpec("RAM (Resource Access Manager)"),
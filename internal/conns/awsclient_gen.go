@@ -189,6 +189,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/lookoutequipment"
 	"github.com/aws/aws-sdk-go/service/lookoutforvision"
 	"github.com/aws/aws-sdk-go/service/lookoutmetrics"
+	"github.com/aws/aws-sdk-go/service/m2"
 	"github.com/aws/aws-sdk-go/service/machinelearning"
 	"github.com/aws/aws-sdk-go/service/macie"
 	"github.com/aws/aws-sdk-go/service/macie2"
@@ -329,7 +330,8 @@ type AWSClient struct {
 	SupportedPlatforms        []string
 	TerraformVersion          string
 
-	ssmClient lazyClient[*ssm_sdkv2.Client]
+	regionalClients regionalClients
+	ssmClient       lazyClient[*ssm_sdkv2.Client]
 
 	ACMConn                          *acm.ACM
 	ACMPCAConn                       *acmpca.ACMPCA
@@ -507,6 +509,7 @@ type AWSClient struct {
 	LookoutEquipmentConn             *lookoutequipment.LookoutEquipment
 	LookoutMetricsConn               *lookoutmetrics.LookoutMetrics
 	LookoutVisionConn                *lookoutforvision.LookoutForVision
+	M2Conn                           *m2.M2
 	MQConn                           *mq.MQ
 	MTurkConn                        *mturk.MTurk
 	MWAAConn                         *mwaa.MWAA
@@ -75,6 +75,7 @@ type Config struct {
 	MaxRetries                     int
 	Profile                        string
 	Region                         string
+	S3UsEast1RegionalEndpoint      string
 	S3UsePathStyle                 bool
 	SecretKey                      string
 	SharedConfigFiles              []string
@@ -333,6 +334,15 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		S3ForcePathStyle: aws.Bool(c.S3UsePathStyle),
 	}
 
+	if c.S3UsEast1RegionalEndpoint != "" {
+		v, err := endpoints.GetS3UsEast1RegionalEndpoint(c.S3UsEast1RegionalEndpoint)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		s3Config.S3UsEast1RegionalEndpoint = v
+	}
+
 	client.S3Conn = s3.New(sess.Copy(s3Config))
 
 	s3Config.DisableRestProtocolURICleaning = aws.Bool(true)
@@ -631,5 +641,28 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		}
 	}
 
+	client.regionalClients.init(func(ctx context.Context, region string) (*AWSClient, diag.Diagnostics) {
+		config := *c
+		config.Region = region
+
+		regionalClient, diags := config.ConfigureProvider(ctx, &AWSClient{ServicePackages: client.ServicePackages})
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		for _, v := range regionalClient.ServicePackages {
+			if err := v.Configure(ctx, regionalClient); err != nil {
+				diags = append(diags, diag.FromErr(err)...)
+			}
+		}
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return regionalClient, diags
+	})
+
 	return client, nil
 }
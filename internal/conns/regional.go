@@ -0,0 +1,61 @@
+package conns
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+type regionalClientInitFunc func(context.Context, string) (*AWSClient, diag.Diagnostics)
+
+// regionalClients caches provider Meta (instance data) configured for Regions
+// other than the provider's own.
+type regionalClients struct {
+	initf regionalClientInitFunc
+
+	mu      sync.Mutex
+	clients map[string]*AWSClient
+}
+
+func (r *regionalClients) init(f regionalClientInitFunc) {
+	r.initf = f
+}
+
+func (r *regionalClients) client(ctx context.Context, region string) (*AWSClient, diag.Diagnostics) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if client, ok := r.clients[region]; ok {
+		return client, nil
+	}
+
+	client, diags := r.initf(ctx, region)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if r.clients == nil {
+		r.clients = make(map[string]*AWSClient)
+	}
+	r.clients[region] = client
+
+	return client, diags
+}
+
+// RegionalClient returns the provider Meta (instance data) for the specified Region.
+// The receiver is returned if the Region is empty or is the provider's configured Region.
+// Otherwise a client configured identically to the receiver except for its Region is
+// created on first use and cached for the lifetime of the provider.
+func (client *AWSClient) RegionalClient(ctx context.Context, region string) (*AWSClient, diag.Diagnostics) {
+	if region == "" || region == client.Region {
+		return client, nil
+	}
+
+	if client.regionalClients.initf == nil {
+		return nil, diag.Errorf("configuring provider for Region (%s): provider is not configured", region)
+	}
+
+	return client.regionalClients.client(ctx, region)
+}
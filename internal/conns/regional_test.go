@@ -0,0 +1,68 @@
+package conns
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestAWSClientRegionalClient(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	ctx := context.Background()
+	calls := 0
+	client := &AWSClient{Region: "us-west-2"}
+	client.regionalClients.init(func(_ context.Context, region string) (*AWSClient, diag.Diagnostics) {
+		calls++
+		if region == "invalid" {
+			return nil, diag.Errorf("invalid Region: %s", region)
+		}
+		return &AWSClient{Region: region}, nil
+	})
+
+	for _, region := range []string{"", "us-west-2"} {
+		got, diags := client.RegionalClient(ctx, region)
+
+		if diags.HasError() {
+			t.Fatalf("unexpected error for Region %q: %v", region, diags)
+		}
+		if got != client {
+			t.Errorf("expected provider client for Region %q", region)
+		}
+	}
+
+	got, diags := client.RegionalClient(ctx, "eu-west-1")
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if got.Region != "eu-west-1" {
+		t.Errorf("got Region %s, expected eu-west-1", got.Region)
+	}
+
+	again, _ := client.RegionalClient(ctx, "eu-west-1")
+
+	if again != got {
+		t.Error("expected cached client for Region eu-west-1")
+	}
+	if calls != 1 {
+		t.Errorf("got %d client configurations, expected 1", calls)
+	}
+
+	if _, diags := client.RegionalClient(ctx, "invalid"); !diags.HasError() {
+		t.Error("expected error for invalid Region")
+	}
+	if _, diags := client.RegionalClient(ctx, "invalid"); !diags.HasError() {
+		t.Error("expected error for invalid Region on retry")
+	}
+	if calls != 3 {
+		t.Errorf("got %d client configurations, expected 3", calls)
+	}
+}
+
+func TestAWSClientRegionalClientUnconfigured(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	client := &AWSClient{Region: "us-west-2"}
+
+	if _, diags := client.RegionalClient(context.Background(), "eu-west-1"); !diags.HasError() {
+		t.Error("expected error for unconfigured provider")
+	}
+}
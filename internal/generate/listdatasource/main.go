@@ -0,0 +1,398 @@
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"bytes"
+	_ "embed"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/tools/go/packages"
+)
+
+const (
+	defaultFilename = "list_data_source_gen.go"
+)
+
+var (
+	sdkTypes   = flag.String("Types", "", "comma-separated list of AWS Go SDK structure types")
+	skipFields = flag.String("SkipFields", "", "comma-separated list of <type>.<field> structure fields to skip")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags] [<generated-data-source-file>]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+type HeaderInfo struct {
+	Parameters         string
+	DestinationPackage string
+	SourcePackage      string
+	NeedsTime          bool
+}
+
+// Attribute kinds understood by the struct template.
+const (
+	kindBool       = "Bool"
+	kindFloat      = "Float"
+	kindInt        = "Int"
+	kindIntList    = "IntList"
+	kindString     = "String"
+	kindStringList = "StringList"
+	kindStringMap  = "StringMap"
+	kindStruct     = "Struct"
+	kindStructList = "StructList"
+	kindTime       = "Time"
+)
+
+type AttributeSpec struct {
+	Name      string // Terraform attribute name.
+	FieldName string // AWS Go SDK structure field name.
+	Kind      string
+	ElemType  string // AWS Go SDK structure type name for Struct and StructList kinds.
+	Sensitive bool
+}
+
+type StructSpec struct {
+	Package    string
+	Name       string
+	Attributes []AttributeSpec
+}
+
+type Generator struct {
+	buf        bytes.Buffer
+	pkgName    string
+	sdkStructs map[string]*ast.StructType
+	structs    map[string]*StructSpec
+	inFlight   map[string]bool
+	skipFields map[string]bool
+	needsTime  bool
+}
+
+func main() {
+	log.SetPrefix("generate/listdatasource: ")
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	filename := defaultFilename
+	if args := flag.Args(); len(args) > 0 {
+		filename = args[0]
+	}
+
+	if *sdkTypes == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	wd, err := os.Getwd()
+
+	if err != nil {
+		log.Fatalf("unable to get working directory: %s", err)
+	}
+
+	servicePackage := filepath.Base(wd)
+	log.SetPrefix(fmt.Sprintf("generate/listdatasource: %s: ", servicePackage))
+
+	awsService, err := names.AWSGoV1Package(servicePackage)
+
+	if err != nil {
+		log.Fatalf("encountered: %s", err)
+	}
+
+	sourcePackage := fmt.Sprintf("github.com/aws/aws-sdk-go/service/%s", awsService)
+
+	g := Generator{
+		structs:    make(map[string]*StructSpec),
+		inFlight:   make(map[string]bool),
+		skipFields: make(map[string]bool),
+	}
+
+	if *skipFields != "" {
+		for _, v := range strings.Split(*skipFields, ",") {
+			g.skipFields[v] = true
+		}
+	}
+
+	g.parsePackage(sourcePackage)
+
+	for _, typeName := range strings.Split(*sdkTypes, ",") {
+		g.addStruct(typeName)
+	}
+
+	g.printHeader(HeaderInfo{
+		Parameters:         strings.Join(os.Args[1:], " "),
+		DestinationPackage: servicePackage,
+		SourcePackage:      sourcePackage,
+		NeedsTime:          g.needsTime,
+	})
+
+	g.printStructs()
+
+	src := g.format()
+
+	err = os.WriteFile(filename, src, 0644)
+	if err != nil {
+		log.Fatalf("error writing output: %s", err)
+	}
+}
+
+func (g *Generator) parsePackage(sourcePackage string) {
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles,
+	}
+	pkgs, err := packages.Load(cfg, sourcePackage)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(pkgs) != 1 {
+		log.Fatalf("error: %d packages found", len(pkgs))
+	}
+
+	g.pkgName = pkgs[0].Name
+	g.sdkStructs = make(map[string]*ast.StructType)
+
+	fset := token.NewFileSet()
+
+	for _, filename := range pkgs[0].GoFiles {
+		file, err := parser.ParseFile(fset, filename, nil, 0)
+
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				if typeSpec, ok := spec.(*ast.TypeSpec); ok {
+					if structType, ok := typeSpec.Type.(*ast.StructType); ok {
+						g.sdkStructs[typeSpec.Name.Name] = structType
+					}
+				}
+			}
+		}
+	}
+}
+
+// addStruct records the attributes of the named AWS Go SDK structure and of any structures nested within it.
+func (g *Generator) addStruct(typeName string) {
+	if _, ok := g.structs[typeName]; ok {
+		return
+	}
+
+	structType, ok := g.sdkStructs[typeName]
+
+	if !ok {
+		log.Fatalf("structure type %q not found", typeName)
+	}
+
+	g.inFlight[typeName] = true
+	defer delete(g.inFlight, typeName)
+
+	spec := &StructSpec{
+		Package: g.pkgName,
+		Name:    typeName,
+	}
+
+	for _, field := range structType.Fields.List {
+		if len(field.Names) != 1 || !field.Names[0].IsExported() {
+			continue
+		}
+
+		fieldName := field.Names[0].Name
+		var tag reflect.StructTag
+
+		if field.Tag != nil {
+			v, err := strconv.Unquote(field.Tag.Value)
+
+			if err != nil {
+				log.Fatalf("parsing %s.%s tag: %s", typeName, fieldName, err)
+			}
+
+			tag = reflect.StructTag(v)
+		}
+
+		if tag.Get("deprecated") == "true" || g.skipFields[typeName+"."+fieldName] {
+			continue
+		}
+
+		attr, ok := g.attribute(fieldName, field.Type)
+
+		if !ok {
+			log.Printf("skipping %s.%s: unsupported type", typeName, fieldName)
+			continue
+		}
+
+		attr.Sensitive = tag.Get("sensitive") == "true"
+		spec.Attributes = append(spec.Attributes, attr)
+	}
+
+	sort.Slice(spec.Attributes, func(i, j int) bool {
+		return spec.Attributes[i].Name < spec.Attributes[j].Name
+	})
+
+	g.structs[typeName] = spec
+}
+
+func (g *Generator) attribute(fieldName string, expr ast.Expr) (AttributeSpec, bool) {
+	attr := AttributeSpec{
+		Name:      tftags.ToSnakeCase(fieldName),
+		FieldName: fieldName,
+	}
+
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		if kind := basicKind(t.X); kind != "" {
+			attr.Kind = kind
+			return attr, true
+		}
+
+		if sel, ok := t.X.(*ast.SelectorExpr); ok {
+			if pkg, ok := sel.X.(*ast.Ident); ok && pkg.Name == "time" && sel.Sel.Name == "Time" {
+				g.needsTime = true
+				attr.Kind = kindTime
+				return attr, true
+			}
+		}
+
+		if name, ok := g.nestedStruct(t.X); ok {
+			attr.Kind = kindStruct
+			attr.ElemType = name
+			return attr, true
+		}
+
+	case *ast.ArrayType:
+		star, ok := t.Elt.(*ast.StarExpr)
+
+		if t.Len != nil || !ok {
+			break
+		}
+
+		switch basicKind(star.X) {
+		case kindString:
+			attr.Kind = kindStringList
+			return attr, true
+		case kindInt:
+			attr.Kind = kindIntList
+			return attr, true
+		}
+
+		if name, ok := g.nestedStruct(star.X); ok {
+			attr.Kind = kindStructList
+			attr.ElemType = name
+			return attr, true
+		}
+
+	case *ast.MapType:
+		if star, ok := t.Value.(*ast.StarExpr); ok && basicKind(t.Key) == kindString && basicKind(star.X) == kindString {
+			attr.Kind = kindStringMap
+			return attr, true
+		}
+	}
+
+	return attr, false
+}
+
+// nestedStruct adds a structure type from the source package and returns its name.
+// Self-referencing structures are not supported as the schema would be infinitely deep.
+func (g *Generator) nestedStruct(expr ast.Expr) (string, bool) {
+	ident, ok := expr.(*ast.Ident)
+
+	if !ok {
+		return "", false
+	}
+
+	if _, ok := g.sdkStructs[ident.Name]; !ok || g.inFlight[ident.Name] {
+		return "", false
+	}
+
+	g.addStruct(ident.Name)
+
+	return ident.Name, true
+}
+
+// basicKind returns the attribute kind of an AWS Go SDK scalar type, as used in the pointer fields of structures.
+func basicKind(expr ast.Expr) string {
+	ident, ok := expr.(*ast.Ident)
+
+	if !ok {
+		return ""
+	}
+
+	switch ident.Name {
+	case "bool":
+		return kindBool
+	case "float64":
+		return kindFloat
+	case "int64":
+		return kindInt
+	case "string":
+		return kindString
+	}
+
+	return ""
+}
+
+//go:embed header.tmpl
+var headerTemplate string
+
+//go:embed struct.tmpl
+var structTemplate string
+
+func (g *Generator) printHeader(headerInfo HeaderInfo) {
+	header := template.Must(template.New("header").Parse(headerTemplate))
+	err := header.Execute(&g.buf, headerInfo)
+	if err != nil {
+		log.Fatalf("error writing header: %s", err)
+	}
+}
+
+func (g *Generator) printStructs() {
+	tmpl := template.Must(template.New("struct").Parse(structTemplate))
+
+	names := make([]string, 0, len(g.structs))
+	for name := range g.structs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		err := tmpl.Execute(&g.buf, g.structs[name])
+		if err != nil {
+			log.Fatalf("error writing structure %q: %s", name, err)
+		}
+	}
+}
+
+func (g *Generator) format() []byte {
+	src, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		log.Printf("warning: internal error: invalid Go generated: %s", err)
+		log.Printf("warning: compile the package to analyze the error")
+		return g.buf.Bytes()
+	}
+	return src
+}
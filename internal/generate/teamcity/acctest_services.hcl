@@ -95,6 +95,10 @@ service "lambda" {
   vpc_lock = true
 }
 
+service "m2" {
+  vpc_lock = true
+}
+
 service "mq" {
   vpc_lock = true
 }
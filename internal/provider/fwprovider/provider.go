@@ -96,6 +96,11 @@ func (p *fwprovider) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnost
 				Description:        "Set this to true to enable the request to use path-style addressing,\ni.e., https://s3.amazonaws.com/BUCKET/KEY. By default, the S3 client will\nuse virtual hosted bucket addressing when possible\n(https://BUCKET.s3.amazonaws.com/KEY). Specific to the Amazon S3 service.",
 				DeprecationMessage: "Use s3_use_path_style instead.",
 			},
+			"s3_us_east_1_regional_endpoint": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "Specifies whether S3 API calls in the `us-east-1` region use the legacy global\nendpoint or a regional endpoint. Valid values are `legacy` or `regional`.\nSpecific to the Amazon S3 service.",
+			},
 			"s3_use_path_style": {
 				Type:        types.BoolType,
 				Optional:    true,
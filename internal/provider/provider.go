@@ -75,6 +75,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dlm"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dms"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/docdb"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/drs"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ds"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
@@ -128,6 +129,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/location"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/logs"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/m2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/macie"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/macie2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
@@ -145,8 +147,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/opsworks"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/outposts"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/personalize"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pricing"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/proton"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/qldb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ram"
@@ -154,11 +158,14 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshiftdata"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshiftserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/rekognition"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/resiliencehub"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroups"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/rolesanywhere"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/route53"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/route53domains"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/route53recoverycluster"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/route53recoverycontrolconfig"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/route53recoveryreadiness"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/route53resolver"
@@ -192,6 +199,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/timestreamwrite"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/transcribe"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/transfer"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/translate"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/waf"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/wafregional"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/wafv2"
@@ -332,6 +340,14 @@ func New(_ context.Context) (*schema.Provider, error) {
 					"use virtual hosted bucket addressing when possible\n" +
 					"(https://BUCKET.s3.amazonaws.com/KEY). Specific to the Amazon S3 service.",
 			},
+			"s3_us_east_1_regional_endpoint": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Specifies whether S3 API calls in the `us-east-1` region use the legacy global\n" +
+					"endpoint or a regional endpoint. Valid values are `legacy` or `regional`.\n" +
+					"Specific to the Amazon S3 service.",
+				ValidateFunc: validation.StringInSlice([]string{"legacy", "regional"}, false),
+			},
 			"s3_use_path_style": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -570,6 +586,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_ec2_managed_prefix_lists":                   ec2.DataSourceManagedPrefixLists(),
 			"aws_ec2_network_insights_analysis":              ec2.DataSourceNetworkInsightsAnalysis(),
 			"aws_ec2_network_insights_path":                  ec2.DataSourceNetworkInsightsPath(),
+			"aws_ec2_network_path_analysis":                  ec2.DataSourceNetworkPathAnalysis(),
 			"aws_ec2_serial_console_access":                  ec2.DataSourceSerialConsoleAccess(),
 			"aws_ec2_spot_price":                             ec2.DataSourceSpotPrice(),
 			"aws_ec2_transit_gateway":                        ec2.DataSourceTransitGateway(),
@@ -743,6 +760,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_kms_ciphertext":       kms.DataSourceCiphertext(),
 			"aws_kms_custom_key_store": kms.DataSourceCustomKeyStore(),
 			"aws_kms_key":              kms.DataSourceKey(),
+			"aws_kms_keys":             kms.DataSourceKeys(),
 			"aws_kms_public_key":       kms.DataSourcePublicKey(),
 			"aws_kms_secret":           kms.DataSourceSecret(),
 			"aws_kms_secrets":          kms.DataSourceSecrets(),
@@ -755,6 +773,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_lambda_code_signing_config": lambda.DataSourceCodeSigningConfig(),
 			"aws_lambda_function_url":        lambda.DataSourceFunctionURL(),
 			"aws_lambda_function":            lambda.DataSourceFunction(),
+			"aws_lambda_functions":           lambda.DataSourceFunctions(),
 			"aws_lambda_invocation":          lambda.DataSourceInvocation(),
 			"aws_lambda_layer_version":       lambda.DataSourceLayerVersion(),
 
@@ -809,6 +828,8 @@ func New(_ context.Context) (*schema.Provider, error) {
 
 			"aws_outposts_asset":                  outposts.DataSourceOutpostAsset(),
 			"aws_outposts_assets":                 outposts.DataSourceOutpostAssets(),
+			"aws_outposts_order":                  outposts.DataSourceOrder(),
+			"aws_outposts_orders":                 outposts.DataSourceOrders(),
 			"aws_outposts_outpost":                outposts.DataSourceOutpost(),
 			"aws_outposts_outpost_instance_type":  outposts.DataSourceOutpostInstanceType(),
 			"aws_outposts_outpost_instance_types": outposts.DataSourceOutpostInstanceTypes(),
@@ -841,6 +862,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_rds_cluster":                    rds.DataSourceCluster(),
 			"aws_rds_clusters":                   rds.DataSourceClusters(),
 			"aws_rds_engine_version":             rds.DataSourceEngineVersion(),
+			"aws_rds_engine_versions":            rds.DataSourceEngineVersions(),
 			"aws_rds_orderable_db_instance":      rds.DataSourceOrderableInstance(),
 			"aws_rds_reserved_instance_offering": rds.DataSourceReservedOffering(),
 
@@ -877,6 +899,10 @@ func New(_ context.Context) (*schema.Provider, error) {
 
 			"aws_sagemaker_prebuilt_ecr_image": sagemaker.DataSourcePrebuiltECRImage(),
 
+			"aws_scheduler_schedules": scheduler.DataSourceSchedules(),
+
+			"aws_schemas_code_binding": schemas.DataSourceCodeBinding(),
+
 			"aws_secretsmanager_random_password": secretsmanager.DataSourceRandomPassword(),
 			"aws_secretsmanager_secret":          secretsmanager.DataSourceSecret(),
 			"aws_secretsmanager_secret_rotation": secretsmanager.DataSourceSecretRotation(),
@@ -998,18 +1024,19 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_api_gateway_usage_plan_key":        apigateway.ResourceUsagePlanKey(),
 			"aws_api_gateway_vpc_link":              apigateway.ResourceVPCLink(),
 
-			"aws_apigatewayv2_api":                  apigatewayv2.ResourceAPI(),
-			"aws_apigatewayv2_api_mapping":          apigatewayv2.ResourceAPIMapping(),
-			"aws_apigatewayv2_authorizer":           apigatewayv2.ResourceAuthorizer(),
-			"aws_apigatewayv2_deployment":           apigatewayv2.ResourceDeployment(),
-			"aws_apigatewayv2_domain_name":          apigatewayv2.ResourceDomainName(),
-			"aws_apigatewayv2_integration":          apigatewayv2.ResourceIntegration(),
-			"aws_apigatewayv2_integration_response": apigatewayv2.ResourceIntegrationResponse(),
-			"aws_apigatewayv2_model":                apigatewayv2.ResourceModel(),
-			"aws_apigatewayv2_route":                apigatewayv2.ResourceRoute(),
-			"aws_apigatewayv2_route_response":       apigatewayv2.ResourceRouteResponse(),
-			"aws_apigatewayv2_stage":                apigatewayv2.ResourceStage(),
-			"aws_apigatewayv2_vpc_link":             apigatewayv2.ResourceVPCLink(),
+			"aws_apigatewayv2_api":                       apigatewayv2.ResourceAPI(),
+			"aws_apigatewayv2_api_mapping":               apigatewayv2.ResourceAPIMapping(),
+			"aws_apigatewayv2_authorizer":                apigatewayv2.ResourceAuthorizer(),
+			"aws_apigatewayv2_deployment":                apigatewayv2.ResourceDeployment(),
+			"aws_apigatewayv2_domain_name":               apigatewayv2.ResourceDomainName(),
+			"aws_apigatewayv2_integration":               apigatewayv2.ResourceIntegration(),
+			"aws_apigatewayv2_integration_response":      apigatewayv2.ResourceIntegrationResponse(),
+			"aws_apigatewayv2_model":                     apigatewayv2.ResourceModel(),
+			"aws_apigatewayv2_route":                     apigatewayv2.ResourceRoute(),
+			"aws_apigatewayv2_route_response":            apigatewayv2.ResourceRouteResponse(),
+			"aws_apigatewayv2_route_responses_exclusive": apigatewayv2.ResourceRouteResponsesExclusive(),
+			"aws_apigatewayv2_stage":                     apigatewayv2.ResourceStage(),
+			"aws_apigatewayv2_vpc_link":                  apigatewayv2.ResourceVPCLink(),
 
 			"aws_appconfig_application":                  appconfig.ResourceApplication(),
 			"aws_appconfig_configuration_profile":        appconfig.ResourceConfigurationProfile(),
@@ -1121,6 +1148,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_cloudformation_type":               cloudformation.ResourceType(),
 
 			"aws_cloudfront_cache_policy":                   cloudfront.ResourceCachePolicy(),
+			"aws_cloudfront_continuous_deployment_policy":   cloudfront.ResourceContinuousDeploymentPolicy(),
 			"aws_cloudfront_distribution":                   cloudfront.ResourceDistribution(),
 			"aws_cloudfront_field_level_encryption_config":  cloudfront.ResourceFieldLevelEncryptionConfig(),
 			"aws_cloudfront_field_level_encryption_profile": cloudfront.ResourceFieldLevelEncryptionProfile(),
@@ -1166,10 +1194,12 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_cloudwatch_log_subscription_filter": logs.ResourceSubscriptionFilter(),
 			"aws_cloudwatch_query_definition":        logs.ResourceQueryDefinition(),
 
-			"aws_rum_app_monitor": rum.ResourceAppMonitor(),
+			"aws_rum_app_monitor":         rum.ResourceAppMonitor(),
+			"aws_rum_metrics_destination": rum.ResourceMetricsDestination(),
 
 			"aws_codeartifact_domain":                        codeartifact.ResourceDomain(),
 			"aws_codeartifact_domain_permissions_policy":     codeartifact.ResourceDomainPermissionsPolicy(),
+			"aws_codeartifact_package_origin_configuration":  codeartifact.ResourcePackageOriginConfiguration(),
 			"aws_codeartifact_repository":                    codeartifact.ResourceRepository(),
 			"aws_codeartifact_repository_permissions_policy": codeartifact.ResourceRepositoryPermissionsPolicy(),
 
@@ -1213,6 +1243,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_cognito_user_pool_ui_customization": cognitoidp.ResourceUserPoolUICustomization(),
 
 			"aws_comprehend_document_classifier": comprehend.ResourceDocumentClassifier(),
+			"aws_comprehend_endpoint":            comprehend.ResourceEndpoint(),
 			"aws_comprehend_entity_recognizer":   comprehend.ResourceEntityRecognizer(),
 
 			"aws_config_aggregate_authorization":       configservice.ResourceAggregateAuthorization(),
@@ -1316,6 +1347,8 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_docdb_global_cluster":          docdb.ResourceGlobalCluster(),
 			"aws_docdb_subnet_group":            docdb.ResourceSubnetGroup(),
 
+			"aws_drs_replication_configuration_template": drs.ResourceReplicationConfigurationTemplate(),
+
 			"aws_directory_service_conditional_forwarder":     ds.ResourceConditionalForwarder(),
 			"aws_directory_service_directory":                 ds.ResourceDirectory(),
 			"aws_directory_service_log_subscription":          ds.ResourceLogSubscription(),
@@ -1349,7 +1382,10 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_ebs_snapshot_copy":                                ec2.ResourceEBSSnapshotCopy(),
 			"aws_ebs_snapshot_import":                              ec2.ResourceEBSSnapshotImport(),
 			"aws_ebs_volume":                                       ec2.ResourceEBSVolume(),
+			"aws_ec2_address_transfer":                             ec2.ResourceAddressTransfer(),
+			"aws_ec2_address_transfer_accepter":                    ec2.ResourceAddressTransferAccepter(),
 			"aws_ec2_availability_zone_group":                      ec2.ResourceAvailabilityZoneGroup(),
+			"aws_ec2_byoip_cidr":                                   ec2.ResourceBYOIPCIDR(),
 			"aws_ec2_capacity_reservation":                         ec2.ResourceCapacityReservation(),
 			"aws_ec2_carrier_gateway":                              ec2.ResourceCarrierGateway(),
 			"aws_ec2_client_vpn_authorization_rule":                ec2.ResourceClientVPNAuthorizationRule(),
@@ -1442,6 +1478,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_vpc_peering_connection":                           ec2.ResourceVPCPeeringConnection(),
 			"aws_vpc_peering_connection_accepter":                  ec2.ResourceVPCPeeringConnectionAccepter(),
 			"aws_vpc_peering_connection_options":                   ec2.ResourceVPCPeeringConnectionOptions(),
+			"aws_vpc_security_group_ingress_rules":                 ec2.ResourceSecurityGroupIngressRules(),
 			"aws_vpn_connection":                                   ec2.ResourceVPNConnection(),
 			"aws_vpn_connection_route":                             ec2.ResourceVPNConnectionRoute(),
 			"aws_vpn_gateway":                                      ec2.ResourceVPNGateway(),
@@ -1622,6 +1659,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_iam_policy":                      iam.ResourcePolicy(),
 			"aws_iam_policy_attachment":           iam.ResourcePolicyAttachment(),
 			"aws_iam_role":                        iam.ResourceRole(),
+			"aws_iam_role_policies_exclusive":     iam.ResourceRolePoliciesExclusive(),
 			"aws_iam_role_policy":                 iam.ResourceRolePolicy(),
 			"aws_iam_role_policy_attachment":      iam.ResourceRolePolicyAttachment(),
 			"aws_iam_saml_provider":               iam.ResourceSAMLProvider(),
@@ -1706,7 +1744,8 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_kinesisanalyticsv2_application":          kinesisanalyticsv2.ResourceApplication(),
 			"aws_kinesisanalyticsv2_application_snapshot": kinesisanalyticsv2.ResourceApplicationSnapshot(),
 
-			"aws_kinesis_video_stream": kinesisvideo.ResourceStream(),
+			"aws_kinesis_video_signaling_channel": kinesisvideo.ResourceSignalingChannel(),
+			"aws_kinesis_video_stream":            kinesisvideo.ResourceStream(),
 
 			"aws_kms_alias":                kms.ResourceAlias(),
 			"aws_kms_ciphertext":           kms.ResourceCiphertext(),
@@ -1770,6 +1809,10 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_location_tracker":             location.ResourceTracker(),
 			"aws_location_tracker_association": location.ResourceTrackerAssociation(),
 
+			"aws_m2_application": m2.ResourceApplication(),
+			"aws_m2_deployment":  m2.ResourceDeployment(),
+			"aws_m2_environment": m2.ResourceEnvironment(),
+
 			"aws_macie_member_account_association": macie.ResourceMemberAccountAssociation(),
 			"aws_macie_s3_bucket_association":      macie.ResourceS3BucketAssociation(),
 
@@ -1782,14 +1825,18 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_macie2_organization_admin_account":          macie2.ResourceOrganizationAdminAccount(),
 			"aws_macie2_classification_export_configuration": macie2.ResourceClassificationExportConfiguration(),
 
-			"aws_media_convert_queue": mediaconvert.ResourceQueue(),
+			"aws_media_convert_job_template": mediaconvert.ResourceJobTemplate(),
+			"aws_media_convert_policy":       mediaconvert.ResourcePolicy(),
+			"aws_media_convert_preset":       mediaconvert.ResourcePreset(),
+			"aws_media_convert_queue":        mediaconvert.ResourceQueue(),
 
 			"aws_media_package_channel": mediapackage.ResourceChannel(),
 
-			"aws_medialive_channel":              medialive.ResourceChannel(),
-			"aws_medialive_input":                medialive.ResourceInput(),
-			"aws_medialive_input_security_group": medialive.ResourceInputSecurityGroup(),
-			"aws_medialive_multiplex":            medialive.ResourceMultiplex(),
+			"aws_medialive_channel":                 medialive.ResourceChannel(),
+			"aws_medialive_channel_schedule_action": medialive.ResourceChannelScheduleAction(),
+			"aws_medialive_input":                   medialive.ResourceInput(),
+			"aws_medialive_input_security_group":    medialive.ResourceInputSecurityGroup(),
+			"aws_medialive_multiplex":               medialive.ResourceMultiplex(),
 
 			"aws_media_store_container":        mediastore.ResourceContainer(),
 			"aws_media_store_container_policy": mediastore.ResourceContainerPolicy(),
@@ -1824,6 +1871,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_networkmanager_attachment_accepter":                      networkmanager.ResourceAttachmentAccepter(),
 			"aws_networkmanager_connect_attachment":                       networkmanager.ResourceConnectAttachment(),
 			"aws_networkmanager_connection":                               networkmanager.ResourceConnection(),
+			"aws_networkmanager_core_network":                             networkmanager.ResourceCoreNetwork(),
 			"aws_networkmanager_customer_gateway_association":             networkmanager.ResourceCustomerGatewayAssociation(),
 			"aws_networkmanager_device":                                   networkmanager.ResourceDevice(),
 			"aws_networkmanager_global_network":                           networkmanager.ResourceGlobalNetwork(),
@@ -1868,6 +1916,13 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_organizations_policy":                  organizations.ResourcePolicy(),
 			"aws_organizations_policy_attachment":       organizations.ResourcePolicyAttachment(),
 
+			"aws_personalize_campaign":         personalize.ResourceCampaign(),
+			"aws_personalize_dataset":          personalize.ResourceDataset(),
+			"aws_personalize_dataset_group":    personalize.ResourceDatasetGroup(),
+			"aws_personalize_schema":           personalize.ResourceSchema(),
+			"aws_personalize_solution":         personalize.ResourceSolution(),
+			"aws_personalize_solution_version": personalize.ResourceSolutionVersion(),
+
 			"aws_pinpoint_adm_channel":               pinpoint.ResourceADMChannel(),
 			"aws_pinpoint_apns_channel":              pinpoint.ResourceAPNSChannel(),
 			"aws_pinpoint_apns_sandbox_channel":      pinpoint.ResourceAPNSSandboxChannel(),
@@ -1880,6 +1935,13 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_pinpoint_gcm_channel":               pinpoint.ResourceGCMChannel(),
 			"aws_pinpoint_sms_channel":               pinpoint.ResourceSMSChannel(),
 
+			"aws_proton_environment":                  proton.ResourceEnvironment(),
+			"aws_proton_environment_template":         proton.ResourceEnvironmentTemplate(),
+			"aws_proton_environment_template_version": proton.ResourceEnvironmentTemplateVersion(),
+			"aws_proton_service":                      proton.ResourceService(),
+			"aws_proton_service_template":             proton.ResourceServiceTemplate(),
+			"aws_proton_service_template_version":     proton.ResourceServiceTemplateVersion(),
+
 			"aws_qldb_ledger": qldb.ResourceLedger(),
 			"aws_qldb_stream": qldb.ResourceStream(),
 
@@ -1944,6 +2006,14 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_redshiftserverless_usage_limit":     redshiftserverless.ResourceUsageLimit(),
 			"aws_redshiftserverless_workgroup":       redshiftserverless.ResourceWorkgroup(),
 
+			"aws_rekognition_collection":       rekognition.ResourceCollection(),
+			"aws_rekognition_project":          rekognition.ResourceProject(),
+			"aws_rekognition_project_version":  rekognition.ResourceProjectVersion(),
+			"aws_rekognition_stream_processor": rekognition.ResourceStreamProcessor(),
+
+			"aws_resiliencehub_app":               resiliencehub.ResourceApp(),
+			"aws_resiliencehub_resiliency_policy": resiliencehub.ResourceResiliencyPolicy(),
+
 			"aws_resourcegroups_group": resourcegroups.ResourceGroup(),
 
 			"aws_rolesanywhere_profile":      rolesanywhere.ResourceProfile(),
@@ -1955,6 +2025,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_route53_key_signing_key":               route53.ResourceKeySigningKey(),
 			"aws_route53_query_log":                     route53.ResourceQueryLog(),
 			"aws_route53_record":                        route53.ResourceRecord(),
+			"aws_route53_records_exclusive":             route53.ResourceRecordsExclusive(),
 			"aws_route53_traffic_policy":                route53.ResourceTrafficPolicy(),
 			"aws_route53_traffic_policy_instance":       route53.ResourceTrafficPolicyInstance(),
 			"aws_route53_vpc_association_authorization": route53.ResourceVPCAssociationAuthorization(),
@@ -1963,6 +2034,8 @@ func New(_ context.Context) (*schema.Provider, error) {
 
 			"aws_route53domains_registered_domain": route53domains.ResourceRegisteredDomain(),
 
+			"aws_route53recoverycluster_routing_control_state": route53recoverycluster.ResourceRoutingControlState(),
+
 			"aws_route53recoverycontrolconfig_cluster":         route53recoverycontrolconfig.ResourceCluster(),
 			"aws_route53recoverycontrolconfig_control_panel":   route53recoverycontrolconfig.ResourceControlPanel(),
 			"aws_route53recoverycontrolconfig_routing_control": route53recoverycontrolconfig.ResourceRoutingControl(),
@@ -1997,6 +2070,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_s3_bucket_logging":                              s3.ResourceBucketLogging(),
 			"aws_s3_bucket_metric":                               s3.ResourceBucketMetric(),
 			"aws_s3_bucket_notification":                         s3.ResourceBucketNotification(),
+			"aws_s3_bucket_notification_rule":                    s3.ResourceBucketNotificationRule(),
 			"aws_s3_bucket_object_lock_configuration":            s3.ResourceBucketObjectLockConfiguration(),
 			"aws_s3_bucket_ownership_controls":                   s3.ResourceBucketOwnershipControls(),
 			"aws_s3_bucket_policy":                               s3.ResourceBucketPolicy(),
@@ -2111,6 +2185,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_ses_receipt_filter":               ses.ResourceReceiptFilter(),
 			"aws_ses_receipt_rule":                 ses.ResourceReceiptRule(),
 			"aws_ses_receipt_rule_set":             ses.ResourceReceiptRuleSet(),
+			"aws_ses_receipt_rule_set_order":       ses.ResourceReceiptRuleSetOrder(),
 			"aws_ses_template":                     ses.ResourceTemplate(),
 
 			"aws_sesv2_configuration_set":                   sesv2.ResourceConfigurationSet(),
@@ -2140,6 +2215,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 
 			"aws_sqs_queue":                      sqs.ResourceQueue(),
 			"aws_sqs_queue_policy":               sqs.ResourceQueuePolicy(),
+			"aws_sqs_queue_policy_statement":     sqs.ResourceQueuePolicyStatement(),
 			"aws_sqs_queue_redrive_allow_policy": sqs.ResourceQueueRedriveAllowPolicy(),
 			"aws_sqs_queue_redrive_policy":       sqs.ResourceQueueRedrivePolicy(),
 
@@ -2173,7 +2249,9 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_storagegateway_upload_buffer":           storagegateway.ResourceUploadBuffer(),
 			"aws_storagegateway_working_storage":         storagegateway.ResourceWorkingStorage(),
 
-			"aws_swf_domain": swf.ResourceDomain(),
+			"aws_swf_activity_type": swf.ResourceActivityType(),
+			"aws_swf_domain":        swf.ResourceDomain(),
+			"aws_swf_workflow_type": swf.ResourceWorkflowType(),
 
 			"aws_synthetics_canary": synthetics.ResourceCanary(),
 
@@ -2192,6 +2270,9 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_transfer_user":     transfer.ResourceUser(),
 			"aws_transfer_workflow": transfer.ResourceWorkflow(),
 
+			"aws_translate_parallel_data": translate.ResourceParallelData(),
+			"aws_translate_terminology":   translate.ResourceTerminology(),
+
 			"aws_waf_byte_match_set":          waf.ResourceByteMatchSet(),
 			"aws_waf_geo_match_set":           waf.ResourceGeoMatchSet(),
 			"aws_waf_ipset":                   waf.ResourceIPSet(),
@@ -2239,6 +2320,15 @@ func New(_ context.Context) (*schema.Provider, error) {
 		},
 	}
 
+	// Allow each resource and data source to override the provider's Region.
+	for _, v := range provider.DataSourcesMap {
+		addDataSourceRegionOverride(v)
+	}
+
+	for _, v := range provider.ResourcesMap {
+		addResourceRegionOverride(v)
+	}
+
 	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		return configure(ctx, provider, d)
 	}
@@ -2284,6 +2374,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		MaxRetries:                     25, // Set default here, not in schema (muxing with v6 provider).
 		Profile:                        d.Get("profile").(string),
 		Region:                         d.Get("region").(string),
+		S3UsEast1RegionalEndpoint:      d.Get("s3_us_east_1_regional_endpoint").(string),
 		S3UsePathStyle:                 d.Get("s3_use_path_style").(bool) || d.Get("s3_force_path_style").(bool),
 		SecretKey:                      d.Get("secret_key").(string),
 		SkipCredsValidation:            d.Get("skip_credentials_validation").(bool),
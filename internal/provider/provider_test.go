@@ -2,9 +2,13 @@ package provider
 
 import (
 	"os"
+	"reflect"
 	"strings"
 	"testing"
+	"time"
 
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -182,3 +186,33 @@ func popEnv(env []string) {
 		os.Setenv(k, v)
 	}
 }
+
+func TestExpandAssumeRole(t *testing.T) {
+	tfMap := map[string]interface{}{
+		"duration":        "1h",
+		"external_id":     "test-external-id",
+		"role_arn":        "arn:aws:iam::123456789012:role/test",
+		"session_name":    "test-session",
+		"source_identity": "test-source-identity",
+		"tags": map[string]interface{}{
+			"Project": "test",
+		},
+		"transitive_tag_keys": schema.NewSet(schema.HashString, []interface{}{"Project"}),
+	}
+
+	got := expandAssumeRole(tfMap)
+
+	want := &awsbase.AssumeRole{
+		Duration:          time.Hour,
+		ExternalID:        "test-external-id",
+		RoleARN:           "arn:aws:iam::123456789012:role/test",
+		SessionName:       "test-session",
+		SourceIdentity:    "test-source-identity",
+		Tags:              map[string]string{"Project": "test"},
+		TransitiveTagKeys: []string{"Project"},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expandAssumeRole() = %#v, want %#v", got, want)
+	}
+}
@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// regionAttribute is the name of the per-resource Region override argument.
+const regionAttribute = "region"
+
+type contextFunc = func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics
+
+// addResourceRegionOverride adds an optional `region` argument to a resource.
+// When set, the resource's operations are performed using provider Meta (instance data)
+// configured for that Region instead of the provider's Region.
+// Resources that already define a top-level `region` attribute are left unchanged.
+func addResourceRegionOverride(r *schema.Resource) {
+	if !addRegionAttribute(r, &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		ValidateFunc: verify.ValidRegionName,
+	}) {
+		return
+	}
+
+	switch {
+	case r.CreateWithoutTimeout != nil:
+		r.CreateWithoutTimeout = withRegion(r.CreateWithoutTimeout)
+	case r.CreateContext != nil:
+		r.CreateContext = withRegion(r.CreateContext)
+	case r.Create != nil:
+		r.CreateContext = withRegion(contextFuncFromFunc(r.Create))
+		r.Create = nil
+	}
+
+	switch {
+	case r.ReadWithoutTimeout != nil:
+		r.ReadWithoutTimeout = withRegion(r.ReadWithoutTimeout)
+	case r.ReadContext != nil:
+		r.ReadContext = withRegion(r.ReadContext)
+	case r.Read != nil:
+		r.ReadContext = withRegion(contextFuncFromFunc(r.Read))
+		r.Read = nil
+	}
+
+	switch {
+	case r.UpdateWithoutTimeout != nil:
+		r.UpdateWithoutTimeout = withRegion(r.UpdateWithoutTimeout)
+	case r.UpdateContext != nil:
+		r.UpdateContext = withRegion(r.UpdateContext)
+	case r.Update != nil:
+		r.UpdateContext = withRegion(contextFuncFromFunc(r.Update))
+		r.Update = nil
+	}
+
+	switch {
+	case r.DeleteWithoutTimeout != nil:
+		r.DeleteWithoutTimeout = withRegion(r.DeleteWithoutTimeout)
+	case r.DeleteContext != nil:
+		r.DeleteContext = withRegion(r.DeleteContext)
+	case r.Delete != nil:
+		r.DeleteContext = withRegion(contextFuncFromFunc(r.Delete))
+		r.Delete = nil
+	}
+
+	if customizeDiff := r.CustomizeDiff; customizeDiff != nil {
+		r.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			client, diags := meta.(*conns.AWSClient).RegionalClient(ctx, d.Get(regionAttribute).(string))
+
+			if diags.HasError() {
+				return diagsError(diags)
+			}
+
+			return customizeDiff(ctx, d, client)
+		}
+	}
+}
+
+// addDataSourceRegionOverride adds an optional `region` argument to a data source.
+// When set, the data source is read using provider Meta (instance data)
+// configured for that Region instead of the provider's Region.
+// Data sources that already define a top-level `region` attribute are left unchanged.
+func addDataSourceRegionOverride(r *schema.Resource) {
+	if !addRegionAttribute(r, &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: verify.ValidRegionName,
+	}) {
+		return
+	}
+
+	switch {
+	case r.ReadWithoutTimeout != nil:
+		r.ReadWithoutTimeout = withRegion(r.ReadWithoutTimeout)
+	case r.ReadContext != nil:
+		r.ReadContext = withRegion(r.ReadContext)
+	case r.Read != nil:
+		r.ReadContext = withRegion(contextFuncFromFunc(r.Read))
+		r.Read = nil
+	}
+}
+
+func addRegionAttribute(r *schema.Resource, s *schema.Schema) bool {
+	if r.Schema == nil {
+		r.Schema = make(map[string]*schema.Schema)
+	}
+
+	if _, ok := r.Schema[regionAttribute]; ok {
+		return false
+	}
+
+	r.Schema[regionAttribute] = s
+
+	return true
+}
+
+// withRegion returns a handler that calls f with provider Meta (instance data)
+// for the Region in the resource's `region` argument.
+func withRegion(f contextFunc) contextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		client, diags := meta.(*conns.AWSClient).RegionalClient(ctx, d.Get(regionAttribute).(string))
+
+		if diags.HasError() {
+			return diags
+		}
+
+		return append(diags, f(ctx, d, client)...)
+	}
+}
+
+func contextFuncFromFunc(f func(*schema.ResourceData, interface{}) error) contextFunc {
+	return func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return diag.FromErr(f(d, meta))
+	}
+}
+
+func diagsError(diags diag.Diagnostics) error {
+	for _, v := range diags {
+		if v.Severity == diag.Error {
+			return errors.New(v.Summary)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestAddResourceRegionOverride(t *testing.T) {
+	ctx := context.Background()
+	client := &conns.AWSClient{Region: "us-west-2"}
+
+	var got interface{}
+	r := &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			got = meta
+			d.SetId("test")
+			return nil
+		},
+		ReadContext: func(_ context.Context, _ *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			got = meta
+			return nil
+		},
+		Delete: schema.Noop,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+
+	addResourceRegionOverride(r)
+
+	if err := r.InternalValidate(nil, true); err != nil {
+		t.Fatalf("unexpected validation error: %s", err)
+	}
+
+	v, ok := r.Schema[regionAttribute]
+
+	if !ok {
+		t.Fatal("expected region attribute")
+	}
+	if !v.Optional || !v.ForceNew || v.Computed {
+		t.Errorf("unexpected region attribute: %#v", v)
+	}
+	if r.Create != nil || r.CreateContext == nil {
+		t.Error("expected Create to be converted to CreateContext")
+	}
+
+	d := r.TestResourceData()
+
+	if diags := r.CreateContext(ctx, d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if got != client {
+		t.Error("expected provider client for unset region")
+	}
+
+	got = nil
+	if err := d.Set(regionAttribute, "us-west-2"); err != nil {
+		t.Fatal(err)
+	}
+
+	if diags := r.ReadContext(ctx, d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if got != client {
+		t.Error("expected provider client for provider region")
+	}
+
+	got = nil
+	if err := d.Set(regionAttribute, "eu-west-1"); err != nil {
+		t.Fatal(err)
+	}
+
+	if diags := r.ReadContext(ctx, d, client); !diags.HasError() {
+		t.Error("expected error for unconfigured provider")
+	}
+	if got != nil {
+		t.Error("expected Read not to be called")
+	}
+}
+
+func TestAddResourceRegionOverride_existing(t *testing.T) {
+	existing := &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			regionAttribute: existing,
+		},
+	}
+
+	addResourceRegionOverride(r)
+
+	if r.Schema[regionAttribute] != existing {
+		t.Error("expected existing region attribute to be unchanged")
+	}
+}
+
+func TestAddDataSourceRegionOverride(t *testing.T) {
+	r := &schema.Resource{
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			d.SetId(meta.(*conns.AWSClient).Region)
+			return nil
+		},
+		Schema: map[string]*schema.Schema{},
+	}
+
+	addDataSourceRegionOverride(r)
+
+	v, ok := r.Schema[regionAttribute]
+
+	if !ok {
+		t.Fatal("expected region attribute")
+	}
+	if !v.Optional || v.ForceNew {
+		t.Errorf("unexpected region attribute: %#v", v)
+	}
+
+	d := r.TestResourceData()
+
+	if diags := r.ReadContext(context.Background(), d, &conns.AWSClient{Region: "us-west-2"}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if got, want := d.Id(), "us-west-2"; got != want {
+		t.Errorf("got ID %s, expected %s", got, want)
+	}
+}
@@ -104,3 +104,42 @@ func FindDomainName(conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetDoma
 
 	return output, nil
 }
+
+// FindRouteResponsesByRouteID returns the route responses for the specified API route.
+// Returns NotFoundError if the API or route is not found.
+func FindRouteResponsesByRouteID(conn *apigatewayv2.ApiGatewayV2, apiID, routeID string) ([]*apigatewayv2.RouteResponse, error) {
+	input := &apigatewayv2.GetRouteResponsesInput{
+		ApiId:   aws.String(apiID),
+		RouteId: aws.String(routeID),
+	}
+	var routeResponses []*apigatewayv2.RouteResponse
+
+	err := getRouteResponsesPages(conn, input, func(page *apigatewayv2.GetRouteResponsesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.Items {
+			if item == nil {
+				continue
+			}
+
+			routeResponses = append(routeResponses, item)
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, apigatewayv2.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return routeResponses, nil
+}
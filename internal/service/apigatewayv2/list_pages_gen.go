@@ -1,4 +1,4 @@
-// Code generated by "internal/generate/listpages/main.go -ListOps=GetApis,GetDomainNames,GetApiMappings,GetStages"; DO NOT EDIT.
+// Code generated by "internal/generate/listpages/main.go -ListOps=GetApis,GetDomainNames,GetApiMappings,GetRouteResponses,GetStages"; DO NOT EDIT.
 
 package apigatewayv2
 
@@ -69,6 +69,26 @@ func getDomainNamesPagesWithContext(ctx context.Context, conn *apigatewayv2.ApiG
 	}
 	return nil
 }
+func getRouteResponsesPages(conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetRouteResponsesInput, fn func(*apigatewayv2.GetRouteResponsesOutput, bool) bool) error {
+	return getRouteResponsesPagesWithContext(context.Background(), conn, input, fn)
+}
+
+func getRouteResponsesPagesWithContext(ctx context.Context, conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetRouteResponsesInput, fn func(*apigatewayv2.GetRouteResponsesOutput, bool) bool) error {
+	for {
+		output, err := conn.GetRouteResponsesWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
 func getStagesPages(conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetStagesInput, fn func(*apigatewayv2.GetStagesOutput, bool) bool) error {
 	return getStagesPagesWithContext(context.Background(), conn, input, fn)
 }
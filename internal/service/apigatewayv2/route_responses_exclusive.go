@@ -0,0 +1,153 @@
+package apigatewayv2
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceRouteResponsesExclusive() *schema.Resource {
+	return &schema.Resource{
+		// Create and Update both delete the route's responses whose keys are not in route_response_keys.
+		Create: resourceRouteResponsesExclusivePut,
+		Update: resourceRouteResponsesExclusivePut,
+
+		Read:   resourceRouteResponsesExclusiveRead,
+		Delete: resourceRouteResponsesExclusiveDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceRouteResponsesExclusiveImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"route_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"route_response_keys": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceRouteResponsesExclusivePut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn
+
+	apiID := d.Get("api_id").(string)
+	routeID := d.Get("route_id").(string)
+	keys := flex.ExpandStringValueSet(d.Get("route_response_keys").(*schema.Set))
+
+	if err := syncRouteResponses(conn, apiID, routeID, keys); err != nil {
+		return fmt.Errorf("synchronizing API Gateway v2 route (%s) responses: %w", routeID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", apiID, routeID))
+
+	return resourceRouteResponsesExclusiveRead(d, meta)
+}
+
+func resourceRouteResponsesExclusiveRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn
+
+	apiID := d.Get("api_id").(string)
+	routeID := d.Get("route_id").(string)
+
+	routeResponses, err := FindRouteResponsesByRouteID(conn, apiID, routeID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] API Gateway v2 route (%s) not found, removing route responses exclusive management from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading API Gateway v2 route (%s) responses: %w", routeID, err)
+	}
+
+	var keys []string
+	for _, v := range routeResponses {
+		keys = append(keys, aws.StringValue(v.RouteResponseKey))
+	}
+
+	d.Set("route_response_keys", keys)
+
+	return nil
+}
+
+func resourceRouteResponsesExclusiveDelete(d *schema.ResourceData, meta interface{}) error {
+	// The listed route responses belong to their aws_apigatewayv2_route_response resources
+	// and any added later are no longer deleted, so there is nothing to call here.
+	log.Printf("[DEBUG] Removing API Gateway v2 route (%s) responses exclusive management from state", d.Id())
+
+	return nil
+}
+
+func resourceRouteResponsesExclusiveImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return []*schema.ResourceData{}, fmt.Errorf("wrong format of import ID (%s), use: 'api-id/route-id'", d.Id())
+	}
+
+	d.Set("api_id", parts[0])
+	d.Set("route_id", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// syncRouteResponses deletes any route response whose key is not in the
+// wanted set, including responses created outside Terraform, e.g. in the
+// console. Responses in the set are expected to be created elsewhere, e.g.
+// with aws_apigatewayv2_route_response.
+func syncRouteResponses(conn *apigatewayv2.ApiGatewayV2, apiID, routeID string, want []string) error {
+	have, err := FindRouteResponsesByRouteID(conn, apiID, routeID)
+
+	if err != nil {
+		return err
+	}
+
+	wanted := make(map[string]struct{}, len(want))
+	for _, key := range want {
+		wanted[key] = struct{}{}
+	}
+
+	for _, v := range have {
+		key := aws.StringValue(v.RouteResponseKey)
+
+		if _, ok := wanted[key]; ok {
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting API Gateway v2 route (%s) response: %s", routeID, key)
+		_, err := conn.DeleteRouteResponse(&apigatewayv2.DeleteRouteResponseInput{
+			ApiId:           aws.String(apiID),
+			RouteId:         aws.String(routeID),
+			RouteResponseId: v.RouteResponseId,
+		})
+
+		if tfawserr.ErrCodeEquals(err, apigatewayv2.ErrCodeNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting route response (%s): %w", key, err)
+		}
+	}
+
+	return nil
+}
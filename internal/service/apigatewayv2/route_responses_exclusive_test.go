@@ -0,0 +1,139 @@
+package apigatewayv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfapigatewayv2 "github.com/hashicorp/terraform-provider-aws/internal/service/apigatewayv2"
+)
+
+func TestAccAPIGatewayV2RouteResponsesExclusive_basic(t *testing.T) {
+	resourceName := "aws_apigatewayv2_route_responses_exclusive.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAPIDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRouteResponsesExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteResponsesExclusiveCount(resourceName, 1),
+					resource.TestCheckResourceAttrPair(resourceName, "api_id", "aws_apigatewayv2_api.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "route_id", "aws_apigatewayv2_route.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "route_response_keys.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "route_response_keys.*", "$default"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAPIGatewayV2RouteResponsesExclusive_outOfBandAddition(t *testing.T) {
+	resourceName := "aws_apigatewayv2_route_responses_exclusive.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAPIDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRouteResponsesExclusiveConfig_empty(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteResponsesExclusiveCount(resourceName, 0),
+					testAccCheckRouteResponsesExclusiveCreateOutOfBand(resourceName, "$default"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccRouteResponsesExclusiveConfig_empty(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteResponsesExclusiveCount(resourceName, 0),
+					resource.TestCheckResourceAttr(resourceName, "route_response_keys.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRouteResponsesExclusiveCount(n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayV2Conn
+
+		routeResponses, err := tfapigatewayv2.FindRouteResponsesByRouteID(conn, rs.Primary.Attributes["api_id"], rs.Primary.Attributes["route_id"])
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(routeResponses); got != want {
+			return fmt.Errorf("API Gateway v2 route (%s) has %d route responses, want %d", rs.Primary.Attributes["route_id"], got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRouteResponsesExclusiveCreateOutOfBand(n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayV2Conn
+
+		_, err := conn.CreateRouteResponse(&apigatewayv2.CreateRouteResponseInput{
+			ApiId:            aws.String(rs.Primary.Attributes["api_id"]),
+			RouteId:          aws.String(rs.Primary.Attributes["route_id"]),
+			RouteResponseKey: aws.String(key),
+		})
+
+		return err
+	}
+}
+
+func testAccRouteResponsesExclusiveConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccRouteResponseConfig_basicWebSocket(rName),
+		`
+resource "aws_apigatewayv2_route_responses_exclusive" "test" {
+  api_id              = aws_apigatewayv2_api.test.id
+  route_id            = aws_apigatewayv2_route.test.id
+  route_response_keys = [aws_apigatewayv2_route_response.test.route_response_key]
+}
+`)
+}
+
+func testAccRouteResponsesExclusiveConfig_empty(rName string) string {
+	return acctest.ConfigCompose(
+		testAccRouteConfig_basicWebSocket(rName),
+		`
+resource "aws_apigatewayv2_route_responses_exclusive" "test" {
+  api_id              = aws_apigatewayv2_api.test.id
+  route_id            = aws_apigatewayv2_route.test.id
+  route_response_keys = []
+}
+`)
+}
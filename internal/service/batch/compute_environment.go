@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/batch"
@@ -30,6 +32,12 @@ func ResourceComputeEnvironment() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
 		CustomizeDiff: customdiff.Sequence(
 			resourceComputeEnvironmentCustomizeDiff,
 			verify.SetTagsDiff,
@@ -59,7 +67,6 @@ func ResourceComputeEnvironment() *schema.Resource {
 			"compute_resources": {
 				Type:     schema.TypeList,
 				Optional: true,
-				ForceNew: true,
 				MinItems: 0,
 				MaxItems: 1,
 				Elem: &schema.Resource{
@@ -67,7 +74,6 @@ func ResourceComputeEnvironment() *schema.Resource {
 						"allocation_strategy": {
 							Type:     schema.TypeString,
 							Optional: true,
-							ForceNew: true,
 							StateFunc: func(val interface{}) string {
 								return strings.ToUpper(val.(string))
 							},
@@ -126,32 +132,27 @@ func ResourceComputeEnvironment() *schema.Resource {
 						"instance_type": {
 							Type:     schema.TypeSet,
 							Optional: true,
-							ForceNew: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
 						"launch_template": {
 							Type:     schema.TypeList,
 							Optional: true,
-							ForceNew: true,
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"launch_template_id": {
 										Type:          schema.TypeString,
 										Optional:      true,
-										ForceNew:      true,
 										ConflictsWith: []string{"compute_resources.0.launch_template.0.launch_template_name"},
 									},
 									"launch_template_name": {
 										Type:          schema.TypeString,
 										Optional:      true,
-										ForceNew:      true,
 										ConflictsWith: []string{"compute_resources.0.launch_template.0.launch_template_id"},
 									},
 									"version": {
 										Type:     schema.TypeString,
 										Optional: true,
-										ForceNew: true,
 									},
 								},
 							},
@@ -384,10 +385,29 @@ func resourceComputeEnvironmentUpdate(d *schema.ResourceData, meta interface{})
 				MaxvCpus: aws.Int64(int64(d.Get("compute_resources.0.max_vcpus").(int))),
 			}
 
+			if d.HasChange("compute_resources.0.allocation_strategy") {
+				computeResourceUpdate.AllocationStrategy = aws.String(d.Get("compute_resources.0.allocation_strategy").(string))
+			}
+
 			if d.HasChange("compute_resources.0.desired_vcpus") {
 				computeResourceUpdate.DesiredvCpus = aws.Int64(int64(d.Get("compute_resources.0.desired_vcpus").(int)))
 			}
 
+			if d.HasChange("compute_resources.0.instance_type") {
+				computeResourceUpdate.InstanceTypes = flex.ExpandStringSet(d.Get("compute_resources.0.instance_type").(*schema.Set))
+			}
+
+			if d.HasChange("compute_resources.0.launch_template") {
+				if v, ok := d.GetOk("compute_resources.0.launch_template"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+					computeResourceUpdate.LaunchTemplate = expandLaunchTemplateSpecification(v.([]interface{})[0].(map[string]interface{}))
+				} else {
+					// An empty launch template ID removes the custom launch template.
+					computeResourceUpdate.LaunchTemplate = &batch.LaunchTemplateSpecification{
+						LaunchTemplateId: aws.String(""),
+					}
+				}
+			}
+
 			if d.HasChange("compute_resources.0.min_vcpus") {
 				computeResourceUpdate.MinvCpus = aws.Int64(int64(d.Get("compute_resources.0.min_vcpus").(int)))
 			}
@@ -478,6 +498,27 @@ func resourceComputeEnvironmentCustomizeDiff(_ context.Context, diff *schema.Res
 			fargateComputeResources = true
 		}
 
+		if diff.HasChange("compute_resources.#") {
+			if err := diff.ForceNew("compute_resources"); err != nil {
+				return err
+			}
+		}
+
+		// These can only be changed in place through an infrastructure update.
+		if !isUpdatableComputeEnvironmentDiff(diff) {
+			for _, key := range []string{
+				"compute_resources.0.allocation_strategy",
+				"compute_resources.0.instance_type",
+				"compute_resources.0.launch_template",
+			} {
+				if diff.HasChange(key) {
+					if err := diff.ForceNew(key); err != nil {
+						return err
+					}
+				}
+			}
+		}
+
 		if diff.HasChange("compute_resources.0.security_group_ids") && !fargateComputeResources {
 			if err := diff.ForceNew("compute_resources.0.security_group_ids"); err != nil {
 				return err
@@ -494,6 +535,39 @@ func resourceComputeEnvironmentCustomizeDiff(_ context.Context, diff *schema.Res
 	return nil
 }
 
+// isUpdatableComputeEnvironmentDiff returns whether the compute environment is
+// eligible for infrastructure updates both before and after the change.
+// See https://docs.aws.amazon.com/batch/latest/userguide/updating-compute-environments.html.
+func isUpdatableComputeEnvironmentDiff(diff *schema.ResourceDiff) bool {
+	o, n := diff.GetChange("service_role")
+	if !isServiceLinkedRoleARN(o.(string)) || !isServiceLinkedRoleARN(n.(string)) {
+		return false
+	}
+
+	o, n = diff.GetChange("compute_resources.0.allocation_strategy")
+	if !isUpdatableAllocationStrategy(o.(string)) || !isUpdatableAllocationStrategy(n.(string)) {
+		return false
+	}
+
+	return true
+}
+
+var serviceLinkedRoleARNRegexp = regexp.MustCompile(`^arn:[^:]+:iam::\d{12}:role/aws-service-role/batch\.amazonaws\.com/`)
+
+func isServiceLinkedRoleARN(arn string) bool {
+	// No service role means the AWSServiceRoleForBatch service-linked role.
+	return arn == "" || serviceLinkedRoleARNRegexp.MatchString(arn)
+}
+
+func isUpdatableAllocationStrategy(allocationStrategy string) bool {
+	switch strings.ToUpper(allocationStrategy) {
+	case batch.CRAllocationStrategyBestFitProgressive, batch.CRAllocationStrategySpotCapacityOptimized:
+		return true
+	default:
+		return false
+	}
+}
+
 func expandComputeResource(tfMap map[string]interface{}) *batch.ComputeResource {
 	if tfMap == nil {
 		return nil
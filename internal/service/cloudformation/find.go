@@ -10,13 +10,13 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
-func FindChangeSetByStackIDAndChangeSetName(conn *cloudformation.CloudFormation, stackID, changeSetName string) (*cloudformation.DescribeChangeSetOutput, error) {
+func FindChangeSetByStackIDAndChangeSetName(ctx context.Context, conn *cloudformation.CloudFormation, stackID, changeSetName string) (*cloudformation.DescribeChangeSetOutput, error) {
 	input := &cloudformation.DescribeChangeSetInput{
 		ChangeSetName: aws.String(changeSetName),
 		StackName:     aws.String(stackID),
 	}
 
-	output, err := conn.DescribeChangeSet(input)
+	output, err := conn.DescribeChangeSetWithContext(ctx, input)
 
 	if tfawserr.ErrCodeEquals(err, cloudformation.ErrCodeChangeSetNotFoundException) {
 		return nil, &resource.NotFoundError{
@@ -36,6 +36,48 @@ func FindChangeSetByStackIDAndChangeSetName(conn *cloudformation.CloudFormation,
 	return output, nil
 }
 
+// FindChangeSetChangesByStackIDAndChangeSetName returns all of a change set's changes, following DescribeChangeSet's NextToken.
+// A change set ARN may be used as the change set name with an empty stack ID.
+func FindChangeSetChangesByStackIDAndChangeSetName(ctx context.Context, conn *cloudformation.CloudFormation, stackID, changeSetName string) ([]*cloudformation.Change, error) {
+	input := &cloudformation.DescribeChangeSetInput{
+		ChangeSetName: aws.String(changeSetName),
+	}
+	if stackID != "" {
+		input.StackName = aws.String(stackID)
+	}
+
+	var output []*cloudformation.Change
+
+	for {
+		page, err := conn.DescribeChangeSetWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, cloudformation.ErrCodeChangeSetNotFoundException) {
+			return nil, &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if page == nil {
+			return nil, tfresource.NewEmptyResultError(input)
+		}
+
+		output = append(output, page.Changes...)
+
+		if aws.StringValue(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
+
 func FindStackByID(conn *cloudformation.CloudFormation, id string) (*cloudformation.Stack, error) {
 	input := &cloudformation.DescribeStacksInput{
 		StackName: aws.String(id),
@@ -1,6 +1,9 @@
 package cloudformation
 
 import (
+	"context"
+	"strings"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 )
@@ -29,3 +32,26 @@ func listStackEventsForOperation(conn *cloudformation.CloudFormation, stackID, r
 	})
 	return err
 }
+
+// listStackChangeSetNamesWithPrefix returns the names of the stack's change sets that start with prefix.
+func listStackChangeSetNamesWithPrefix(ctx context.Context, conn *cloudformation.CloudFormation, stackID, prefix string) ([]string, error) {
+	var names []string
+
+	err := conn.ListChangeSetsPagesWithContext(ctx, &cloudformation.ListChangeSetsInput{
+		StackName: aws.String(stackID),
+	}, func(page *cloudformation.ListChangeSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Summaries {
+			if name := aws.StringValue(v.ChangeSetName); strings.HasPrefix(name, prefix) {
+				names = append(names, name)
+			}
+		}
+
+		return !lastPage
+	})
+
+	return names, err
+}
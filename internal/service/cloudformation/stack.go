@@ -1,13 +1,19 @@
 package cloudformation
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -29,7 +35,11 @@ func ResourceStack() *schema.Resource {
 		Delete: resourceStackDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("change_set_preview", false)
+
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -48,6 +58,11 @@ func ResourceStack() *schema.Resource {
 				},
 				Set: schema.HashString,
 			},
+			"change_set_preview": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"disable_rollback": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -95,6 +110,11 @@ func ResourceStack() *schema.Resource {
 					return json
 				},
 			},
+			"planned_changes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"policy_url": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -122,7 +142,10 @@ func ResourceStack() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceStackChangeSetPreviewDiff,
+		),
 	}
 }
 
@@ -322,6 +345,7 @@ func resourceStackRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceStackUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx := context.Background()
 	conn := meta.(*conns.AWSClient).CloudFormationConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
@@ -377,6 +401,28 @@ func resourceStackUpdate(d *schema.ResourceData, meta interface{}) error {
 		input.RoleARN = aws.String(d.Get("iam_role_arn").(string))
 	}
 
+	// Execute the change set created during plan if it is still applicable.
+	// Stack policy changes are not part of a change set.
+	if d.Get("change_set_preview").(bool) && !d.HasChanges("policy_body", "policy_url") {
+		executed, plannedChanges, err := executeStackPreviewChangeSet(ctx, conn, d, tags, requestToken)
+
+		if err != nil {
+			return fmt.Errorf("error executing CloudFormation Stack (%s) change set: %w", d.Id(), err)
+		}
+
+		if executed {
+			if _, err := WaitStackUpdated(conn, d.Id(), requestToken, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf("error waiting for CloudFormation Stack (%s) update: %w", d.Id(), err)
+			}
+
+			if err := d.Set("planned_changes", plannedChanges); err != nil {
+				return fmt.Errorf("error setting planned_changes: %w", err)
+			}
+
+			return resourceStackRead(d, meta)
+		}
+	}
+
 	log.Printf("[DEBUG] Updating CloudFormation Stack: %s", input)
 	_, err := tfresource.RetryWhen(propagationTimeout,
 		func() (interface{}, error) {
@@ -401,6 +447,11 @@ func resourceStackUpdate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error waiting for CloudFormation Stack (%s) update: %w", d.Id(), err)
 	}
 
+	// Any preview change sets are obsolete now that the stack has been updated directly.
+	if d.Get("change_set_preview").(bool) || d.HasChange("change_set_preview") {
+		deleteStackPreviewChangeSets(ctx, conn, d.Id(), "")
+	}
+
 	return resourceStackRead(d, meta)
 }
 
@@ -430,3 +481,278 @@ func resourceStackDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+// stackChangeSetPreviewNamePrefix prefixes the names of change sets created during plan.
+// The rest of the name is derived from the change set input so that planning the same
+// configuration again, including the plan run during apply, reuses the change set.
+const stackChangeSetPreviewNamePrefix = "terraform-preview-"
+
+// stackChangeSetInputGetter is implemented by both schema.ResourceData and schema.ResourceDiff.
+type stackChangeSetInputGetter interface {
+	Get(string) interface{}
+	GetOk(string) (interface{}, bool)
+}
+
+func resourceStackChangeSetPreviewDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	if !diff.Get("change_set_preview").(bool) {
+		// Clear the changes recorded by an earlier preview.
+		if o, _ := diff.GetChange("planned_changes"); len(o.([]interface{})) > 0 {
+			return diff.SetNew("planned_changes", []string{})
+		}
+
+		return nil
+	}
+
+	if !diff.HasChanges("capabilities", "iam_role_arn", "notification_arns", "parameters", "tags_all", "template_body", "template_url") {
+		return nil
+	}
+
+	for _, key := range []string{"parameters", "tags_all", "template_body", "template_url"} {
+		if !diff.NewValueKnown(key) {
+			return diff.SetNewComputed("planned_changes")
+		}
+	}
+
+	conn := meta.(*conns.AWSClient).CloudFormationConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(diff.Get("tags").(map[string]interface{})))
+
+	input, err := expandStackChangeSetInput(diff, diff.Id(), tags)
+
+	if err != nil {
+		return err
+	}
+
+	changeSetName := aws.StringValue(input.ChangeSetName)
+
+	// Remove change sets left by earlier plans that were never applied.
+	deleteStackPreviewChangeSets(ctx, conn, diff.Id(), changeSetName)
+
+	_, err = FindChangeSetByStackIDAndChangeSetName(ctx, conn, diff.Id(), changeSetName)
+
+	if tfresource.NotFound(err) {
+		log.Printf("[DEBUG] Creating CloudFormation Stack change set: %s", input)
+		_, err = conn.CreateChangeSetWithContext(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("creating CloudFormation Stack (%s) change set: %w", diff.Id(), err)
+		}
+
+		output, err := WaitChangeSetCreated(ctx, conn, diff.Id(), changeSetName)
+
+		if isNoChangesChangeSet(output) {
+			if err := deleteStackChangeSet(ctx, conn, diff.Id(), changeSetName); err != nil {
+				log.Printf("[WARN] %s", err)
+			}
+
+			return diff.SetNew("planned_changes", []string{})
+		}
+
+		if err != nil {
+			return fmt.Errorf("waiting for CloudFormation Stack (%s) change set (%s) create: %w", diff.Id(), changeSetName, err)
+		}
+	} else if err != nil {
+		return fmt.Errorf("reading CloudFormation Stack (%s) change set (%s): %w", diff.Id(), changeSetName, err)
+	}
+
+	plannedChanges, err := flattenStackChangeSetChanges(ctx, conn, diff.Id(), changeSetName, "")
+
+	if err != nil {
+		return fmt.Errorf("reading CloudFormation Stack (%s) change set (%s): %w", diff.Id(), changeSetName, err)
+	}
+
+	log.Printf("[INFO] CloudFormation Stack (%s) change set (%s) planned changes:\n%s", diff.Id(), changeSetName, strings.Join(plannedChanges, "\n"))
+
+	return diff.SetNew("planned_changes", plannedChanges)
+}
+
+// executeStackPreviewChangeSet executes the change set created during plan and returns its changes.
+// It returns false if there is no matching change set that can be executed.
+func executeStackPreviewChangeSet(ctx context.Context, conn *cloudformation.CloudFormation, d *schema.ResourceData, tags tftags.KeyValueTags, requestToken string) (bool, []string, error) {
+	input, err := expandStackChangeSetInput(d, d.Id(), tags)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	changeSetName := aws.StringValue(input.ChangeSetName)
+	output, err := FindChangeSetByStackIDAndChangeSetName(ctx, conn, d.Id(), changeSetName)
+
+	if tfresource.NotFound(err) {
+		return false, nil, nil
+	}
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	if aws.StringValue(output.Status) != cloudformation.ChangeSetStatusCreateComplete || aws.StringValue(output.ExecutionStatus) != cloudformation.ExecutionStatusAvailable {
+		log.Printf("[DEBUG] CloudFormation Stack (%s) change set (%s) cannot be executed (%s), updating stack", d.Id(), changeSetName, aws.StringValue(output.ExecutionStatus))
+
+		return false, nil, nil
+	}
+
+	// Read the changes before execution so that state records exactly what was planned.
+	plannedChanges, err := flattenStackChangeSetChanges(ctx, conn, d.Id(), changeSetName, "")
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	log.Printf("[DEBUG] Executing CloudFormation Stack (%s) change set: %s", d.Id(), changeSetName)
+	_, err = conn.ExecuteChangeSetWithContext(ctx, &cloudformation.ExecuteChangeSetInput{
+		ChangeSetName:      aws.String(changeSetName),
+		ClientRequestToken: aws.String(requestToken),
+		StackName:          aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return true, plannedChanges, nil
+}
+
+func deleteStackChangeSet(ctx context.Context, conn *cloudformation.CloudFormation, stackID, changeSetName string) error {
+	log.Printf("[DEBUG] Deleting CloudFormation Stack (%s) change set: %s", stackID, changeSetName)
+	_, err := conn.DeleteChangeSetWithContext(ctx, &cloudformation.DeleteChangeSetInput{
+		ChangeSetName: aws.String(changeSetName),
+		StackName:     aws.String(stackID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudformation.ErrCodeChangeSetNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting CloudFormation Stack (%s) change set (%s): %w", stackID, changeSetName, err)
+	}
+
+	return nil
+}
+
+// deleteStackPreviewChangeSets deletes the stack's preview change sets other than keep.
+// Failures are logged and otherwise ignored as they do not affect the stack.
+func deleteStackPreviewChangeSets(ctx context.Context, conn *cloudformation.CloudFormation, stackID, keep string) {
+	names, err := listStackChangeSetNamesWithPrefix(ctx, conn, stackID, stackChangeSetPreviewNamePrefix)
+
+	if err != nil {
+		log.Printf("[WARN] listing CloudFormation Stack (%s) change sets: %s", stackID, err)
+
+		return
+	}
+
+	for _, name := range names {
+		if name == keep {
+			continue
+		}
+
+		if err := deleteStackChangeSet(ctx, conn, stackID, name); err != nil {
+			log.Printf("[WARN] %s", err)
+		}
+	}
+}
+
+func isNoChangesChangeSet(output *cloudformation.DescribeChangeSetOutput) bool {
+	if output == nil || aws.StringValue(output.Status) != cloudformation.ChangeSetStatusFailed {
+		return false
+	}
+
+	reason := aws.StringValue(output.StatusReason)
+
+	return strings.Contains(reason, "didn't contain changes") || strings.Contains(reason, "No updates are to be performed")
+}
+
+func expandStackChangeSetInput(d stackChangeSetInputGetter, stackID string, tags tftags.KeyValueTags) (*cloudformation.CreateChangeSetInput, error) {
+	input := &cloudformation.CreateChangeSetInput{
+		ChangeSetType:       aws.String(cloudformation.ChangeSetTypeUpdate),
+		IncludeNestedStacks: aws.Bool(true),
+		StackName:           aws.String(stackID),
+	}
+
+	if v, ok := d.GetOk("template_url"); ok {
+		input.TemplateURL = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("template_body"); ok && input.TemplateURL == nil {
+		template, err := verify.NormalizeJSONOrYAMLString(v)
+		if err != nil {
+			return nil, fmt.Errorf("template body contains an invalid JSON or YAML: %s", err)
+		}
+		input.TemplateBody = aws.String(template)
+	}
+	if v, ok := d.GetOk("capabilities"); ok {
+		input.Capabilities = flex.ExpandStringSet(v.(*schema.Set))
+		sort.Slice(input.Capabilities, func(i, j int) bool {
+			return aws.StringValue(input.Capabilities[i]) < aws.StringValue(input.Capabilities[j])
+		})
+	}
+	if v, ok := d.GetOk("notification_arns"); ok {
+		input.NotificationARNs = flex.ExpandStringSet(v.(*schema.Set))
+		sort.Slice(input.NotificationARNs, func(i, j int) bool {
+			return aws.StringValue(input.NotificationARNs[i]) < aws.StringValue(input.NotificationARNs[j])
+		})
+	}
+	if v, ok := d.GetOk("parameters"); ok {
+		input.Parameters = expandParameters(v.(map[string]interface{}))
+		sort.Slice(input.Parameters, func(i, j int) bool {
+			return aws.StringValue(input.Parameters[i].ParameterKey) < aws.StringValue(input.Parameters[j].ParameterKey)
+		})
+	}
+	if v, ok := d.GetOk("iam_role_arn"); ok {
+		input.RoleARN = aws.String(v.(string))
+	}
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+		sort.Slice(input.Tags, func(i, j int) bool {
+			return aws.StringValue(input.Tags[i].Key) < aws.StringValue(input.Tags[j].Key)
+		})
+	}
+
+	hash := sha256.Sum256([]byte(input.String()))
+	input.ChangeSetName = aws.String(stackChangeSetPreviewNamePrefix + hex.EncodeToString(hash[:])[:32])
+
+	return input, nil
+}
+
+// flattenStackChangeSetChanges returns a line per resource change, descending into nested stack change sets.
+func flattenStackChangeSetChanges(ctx context.Context, conn *cloudformation.CloudFormation, stackID, changeSetName, prefix string) ([]string, error) {
+	apiObjects, err := FindChangeSetChangesByStackIDAndChangeSetName(ctx, conn, stackID, changeSetName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []string
+
+	for _, change := range apiObjects {
+		if change == nil || change.ResourceChange == nil {
+			continue
+		}
+
+		rc := change.ResourceChange
+		logicalID := prefix + aws.StringValue(rc.LogicalResourceId)
+		line := fmt.Sprintf("%s %s %s", aws.StringValue(rc.Action), aws.StringValue(rc.ResourceType), logicalID)
+
+		if v := aws.StringValue(rc.Replacement); v != "" && aws.StringValue(rc.Action) == cloudformation.ChangeActionModify {
+			line += fmt.Sprintf(" (replacement: %s)", v)
+		}
+
+		changes = append(changes, line)
+
+		if v := aws.StringValue(rc.ChangeSetId); v != "" {
+			nestedChanges, err := flattenStackChangeSetChanges(ctx, conn, "", v, logicalID+"/")
+
+			if err != nil {
+				return nil, err
+			}
+
+			changes = append(changes, nestedChanges...)
+		}
+	}
+
+	return changes, nil
+}
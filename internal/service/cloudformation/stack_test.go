@@ -43,6 +43,48 @@ func TestAccCloudFormationStack_basic(t *testing.T) {
 	})
 }
 
+func TestAccCloudFormationStack_changeSetPreview(t *testing.T) {
+	var stack cloudformation.Stack
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudformation_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackConfig_changeSetPreview(rName, "10.0.0.0/16", "Primary_CF_VPC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "change_set_preview", "true"),
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.#", "0"),
+				),
+			},
+			{
+				Config:             testAccStackConfig_changeSetPreview(rName, "10.1.0.0/16", "Primary_CF_VPC"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccStackConfig_changeSetPreview(rName, "10.0.0.0/16", "Updated_CF_VPC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.0", "Modify AWS::EC2::VPC MyVPC (replacement: False)"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"change_set_preview", "planned_changes"},
+			},
+		},
+	})
+}
+
 func TestAccCloudFormationStack_CreationFailure_doNothing(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
@@ -554,6 +596,31 @@ STACK
 `, rName)
 }
 
+func testAccStackConfig_changeSetPreview(rName, cidrBlock, tagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack" "test" {
+  name               = %[1]q
+  change_set_preview = true
+
+  template_body = <<STACK
+{
+  "Resources" : {
+    "MyVPC": {
+      "Type" : "AWS::EC2::VPC",
+      "Properties" : {
+        "CidrBlock" : %[2]q,
+        "Tags" : [
+          {"Key": "Name", "Value": %[3]q}
+        ]
+      }
+    }
+  }
+}
+STACK
+}
+`, rName, cidrBlock, tagValue)
+}
+
 func testAccStackConfig_creationFailure(rName, onFailure string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudformation_stack" "test" {
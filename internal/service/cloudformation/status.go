@@ -9,9 +9,9 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
-func StatusChangeSet(conn *cloudformation.CloudFormation, stackID, changeSetName string) resource.StateRefreshFunc {
+func StatusChangeSet(ctx context.Context, conn *cloudformation.CloudFormation, stackID, changeSetName string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		output, err := FindChangeSetByStackIDAndChangeSetName(conn, stackID, changeSetName)
+		output, err := FindChangeSetByStackIDAndChangeSetName(ctx, conn, stackID, changeSetName)
 
 		if tfresource.NotFound(err) {
 			return nil, "", nil
@@ -17,15 +17,15 @@ const (
 	ChangeSetCreatedTimeout = 5 * time.Minute
 )
 
-func WaitChangeSetCreated(conn *cloudformation.CloudFormation, stackID, changeSetName string) (*cloudformation.DescribeChangeSetOutput, error) {
+func WaitChangeSetCreated(ctx context.Context, conn *cloudformation.CloudFormation, stackID, changeSetName string) (*cloudformation.DescribeChangeSetOutput, error) {
 	stateConf := resource.StateChangeConf{
 		Pending: []string{cloudformation.ChangeSetStatusCreateInProgress, cloudformation.ChangeSetStatusCreatePending},
 		Target:  []string{cloudformation.ChangeSetStatusCreateComplete},
 		Timeout: ChangeSetCreatedTimeout,
-		Refresh: StatusChangeSet(conn, stackID, changeSetName),
+		Refresh: StatusChangeSet(ctx, conn, stackID, changeSetName),
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*cloudformation.DescribeChangeSetOutput); ok {
 		if status := aws.StringValue(output.Status); status == cloudformation.ChangeSetStatusFailed {
@@ -0,0 +1,6 @@
+package cloudfront
+
+// Error codes that are not defined in the vendored SDK.
+const (
+	errCodeNoSuchContinuousDeploymentPolicy = "NoSuchContinuousDeploymentPolicy"
+)
@@ -35,6 +35,24 @@ func ResourceCompositeAlarm() *schema.Resource {
 				Default:  true,
 				ForceNew: true,
 			},
+			"actions_suppressor": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1600),
+				RequiredWith: []string{"actions_suppressor_extension_period", "actions_suppressor_wait_period"},
+			},
+			"actions_suppressor_extension_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				RequiredWith: []string{"actions_suppressor"},
+			},
+			"actions_suppressor_wait_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				RequiredWith: []string{"actions_suppressor"},
+			},
 			"alarm_actions": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -170,6 +188,9 @@ func resourceCompositeAlarmRead(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	d.Set("actions_enabled", alarm.ActionsEnabled)
+	d.Set("actions_suppressor", alarm.ActionsSuppressor)
+	d.Set("actions_suppressor_extension_period", alarm.ActionsSuppressorExtensionPeriod)
+	d.Set("actions_suppressor_wait_period", alarm.ActionsSuppressorWaitPeriod)
 
 	if err := d.Set("alarm_actions", flex.FlattenStringSet(alarm.AlarmActions)); err != nil {
 		return diag.Errorf("error setting alarm_actions: %s", err)
@@ -272,6 +293,12 @@ func expandPutCompositeAlarmInput(d *schema.ResourceData, meta interface{}) clou
 		ActionsEnabled: aws.Bool(d.Get("actions_enabled").(bool)),
 	}
 
+	if v, ok := d.GetOk("actions_suppressor"); ok {
+		out.ActionsSuppressor = aws.String(v.(string))
+		out.ActionsSuppressorExtensionPeriod = aws.Int64(int64(d.Get("actions_suppressor_extension_period").(int)))
+		out.ActionsSuppressorWaitPeriod = aws.Int64(int64(d.Get("actions_suppressor_wait_period").(int)))
+	}
+
 	if v, ok := d.GetOk("alarm_actions"); ok {
 		out.AlarmActions = flex.ExpandStringSet(v.(*schema.Set))
 	}
@@ -110,6 +110,49 @@ func TestAccCloudWatchCompositeAlarm_actionsEnabled(t *testing.T) {
 	})
 }
 
+func TestAccCloudWatchCompositeAlarm_actionsSuppressor(t *testing.T) {
+	suffix := sdkacctest.RandString(8)
+	resourceName := "aws_cloudwatch_composite_alarm.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCompositeAlarmDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCompositeAlarmConfig_actionsSuppressor(suffix, 20, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCompositeAlarmExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "actions_suppressor", "aws_cloudwatch_metric_alarm.test.0", "alarm_name"),
+					resource.TestCheckResourceAttr(resourceName, "actions_suppressor_extension_period", "20"),
+					resource.TestCheckResourceAttr(resourceName, "actions_suppressor_wait_period", "10"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCompositeAlarmConfig_actionsSuppressor(suffix, 30, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCompositeAlarmExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "actions_suppressor_extension_period", "30"),
+					resource.TestCheckResourceAttr(resourceName, "actions_suppressor_wait_period", "20"),
+				),
+			},
+			{
+				Config: testAccCompositeAlarmConfig_basic(suffix),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCompositeAlarmExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "actions_suppressor", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCloudWatchCompositeAlarm_alarmActions(t *testing.T) {
 	suffix := sdkacctest.RandString(8)
 	resourceName := "aws_cloudwatch_composite_alarm.test"
@@ -462,6 +505,20 @@ resource "aws_cloudwatch_composite_alarm" "test" {
 `, enabled, suffix))
 }
 
+func testAccCompositeAlarmConfig_actionsSuppressor(suffix string, extensionPeriod, waitPeriod int) string {
+	return acctest.ConfigCompose(
+		testAccCompositeAlarmBaseConfig(suffix),
+		fmt.Sprintf(`
+resource "aws_cloudwatch_composite_alarm" "test" {
+  actions_suppressor                  = aws_cloudwatch_metric_alarm.test[0].alarm_name
+  actions_suppressor_extension_period = %[2]d
+  actions_suppressor_wait_period      = %[3]d
+  alarm_name                          = "tf-test-composite-%[1]s"
+  alarm_rule                          = "ALARM(${aws_cloudwatch_metric_alarm.test[1].alarm_name})"
+}
+`, suffix, extensionPeriod, waitPeriod))
+}
+
 func testAccCompositeAlarmConfig_basic(suffix string) string {
 	return acctest.ConfigCompose(
 		testAccCompositeAlarmBaseConfig(suffix),
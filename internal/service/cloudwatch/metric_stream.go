@@ -117,11 +117,13 @@ func ResourceMetricStream() *schema.Resource {
 			"statistics_configuration": {
 				Type:     schema.TypeSet,
 				Optional: true,
+				MaxItems: 100,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"additional_statistics": {
 							Type:     schema.TypeSet,
 							Required: true,
+							MaxItems: 20,
 							Elem: &schema.Schema{
 								Type: schema.TypeString,
 								ValidateFunc: validation.All(
@@ -145,6 +147,7 @@ func ResourceMetricStream() *schema.Resource {
 						"include_metric": {
 							Type:     schema.TypeSet,
 							Required: true,
+							MaxItems: 100,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"metric_name": {
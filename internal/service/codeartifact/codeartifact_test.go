@@ -22,6 +22,9 @@ func TestAccCodeArtifact_serial(t *testing.T) {
 			"disappearsDomain": testAccDomainPermissionsPolicy_Disappears_domain,
 			"ignoreEquivalent": testAccDomainPermissionsPolicy_ignoreEquivalent,
 		},
+		"PackageOriginConfiguration": {
+			"basic": testAccPackageOriginConfiguration_basic,
+		},
 		"Repository": {
 			"basic":              testAccRepository_basic,
 			"description":        testAccRepository_description,
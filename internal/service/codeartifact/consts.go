@@ -3,6 +3,7 @@ package codeartifact
 const (
 	ResNameDomain                      = "Domain"
 	ResNameDomainPermissionsPolicy     = "Domain Permissions Policy"
+	ResNamePackageOriginConfiguration  = "Package Origin Configuration"
 	ResNameRepository                  = "Repository"
 	ResNameRepositoryPermissionsPolicy = "Repository Permissions Policy"
 )
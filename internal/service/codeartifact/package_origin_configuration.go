@@ -0,0 +1,294 @@
+package codeartifact
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/codeartifact"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourcePackageOriginConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePackageOriginConfigurationPut,
+		Update: resourcePackageOriginConfigurationPut,
+		Read:   resourcePackageOriginConfigurationRead,
+		Delete: resourcePackageOriginConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"domain_owner": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(codeartifact.PackageFormat_Values(), false),
+			},
+			"namespace": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"package": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"restrictions": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"publish": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(codeartifact.AllowPublish_Values(), false),
+						},
+						"upstream": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(codeartifact.AllowUpstream_Values(), false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourcePackageOriginConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CodeArtifactConn
+
+	input := &codeartifact.PutPackageOriginConfigurationInput{
+		Domain:       aws.String(d.Get("domain").(string)),
+		Format:       aws.String(d.Get("format").(string)),
+		Package:      aws.String(d.Get("package").(string)),
+		Repository:   aws.String(d.Get("repository").(string)),
+		Restrictions: expandPackageOriginRestrictions(d.Get("restrictions").([]interface{})[0].(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("domain_owner"); ok {
+		input.DomainOwner = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("namespace"); ok {
+		input.Namespace = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Putting CodeArtifact Package Origin Configuration: %s", input)
+	_, err := conn.PutPackageOriginConfiguration(input)
+
+	if err != nil {
+		return create.Error(names.CodeArtifact, create.ErrActionCreating, ResNamePackageOriginConfiguration, aws.StringValue(input.Package), err)
+	}
+
+	if d.IsNewResource() {
+		domainOwner := meta.(*conns.AWSClient).AccountID
+		if v, ok := d.GetOk("domain_owner"); ok {
+			domainOwner = v.(string)
+		}
+
+		d.SetId(arn.ARN{
+			Partition: meta.(*conns.AWSClient).Partition,
+			Service:   "codeartifact",
+			Region:    meta.(*conns.AWSClient).Region,
+			AccountID: domainOwner,
+			Resource: fmt.Sprintf("package/%s/%s/%s/%s/%s",
+				d.Get("domain").(string),
+				d.Get("repository").(string),
+				d.Get("format").(string),
+				d.Get("namespace").(string),
+				d.Get("package").(string),
+			),
+		}.String())
+	}
+
+	return resourcePackageOriginConfigurationRead(d, meta)
+}
+
+func resourcePackageOriginConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CodeArtifactConn
+
+	domainOwner, domainName, repoName, format, namespace, packageName, err := DecodePackageID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	pkg, err := FindPackage(conn, domainOwner, domainName, repoName, format, namespace, packageName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		create.LogNotFoundRemoveState(names.CodeArtifact, create.ErrActionReading, ResNamePackageOriginConfiguration, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.Error(names.CodeArtifact, create.ErrActionReading, ResNamePackageOriginConfiguration, d.Id(), err)
+	}
+
+	d.Set("domain", domainName)
+	d.Set("domain_owner", domainOwner)
+	d.Set("format", pkg.Format)
+	d.Set("namespace", pkg.Namespace)
+	d.Set("package", pkg.Name)
+	d.Set("repository", repoName)
+
+	if pkg.OriginConfiguration != nil && pkg.OriginConfiguration.Restrictions != nil {
+		if err := d.Set("restrictions", []interface{}{flattenPackageOriginRestrictions(pkg.OriginConfiguration.Restrictions)}); err != nil {
+			return fmt.Errorf("setting restrictions: %w", err)
+		}
+	} else {
+		d.Set("restrictions", nil)
+	}
+
+	return nil
+}
+
+func resourcePackageOriginConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CodeArtifactConn
+
+	domainOwner, domainName, repoName, format, namespace, packageName, err := DecodePackageID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// Package origin configuration cannot be removed, so restore the default of allowing both publishing and upstream.
+	input := &codeartifact.PutPackageOriginConfigurationInput{
+		Domain:      aws.String(domainName),
+		DomainOwner: aws.String(domainOwner),
+		Format:      aws.String(format),
+		Package:     aws.String(packageName),
+		Repository:  aws.String(repoName),
+		Restrictions: &codeartifact.PackageOriginRestrictions{
+			Publish:  aws.String(codeartifact.AllowPublishAllow),
+			Upstream: aws.String(codeartifact.AllowUpstreamAllow),
+		},
+	}
+
+	if namespace != "" {
+		input.Namespace = aws.String(namespace)
+	}
+
+	log.Printf("[DEBUG] Resetting CodeArtifact Package Origin Configuration: %s", d.Id())
+	_, err = conn.PutPackageOriginConfiguration(input)
+
+	if tfawserr.ErrCodeEquals(err, codeartifact.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.Error(names.CodeArtifact, create.ErrActionDeleting, ResNamePackageOriginConfiguration, d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindPackage(conn *codeartifact.CodeArtifact, domainOwner, domainName, repoName, format, namespace, packageName string) (*codeartifact.PackageDescription, error) {
+	input := &codeartifact.DescribePackageInput{
+		Domain:      aws.String(domainName),
+		DomainOwner: aws.String(domainOwner),
+		Format:      aws.String(format),
+		Package:     aws.String(packageName),
+		Repository:  aws.String(repoName),
+	}
+
+	if namespace != "" {
+		input.Namespace = aws.String(namespace)
+	}
+
+	output, err := conn.DescribePackage(input)
+
+	if tfawserr.ErrCodeEquals(err, codeartifact.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Package == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Package, nil
+}
+
+func DecodePackageID(id string) (string, string, string, string, string, string, error) {
+	packageArn, err := arn.Parse(id)
+	if err != nil {
+		return "", "", "", "", "", "", err
+	}
+
+	idParts := strings.Split(strings.TrimPrefix(packageArn.Resource, "package/"), "/")
+	if len(idParts) != 5 {
+		return "", "", "", "", "", "", fmt.Errorf("expected resource part of arn in format DomainName/RepositoryName/Format/Namespace/PackageName, received: %s", packageArn.Resource)
+	}
+	return packageArn.AccountID, idParts[0], idParts[1], idParts[2], idParts[3], idParts[4], nil
+}
+
+func expandPackageOriginRestrictions(tfMap map[string]interface{}) *codeartifact.PackageOriginRestrictions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &codeartifact.PackageOriginRestrictions{}
+
+	if v, ok := tfMap["publish"].(string); ok && v != "" {
+		apiObject.Publish = aws.String(v)
+	}
+
+	if v, ok := tfMap["upstream"].(string); ok && v != "" {
+		apiObject.Upstream = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenPackageOriginRestrictions(apiObject *codeartifact.PackageOriginRestrictions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Publish; v != nil {
+		tfMap["publish"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Upstream; v != nil {
+		tfMap["upstream"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,103 @@
+package codeartifact_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/codeartifact"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcodeartifact "github.com/hashicorp/terraform-provider-aws/internal/service/codeartifact"
+)
+
+func testAccPackageOriginConfiguration_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeartifact_package_origin_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(codeartifact.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, codeartifact.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageOriginConfigurationConfig_basic(rName, "BLOCK", "BLOCK"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageOriginConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "domain", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_owner", "aws_codeartifact_domain.test", "owner"),
+					resource.TestCheckResourceAttr(resourceName, "format", "npm"),
+					resource.TestCheckResourceAttr(resourceName, "namespace", "internal"),
+					resource.TestCheckResourceAttr(resourceName, "package", rName),
+					resource.TestCheckResourceAttr(resourceName, "repository", rName),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.publish", "BLOCK"),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.upstream", "BLOCK"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPackageOriginConfigurationConfig_basic(rName, "ALLOW", "BLOCK"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageOriginConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.publish", "ALLOW"),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.upstream", "BLOCK"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPackageOriginConfigurationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no CodeArtifact Package Origin Configuration ID is set")
+		}
+
+		domainOwner, domainName, repoName, format, namespace, packageName, err := tfcodeartifact.DecodePackageID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeArtifactConn
+
+		_, err = tfcodeartifact.FindPackage(conn, domainOwner, domainName, repoName, format, namespace, packageName)
+
+		return err
+	}
+}
+
+func testAccPackageOriginConfigurationConfig_basic(rName, publish, upstream string) string {
+	return testAccRepositoryBaseConfig(rName) + fmt.Sprintf(`
+resource "aws_codeartifact_repository" "test" {
+  repository = %[1]q
+  domain     = aws_codeartifact_domain.test.domain
+}
+
+resource "aws_codeartifact_package_origin_configuration" "test" {
+  domain       = aws_codeartifact_domain.test.domain
+  domain_owner = aws_codeartifact_domain.test.owner
+  repository   = aws_codeartifact_repository.test.repository
+  format       = "npm"
+  namespace    = "internal"
+  package      = %[1]q
+
+  restrictions {
+    publish  = %[2]q
+    upstream = %[3]q
+  }
+}
+`, rName, publish, upstream)
+}
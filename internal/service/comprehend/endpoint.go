@@ -0,0 +1,332 @@
+package comprehend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/comprehend"
+	"github.com/aws/aws-sdk-go-v2/service/comprehend/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceEndpoint() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEndpointCreate,
+		ReadWithoutTimeout:   resourceEndpointRead,
+		UpdateWithoutTimeout: resourceEndpointUpdate,
+		DeleteWithoutTimeout: resourceEndpointDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"current_inference_units": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"data_access_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"desired_inference_units": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"model_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 40),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9](-*[a-zA-Z0-9])*$`), "must contain A-Z, a-z, 0-9, and hyphen (-)"),
+				),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ComprehendClient
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	in := &comprehend.CreateEndpointInput{
+		ClientRequestToken:    aws.String(resource.UniqueId()),
+		DesiredInferenceUnits: aws.Int32(int32(d.Get("desired_inference_units").(int))),
+		EndpointName:          aws.String(name),
+		ModelArn:              aws.String(d.Get("model_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("data_access_role_arn"); ok {
+		in.DataAccessRoleArn = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreateEndpoint(ctx, in)
+
+	if err != nil {
+		return diag.Errorf("creating Comprehend Endpoint (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(out.EndpointArn))
+
+	if _, err := waitEndpointInService(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Comprehend Endpoint (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceEndpointRead(ctx, d, meta)
+}
+
+func resourceEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ComprehendClient
+
+	out, err := FindEndpointByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Comprehend Endpoint (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Comprehend Endpoint (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", out.EndpointArn)
+	d.Set("current_inference_units", out.CurrentInferenceUnits)
+	d.Set("data_access_role_arn", out.DataAccessRoleArn)
+	d.Set("desired_inference_units", out.DesiredInferenceUnits)
+	d.Set("model_arn", out.ModelArn)
+	d.Set("status", out.Status)
+
+	// DescribeEndpoint() doesn't return the endpoint name
+	name, err := EndpointParseARN(aws.ToString(out.EndpointArn))
+	if err != nil {
+		return diag.Errorf("reading Comprehend Endpoint (%s): %s", d.Id(), err)
+	}
+	d.Set("name", name)
+
+	tags, err := ListTags(ctx, conn, d.Id())
+	if err != nil {
+		return diag.Errorf("listing tags for Comprehend Endpoint (%s): %s", d.Id(), err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ComprehendClient
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		in := &comprehend.UpdateEndpointInput{
+			EndpointArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("data_access_role_arn") {
+			in.DesiredDataAccessRoleArn = aws.String(d.Get("data_access_role_arn").(string))
+		}
+
+		if d.HasChange("desired_inference_units") {
+			in.DesiredInferenceUnits = aws.Int32(int32(d.Get("desired_inference_units").(int)))
+		}
+
+		if d.HasChange("model_arn") {
+			in.DesiredModelArn = aws.String(d.Get("model_arn").(string))
+		}
+
+		_, err := conn.UpdateEndpoint(ctx, in)
+
+		if err != nil {
+			return diag.Errorf("updating Comprehend Endpoint (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitEndpointInService(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for Comprehend Endpoint (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating tags for Comprehend Endpoint (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceEndpointRead(ctx, d, meta)
+}
+
+func resourceEndpointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ComprehendClient
+
+	log.Printf("[INFO] Deleting Comprehend Endpoint (%s)", d.Id())
+
+	_, err := conn.DeleteEndpoint(ctx, &comprehend.DeleteEndpointInput{
+		EndpointArn: aws.String(d.Id()),
+	})
+	if err != nil {
+		var nfe *types.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			return nil
+		}
+
+		return diag.Errorf("deleting Comprehend Endpoint (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitEndpointDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Comprehend Endpoint (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindEndpointByID(ctx context.Context, conn *comprehend.Client, id string) (*types.EndpointProperties, error) {
+	in := &comprehend.DescribeEndpointInput{
+		EndpointArn: aws.String(id),
+	}
+
+	out, err := conn.DescribeEndpoint(ctx, in)
+	if err != nil {
+		var nfe *types.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			return nil, &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil || out.EndpointProperties == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.EndpointProperties, nil
+}
+
+func waitEndpointInService(ctx context.Context, conn *comprehend.Client, id string, timeout time.Duration) (*types.EndpointProperties, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:      enum.Slice(types.EndpointStatusCreating, types.EndpointStatusUpdating),
+		Target:       enum.Slice(types.EndpointStatusInService),
+		Refresh:      statusEndpoint(ctx, conn, id),
+		PollInterval: endpointPollInterval,
+		Timeout:      timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*types.EndpointProperties); ok {
+		var ues *resource.UnexpectedStateError
+		if errors.As(err, &ues) {
+			if ues.State == string(types.EndpointStatusFailed) {
+				err = errors.New(aws.ToString(out.Message))
+			}
+		}
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitEndpointDeleted(ctx context.Context, conn *comprehend.Client, id string, timeout time.Duration) (*types.EndpointProperties, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:      enum.Slice(types.EndpointStatusDeleting, types.EndpointStatusInService, types.EndpointStatusFailed),
+		Target:       []string{},
+		Refresh:      statusEndpoint(ctx, conn, id),
+		PollInterval: endpointPollInterval,
+		Timeout:      timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*types.EndpointProperties); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func statusEndpoint(ctx context.Context, conn *comprehend.Client, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := FindEndpointByID(ctx, conn, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.Status), nil
+	}
+}
+
+func EndpointParseARN(arnString string) (string, error) {
+	arn, err := arn.Parse(arnString)
+	if err != nil {
+		return "", err
+	}
+	re := regexp.MustCompile(`^(?:document-classifier|entity-recognizer)-endpoint/([[:alnum:]-]+)`)
+	matches := re.FindStringSubmatch(arn.Resource)
+	if len(matches) != 2 {
+		return "", fmt.Errorf("unable to parse %q", arnString)
+	}
+	name := matches[1]
+
+	return name, nil
+}
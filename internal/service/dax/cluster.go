@@ -483,6 +483,12 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("parameter_group_name") {
+		if err := rebootClusterNodesPendingParameterGroup(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("Error applying parameter group to DAX cluster (%s): %s", d.Id(), err)
+		}
+	}
+
 	return resourceClusterRead(d, meta)
 }
 
@@ -558,6 +564,84 @@ func resourceClusterDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// rebootClusterNodesPendingParameterGroup reboots, one at a time, the nodes
+// that DAX reports as needing a reboot for parameter group changes to take
+// effect. Rebooting nodes one at a time keeps multi-node clusters available.
+func rebootClusterNodesPendingParameterGroup(conn *dax.DAX, clusterID string, timeout time.Duration) error {
+	resp, err := conn.DescribeClusters(&dax.DescribeClustersInput{
+		ClusterNames: []*string{aws.String(clusterID)},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if len(resp.Clusters) == 0 || resp.Clusters[0].ParameterGroup == nil {
+		return nil
+	}
+
+	for _, nodeID := range resp.Clusters[0].ParameterGroup.NodeIdsToReboot {
+		log.Printf("[INFO] Rebooting DAX cluster (%s) node (%s) to apply parameter group", clusterID, aws.StringValue(nodeID))
+		_, err := conn.RebootNode(&dax.RebootNodeInput{
+			ClusterName: aws.String(clusterID),
+			NodeId:      nodeID,
+		})
+
+		if err != nil {
+			return fmt.Errorf("rebooting node (%s): %w", aws.StringValue(nodeID), err)
+		}
+
+		// The node can still report "available" shortly after RebootNode returns,
+		// so it must be seen as available on consecutive polls before moving on.
+		stateConf := &resource.StateChangeConf{
+			Pending:                   []string{"creating", "modifying", "rebooting"},
+			Target:                    []string{"available"},
+			Refresh:                   nodeStatusRefreshFunc(conn, clusterID, aws.StringValue(nodeID)),
+			Timeout:                   timeout,
+			MinTimeout:                10 * time.Second,
+			Delay:                     30 * time.Second,
+			ContinuousTargetOccurence: 3,
+		}
+
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("waiting for node (%s) reboot: %w", aws.StringValue(nodeID), err)
+		}
+	}
+
+	return nil
+}
+
+// nodeStatusRefreshFunc returns the status of the specified node of a DAX cluster.
+func nodeStatusRefreshFunc(conn *dax.DAX, clusterID, nodeID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeClusters(&dax.DescribeClustersInput{
+			ClusterNames: []*string{aws.String(clusterID)},
+		})
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, cluster := range resp.Clusters {
+			if aws.StringValue(cluster.ClusterName) != clusterID {
+				continue
+			}
+
+			for _, node := range cluster.Nodes {
+				if aws.StringValue(node.NodeId) != nodeID {
+					continue
+				}
+
+				log.Printf("[DEBUG] DAX cluster (%s) node (%s) status: %s", clusterID, nodeID, aws.StringValue(node.NodeStatus))
+
+				return node, aws.StringValue(node.NodeStatus), nil
+			}
+		}
+
+		return nil, "", fmt.Errorf("no node (%s) found in DAX cluster (%s)", nodeID, clusterID)
+	}
+}
+
 func clusterStateRefreshFunc(conn *dax.DAX, clusterID, givenState string, pending []string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		resp, err := conn.DescribeClusters(&dax.DescribeClustersInput{
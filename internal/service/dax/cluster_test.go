@@ -116,6 +116,37 @@ func TestAccDAXCluster_resize(t *testing.T) {
 	})
 }
 
+func TestAccDAXCluster_parameterGroup(t *testing.T) {
+	var dc dax.Cluster
+	rString := sdkacctest.RandString(10)
+	resourceName := "aws_dax_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, dax.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_basic(rString),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName, &dc),
+					resource.TestMatchResourceAttr(
+						resourceName, "parameter_group_name", regexp.MustCompile(`^default.dax`)),
+				),
+			},
+			{
+				Config: testAccClusterConfig_parameterGroup(rString),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName, &dc),
+					resource.TestCheckResourceAttrPair(resourceName, "parameter_group_name", "aws_dax_parameter_group.test", "name"),
+					testAccCheckClusterParameterGroupInSync(&dc),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDAXCluster_Encryption_disabled(t *testing.T) {
 	var dc dax.Cluster
 	rString := sdkacctest.RandString(10)
@@ -305,6 +336,22 @@ func testAccCheckClusterExists(n string, v *dax.Cluster) resource.TestCheckFunc
 	}
 }
 
+func testAccCheckClusterParameterGroupInSync(c *dax.Cluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if c.ParameterGroup != nil && len(c.ParameterGroup.NodeIdsToReboot) > 0 {
+			return fmt.Errorf("DAX cluster (%s) has nodes pending reboot: %s", aws.StringValue(c.ClusterName), aws.StringValueSlice(c.ParameterGroup.NodeIdsToReboot))
+		}
+
+		for _, n := range c.Nodes {
+			if v := aws.StringValue(n.ParameterGroupStatus); v != "in-sync" {
+				return fmt.Errorf("DAX cluster (%s) node (%s) parameter group status is %s", aws.StringValue(c.ClusterName), aws.StringValue(n.NodeId), v)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).DAXConn
 
@@ -373,6 +420,32 @@ resource "aws_dax_cluster" "test" {
 `, baseConfig, rString)
 }
 
+func testAccClusterConfig_parameterGroup(rString string) string {
+	return fmt.Sprintf(`%[1]s
+resource "aws_dax_parameter_group" "test" {
+  name = "tf-%[2]s"
+
+  parameters {
+    name  = "query-ttl-millis"
+    value = "100000"
+  }
+}
+
+resource "aws_dax_cluster" "test" {
+  cluster_name         = "tf-%[2]s"
+  iam_role_arn         = aws_iam_role.test.arn
+  node_type            = "dax.t2.small"
+  replication_factor   = 1
+  description          = "test cluster"
+  parameter_group_name = aws_dax_parameter_group.test.name
+
+  tags = {
+    foo = "bar"
+  }
+}
+`, baseConfig, rString)
+}
+
 func testAccClusterConfig_encryption(rString string, enabled bool) string {
 	return fmt.Sprintf(`%s
 resource "aws_dax_cluster" "test" {
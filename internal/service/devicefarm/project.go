@@ -1,15 +1,18 @@
 package devicefarm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/devicefarm"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -42,8 +45,37 @@ func ResourceProject() *schema.Resource {
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			"vpc_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Required: true,
+						},
+						"subnet_ids": {
+							Type:     schema.TypeSet,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Required: true,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
 		},
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			// A VPC configuration can be changed but not removed.
+			customdiff.ForceNewIfChange("vpc_config", isVPCConfigRemoved),
+		),
 	}
 }
 
@@ -61,6 +93,10 @@ func resourceProjectCreate(d *schema.ResourceData, meta interface{}) error {
 		input.DefaultJobTimeoutMinutes = aws.Int64(int64(v.(int)))
 	}
 
+	if v, ok := d.GetOk("vpc_config"); ok {
+		input.VpcConfig = expandProjectVPCConfig(v.([]interface{}))
+	}
+
 	log.Printf("[DEBUG] Creating DeviceFarm Project: %s", name)
 	out, err := conn.CreateProject(input)
 	if err != nil {
@@ -101,6 +137,9 @@ func resourceProjectRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("name", project.Name)
 	d.Set("arn", arn)
 	d.Set("default_job_timeout_minutes", project.DefaultJobTimeoutMinutes)
+	if err := d.Set("vpc_config", flattenProjectVPCConfig(project.VpcConfig)); err != nil {
+		return fmt.Errorf("error setting vpc_config: %w", err)
+	}
 
 	tags, err := ListTags(conn, arn)
 
@@ -138,6 +177,10 @@ func resourceProjectUpdate(d *schema.ResourceData, meta interface{}) error {
 			input.DefaultJobTimeoutMinutes = aws.Int64(int64(d.Get("default_job_timeout_minutes").(int)))
 		}
 
+		if d.HasChange("vpc_config") {
+			input.VpcConfig = expandProjectVPCConfig(d.Get("vpc_config").([]interface{}))
+		}
+
 		log.Printf("[DEBUG] Updating DeviceFarm Project: %s", d.Id())
 		_, err := conn.UpdateProject(input)
 		if err != nil {
@@ -174,3 +217,38 @@ func resourceProjectDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+func expandProjectVPCConfig(l []interface{}) *devicefarm.VpcConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &devicefarm.VpcConfig{
+		VpcId:            aws.String(m["vpc_id"].(string)),
+		SubnetIds:        flex.ExpandStringSet(m["subnet_ids"].(*schema.Set)),
+		SecurityGroupIds: flex.ExpandStringSet(m["security_group_ids"].(*schema.Set)),
+	}
+
+	return config
+}
+
+func flattenProjectVPCConfig(conf *devicefarm.VpcConfig) []interface{} {
+	if conf == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"vpc_id":             aws.StringValue(conf.VpcId),
+		"subnet_ids":         flex.FlattenStringSet(conf.SubnetIds),
+		"security_group_ids": flex.FlattenStringSet(conf.SecurityGroupIds),
+	}
+
+	return []interface{}{m}
+}
+
+// isVPCConfigRemoved returns whether a vpc_config block is being removed.
+func isVPCConfigRemoved(_ context.Context, old, new, _ interface{}) bool {
+	return len(old.([]interface{})) > 0 && len(new.([]interface{})) == 0
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/devicefarm"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -69,7 +70,11 @@ func ResourceTestGridProject() *schema.Resource {
 				},
 			},
 		},
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			// A VPC configuration can be changed but not removed.
+			customdiff.ForceNewIfChange("vpc_config", isVPCConfigRemoved),
+		),
 	}
 }
 
@@ -171,6 +176,10 @@ func resourceTestGridProjectUpdate(d *schema.ResourceData, meta interface{}) err
 			input.Description = aws.String(d.Get("description").(string))
 		}
 
+		if d.HasChange("vpc_config") {
+			input.VpcConfig = expandTestGridProjectVPCConfig(d.Get("vpc_config").([]interface{}))
+		}
+
 		log.Printf("[DEBUG] Updating DeviceFarm Test Grid Project: %s", d.Id())
 		_, err := conn.UpdateTestGridProject(input)
 		if err != nil {
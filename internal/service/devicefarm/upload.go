@@ -1,12 +1,17 @@
 package devicefarm
 
 import (
+	"errors"
 	"fmt"
 	"log"
+	"net/http"
+	"os"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/devicefarm"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -24,6 +29,10 @@ func ResourceUpload() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -53,6 +62,16 @@ func ResourceUpload() *schema.Resource {
 				Required:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"source": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"source_hash": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
 			"type": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -89,6 +108,16 @@ func resourceUploadCreate(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Successsfully Created DeviceFarm Upload: %s", arn)
 	d.SetId(arn)
 
+	if v, ok := d.GetOk("source"); ok {
+		if err := uploadFile(aws.StringValue(out.Upload.Url), v.(string), d.Get("content_type").(string)); err != nil {
+			return fmt.Errorf("error uploading DeviceFarm Upload (%s) content: %w", d.Id(), err)
+		}
+
+		if _, err := waitUploadSucceeded(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("error waiting for DeviceFarm Upload (%s) to succeed: %w", d.Id(), err)
+		}
+	}
+
 	return resourceUploadRead(d, meta)
 }
 
@@ -168,3 +197,80 @@ func resourceUploadDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+// uploadFile sends the contents of the local file at path to the pre-signed URL
+// returned when the upload was created.
+func uploadFile(url, path, contentType string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("error opening file (%s): %w", path, err)
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("error reading file (%s): %w", path, err)
+	}
+
+	request, err := http.NewRequest(http.MethodPut, url, file)
+	if err != nil {
+		return fmt.Errorf("error creating HTTP request: %w", err)
+	}
+
+	// The pre-signed URL is signed for the content type the upload was created with.
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	request.Header.Set("Content-Type", contentType)
+	request.ContentLength = info.Size()
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("error making HTTP request: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("expected HTTP status code %d, received: %d", http.StatusOK, response.StatusCode)
+	}
+
+	return nil
+}
+
+func statusUpload(conn *devicefarm.DeviceFarm, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindUploadByARN(conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitUploadSucceeded(conn *devicefarm.DeviceFarm, arn string, timeout time.Duration) (*devicefarm.Upload, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{devicefarm.UploadStatusInitialized, devicefarm.UploadStatusProcessing},
+		Target:     []string{devicefarm.UploadStatusSucceeded},
+		Refresh:    statusUpload(conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*devicefarm.Upload); ok {
+		if aws.StringValue(output.Status) == devicefarm.UploadStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -200,3 +200,19 @@ func statusContributorInsights(ctx context.Context, conn *dynamodb.DynamoDB, tab
 		return insight, aws.StringValue(insight.ContributorInsightsStatus), nil
 	}
 }
+
+func statusImport(conn *dynamodb.DynamoDB, importARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findImportByARN(conn, importARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ImportStatus), nil
+	}
+}
@@ -187,6 +187,86 @@ func ResourceTable() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"import_table": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"local_secondary_index", "restore_source_name"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"input_compression_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      dynamodb.InputCompressionTypeNone,
+							ValidateFunc: validation.StringInSlice(dynamodb.InputCompressionType_Values(), false),
+						},
+						"input_format": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(dynamodb.InputFormat_Values(), false),
+						},
+						"input_format_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"csv": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"delimiter": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"header_list": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"s3_bucket_source": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"bucket_owner": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"key_prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"local_secondary_index": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -458,6 +538,96 @@ func resourceTableCreate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			return create.Error(names.DynamoDB, create.ErrActionCreating, ResNameTable, tableName, err)
 		}
+	} else if v, ok := d.GetOk("import_table"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input := expandImportTable(v.([]interface{})[0].(map[string]interface{}))
+
+		billingMode := d.Get("billing_mode").(string)
+
+		capacityMap := map[string]interface{}{
+			"write_capacity": d.Get("write_capacity"),
+			"read_capacity":  d.Get("read_capacity"),
+		}
+
+		input.TableCreationParameters = &dynamodb.TableCreationParameters{
+			BillingMode:           aws.String(billingMode),
+			KeySchema:             expandKeySchema(keySchemaMap),
+			ProvisionedThroughput: expandProvisionedThroughput(capacityMap, billingMode),
+			TableName:             aws.String(tableName),
+		}
+
+		if v, ok := d.GetOk("attribute"); ok {
+			aSet := v.(*schema.Set)
+			input.TableCreationParameters.AttributeDefinitions = expandAttributes(aSet.List())
+		}
+
+		if v, ok := d.GetOk("global_secondary_index"); ok {
+			globalSecondaryIndexes := []*dynamodb.GlobalSecondaryIndex{}
+			gsiSet := v.(*schema.Set)
+
+			for _, gsiObject := range gsiSet.List() {
+				gsi := gsiObject.(map[string]interface{})
+				if err := validateGSIProvisionedThroughput(gsi, billingMode); err != nil {
+					return create.Error(names.DynamoDB, create.ErrActionCreating, ResNameTable, tableName, err)
+				}
+
+				gsiObject := expandGlobalSecondaryIndex(gsi, billingMode)
+				globalSecondaryIndexes = append(globalSecondaryIndexes, gsiObject)
+			}
+			input.TableCreationParameters.GlobalSecondaryIndexes = globalSecondaryIndexes
+		}
+
+		if v, ok := d.GetOk("server_side_encryption"); ok {
+			input.TableCreationParameters.SSESpecification = expandEncryptAtRestOptions(v.([]interface{}))
+		}
+
+		output, err := conn.ImportTable(input)
+
+		if err != nil {
+			return create.Error(names.DynamoDB, create.ErrActionCreating, ResNameTable, tableName, err)
+		}
+
+		importARN := aws.StringValue(output.ImportTableDescription.ImportArn)
+
+		importDescription, err := waitImportComplete(conn, importARN, d.Timeout(schema.TimeoutCreate))
+
+		if err != nil {
+			return create.Error(names.DynamoDB, create.ErrActionWaitingForCreation, ResNameTable, tableName, fmt.Errorf("import (%s): %w", importARN, err))
+		}
+
+		// ImportTable doesn't accept tags, stream or table class settings, so apply them once the table exists.
+		if len(tags) > 0 {
+			if err := UpdateTags(conn, aws.StringValue(importDescription.TableArn), nil, tags.IgnoreAWS()); err != nil {
+				return create.Error(names.DynamoDB, create.ErrActionCreating, ResNameTable, tableName, fmt.Errorf("tags: %w", err))
+			}
+		}
+
+		updateInput := &dynamodb.UpdateTableInput{
+			TableName: aws.String(tableName),
+		}
+		var update bool
+
+		if v, ok := d.GetOk("stream_enabled"); ok {
+			updateInput.StreamSpecification = &dynamodb.StreamSpecification{
+				StreamEnabled:  aws.Bool(v.(bool)),
+				StreamViewType: aws.String(d.Get("stream_view_type").(string)),
+			}
+			update = true
+		}
+
+		if v, ok := d.GetOk("table_class"); ok && v.(string) != dynamodb.TableClassStandard {
+			updateInput.TableClass = aws.String(v.(string))
+			update = true
+		}
+
+		if update {
+			if _, err := waitTableActive(conn, tableName, d.Timeout(schema.TimeoutCreate)); err != nil {
+				return create.Error(names.DynamoDB, create.ErrActionWaitingForCreation, ResNameTable, tableName, err)
+			}
+
+			if _, err := conn.UpdateTable(updateInput); err != nil {
+				return create.Error(names.DynamoDB, create.ErrActionCreating, ResNameTable, tableName, err)
+			}
+		}
 	} else {
 		input := &dynamodb.CreateTableInput{
 			BillingMode: aws.String(d.Get("billing_mode").(string)),
@@ -1923,6 +2093,68 @@ func expandEncryptAtRestOptions(vOptions []interface{}) *dynamodb.SSESpecificati
 	return options
 }
 
+func expandImportTable(data map[string]interface{}) *dynamodb.ImportTableInput {
+	a := &dynamodb.ImportTableInput{
+		ClientToken: aws.String(resource.UniqueId()),
+	}
+
+	if v, ok := data["input_compression_type"].(string); ok && v != "" {
+		a.InputCompressionType = aws.String(v)
+	}
+
+	if v, ok := data["input_format"].(string); ok && v != "" {
+		a.InputFormat = aws.String(v)
+	}
+
+	if v, ok := data["input_format_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		a.InputFormatOptions = expandInputFormatOptions(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := data["s3_bucket_source"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		a.S3BucketSource = expandS3BucketSource(v[0].(map[string]interface{}))
+	}
+
+	return a
+}
+
+func expandInputFormatOptions(data map[string]interface{}) *dynamodb.InputFormatOptions {
+	a := &dynamodb.InputFormatOptions{}
+
+	if v, ok := data["csv"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		a.Csv = &dynamodb.CsvOptions{}
+
+		csv := v[0].(map[string]interface{})
+
+		if v, ok := csv["delimiter"].(string); ok && v != "" {
+			a.Csv.Delimiter = aws.String(v)
+		}
+
+		if v, ok := csv["header_list"].([]interface{}); ok && len(v) > 0 {
+			a.Csv.HeaderList = flex.ExpandStringList(v)
+		}
+	}
+
+	return a
+}
+
+func expandS3BucketSource(data map[string]interface{}) *dynamodb.S3BucketSource {
+	a := &dynamodb.S3BucketSource{}
+
+	if v, ok := data["bucket"].(string); ok && v != "" {
+		a.S3Bucket = aws.String(v)
+	}
+
+	if v, ok := data["bucket_owner"].(string); ok && v != "" {
+		a.S3BucketOwner = aws.String(v)
+	}
+
+	if v, ok := data["key_prefix"].(string); ok && v != "" {
+		a.S3KeyPrefix = aws.String(v)
+	}
+
+	return a
+}
+
 // validators
 
 func validateTableAttributes(d *schema.ResourceDiff) error {
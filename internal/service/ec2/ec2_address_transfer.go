@@ -0,0 +1,139 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAddressTransfer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAddressTransferCreate,
+		Read:   resourceAddressTransferRead,
+		Delete: resourceAddressTransferDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"address_transfer_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"allocation_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"public_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"transfer_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"transfer_offer_accepted_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"transfer_offer_expiration_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAddressTransferCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	allocationID := d.Get("allocation_id").(string)
+	input := &ec2.EnableAddressTransferInput{
+		AllocationId:      aws.String(allocationID),
+		TransferAccountId: aws.String(d.Get("transfer_account_id").(string)),
+	}
+
+	log.Printf("[DEBUG] Enabling EC2 Address Transfer: %s", input)
+	_, err := conn.EnableAddressTransfer(input)
+
+	if err != nil {
+		return fmt.Errorf("enabling EC2 Address Transfer (%s): %w", allocationID, err)
+	}
+
+	d.SetId(allocationID)
+
+	return resourceAddressTransferRead(d, meta)
+}
+
+func resourceAddressTransferRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(propagationTimeout, func() (interface{}, error) {
+		return FindAddressTransferByAllocationID(conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Address Transfer (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 Address Transfer (%s): %w", d.Id(), err)
+	}
+
+	transfer := outputRaw.(*ec2.AddressTransfer)
+
+	d.Set("address_transfer_status", transfer.AddressTransferStatus)
+	d.Set("allocation_id", transfer.AllocationId)
+	d.Set("public_ip", transfer.PublicIp)
+	d.Set("transfer_account_id", transfer.TransferAccountId)
+	if v := transfer.TransferOfferAcceptedTimestamp; v != nil {
+		d.Set("transfer_offer_accepted_timestamp", aws.TimeValue(v).Format(time.RFC3339))
+	} else {
+		d.Set("transfer_offer_accepted_timestamp", nil)
+	}
+	if v := transfer.TransferOfferExpirationTimestamp; v != nil {
+		d.Set("transfer_offer_expiration_timestamp", aws.TimeValue(v).Format(time.RFC3339))
+	} else {
+		d.Set("transfer_offer_expiration_timestamp", nil)
+	}
+
+	return nil
+}
+
+func resourceAddressTransferDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	// Once accepted the address belongs to the transfer account and there is nothing to disable.
+	if d.Get("address_transfer_status").(string) == ec2.AddressTransferStatusAccepted {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Disabling EC2 Address Transfer: %s", d.Id())
+	_, err := conn.DisableAddressTransfer(&ec2.DisableAddressTransferInput{
+		AllocationId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidAllocationIDNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("disabling EC2 Address Transfer (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceAddressTransferAccepter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAddressTransferAccepterCreate,
+		Read:   resourceAddressTransferAccepterRead,
+		Delete: resourceAddressTransferAccepterDelete,
+
+		Schema: map[string]*schema.Schema{
+			"address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+			"allocation_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAddressTransferAccepterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	address := d.Get("address").(string)
+	input := &ec2.AcceptAddressTransferInput{
+		Address: aws.String(address),
+	}
+
+	log.Printf("[DEBUG] Accepting EC2 Address Transfer: %s", input)
+	_, err := conn.AcceptAddressTransfer(input)
+
+	if err != nil {
+		return fmt.Errorf("accepting EC2 Address Transfer (%s): %w", address, err)
+	}
+
+	outputRaw, err := tfresource.RetryWhenNotFound(propagationTimeout, func() (interface{}, error) {
+		return FindEIPByPublicIP(conn, address)
+	})
+
+	if err != nil {
+		return fmt.Errorf("waiting for EC2 EIP (%s) transfer: %w", address, err)
+	}
+
+	d.SetId(aws.StringValue(outputRaw.(*ec2.Address).AllocationId))
+
+	return resourceAddressTransferAccepterRead(d, meta)
+}
+
+func resourceAddressTransferAccepterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	address, err := FindEIPByAllocationID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 EIP (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 EIP (%s): %w", d.Id(), err)
+	}
+
+	d.Set("address", address.PublicIp)
+	d.Set("allocation_id", address.AllocationId)
+	d.Set("domain", address.Domain)
+
+	return nil
+}
+
+func resourceAddressTransferAccepterDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARN] EC2 Address Transfer Accepter (%s) only removed from state; the Elastic IP address is not released", d.Id())
+
+	return nil
+}
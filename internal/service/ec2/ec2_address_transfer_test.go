@@ -0,0 +1,199 @@
+package ec2_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEC2AddressTransfer_basic(t *testing.T) {
+	var v ec2.AddressTransfer
+	resourceName := "aws_ec2_address_transfer.test"
+	eipResourceName := "aws_eip.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		CheckDestroy:             testAccCheckAddressTransferDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAddressTransferConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAddressTransferExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "address_transfer_status", "pending"),
+					resource.TestCheckResourceAttrPair(resourceName, "allocation_id", eipResourceName, "allocation_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "public_ip", eipResourceName, "public_ip"),
+					resource.TestCheckResourceAttrPair(resourceName, "transfer_account_id", "data.aws_caller_identity.peer", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "transfer_offer_accepted_timestamp", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "transfer_offer_expiration_timestamp"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2AddressTransfer_disappears(t *testing.T) {
+	var v ec2.AddressTransfer
+	resourceName := "aws_ec2_address_transfer.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		CheckDestroy:             testAccCheckAddressTransferDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAddressTransferConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAddressTransferExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceAddressTransfer(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2AddressTransfer_accepter(t *testing.T) {
+	var v ec2.AddressTransfer
+	resourceName := "aws_ec2_address_transfer.test"
+	accepterResourceName := "aws_ec2_address_transfer_accepter.test"
+	eipResourceName := "aws_eip.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		CheckDestroy:             testAccCheckAddressTransferDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAddressTransferConfig_accepter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAddressTransferExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(accepterResourceName, "address", eipResourceName, "public_ip"),
+					resource.TestMatchResourceAttr(accepterResourceName, "allocation_id", regexp.MustCompile(`^eipalloc-.+`)),
+					resource.TestCheckResourceAttr(accepterResourceName, "domain", "vpc"),
+				),
+				// The source account's aws_eip no longer exists once the transfer is accepted.
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAddressTransferExists(n string, v *ec2.AddressTransfer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Address Transfer ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := tfec2.FindAddressTransferByAllocationID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAddressTransferDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_address_transfer" {
+			continue
+		}
+
+		output, err := tfec2.FindAddressTransferByAllocationID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		// Accepted transfers remain visible to the source account.
+		if aws.StringValue(output.AddressTransferStatus) == ec2.AddressTransferStatusAccepted {
+			continue
+		}
+
+		return fmt.Errorf("EC2 Address Transfer %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAddressTransferConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_caller_identity" "peer" {
+  provider = "awsalternate"
+}
+
+resource "aws_eip" "test" {
+  vpc = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_address_transfer" "test" {
+  allocation_id       = aws_eip.test.allocation_id
+  transfer_account_id = data.aws_caller_identity.peer.account_id
+}
+`, rName))
+}
+
+func testAccAddressTransferConfig_basic(rName string) string {
+	return testAccAddressTransferConfig_base(rName)
+}
+
+func testAccAddressTransferConfig_accepter(rName string) string {
+	return acctest.ConfigCompose(testAccAddressTransferConfig_base(rName), `
+resource "aws_ec2_address_transfer_accepter" "test" {
+  provider = "awsalternate"
+
+  address = aws_ec2_address_transfer.test.public_ip
+}
+`)
+}
@@ -0,0 +1,199 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceBYOIPCIDR() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBYOIPCIDRCreate,
+		Read:   resourceBYOIPCIDRRead,
+		Delete: resourceBYOIPCIDRDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(3 * time.Hour),
+			Delete: schema.DefaultTimeout(3 * time.Hour),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cidr": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.Any(
+					verify.ValidIPv4CIDRNetworkAddress,
+					verify.ValidIPv6CIDRNetworkAddress,
+				),
+			},
+			"cidr_authorization_context": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"message": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"signature": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"multi_region": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"pool_tags": tftags.TagsSchemaForceNew(),
+			"publicly_advertisable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBYOIPCIDRCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	cidrBlock := d.Get("cidr").(string)
+	input := &ec2.ProvisionByoipCidrInput{
+		Cidr:                 aws.String(cidrBlock),
+		PubliclyAdvertisable: aws.Bool(d.Get("publicly_advertisable").(bool)),
+	}
+
+	if v, ok := d.GetOk("cidr_authorization_context"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.CidrAuthorizationContext = expandCIDRAuthorizationContext(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("multi_region"); ok {
+		input.MultiRegion = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("pool_tags"); ok && len(v.(map[string]interface{})) > 0 {
+		resourceType := ec2.ResourceTypeIpv4poolEc2
+		if ip, _, _ := net.ParseCIDR(cidrBlock); ip != nil && ip.To4() == nil {
+			resourceType = ec2.ResourceTypeIpv6poolEc2
+		}
+
+		input.PoolTagSpecifications = tagSpecificationsFromKeyValueTags(tftags.New(v).IgnoreAWS(), resourceType)
+	}
+
+	log.Printf("[DEBUG] Provisioning EC2 BYOIP CIDR: %s", input)
+	output, err := conn.ProvisionByoipCidr(input)
+
+	if err != nil {
+		return fmt.Errorf("provisioning EC2 BYOIP CIDR (%s): %w", cidrBlock, err)
+	}
+
+	d.SetId(aws.StringValue(output.ByoipCidr.Cidr))
+
+	if _, err := WaitBYOIPCIDRProvisioned(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("waiting for EC2 BYOIP CIDR (%s) provision: %w", d.Id(), err)
+	}
+
+	return resourceBYOIPCIDRRead(d, meta)
+}
+
+func resourceBYOIPCIDRRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	byoipCIDR, err := FindBYOIPCIDRByCIDR(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 BYOIP CIDR (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 BYOIP CIDR (%s): %w", d.Id(), err)
+	}
+
+	d.Set("cidr", byoipCIDR.Cidr)
+	d.Set("description", byoipCIDR.Description)
+	d.Set("state", byoipCIDR.State)
+	d.Set("status_message", byoipCIDR.StatusMessage)
+
+	return nil
+}
+
+func resourceBYOIPCIDRDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	log.Printf("[DEBUG] Deprovisioning EC2 BYOIP CIDR: %s", d.Id())
+	_, err := conn.DeprovisionByoipCidr(&ec2.DeprovisionByoipCidrInput{
+		Cidr: aws.String(d.Id()),
+	})
+
+	// IncorrectState error can mean: State = "deprovisioned" || State = "pending-deprovision".
+	if err != nil && !tfawserr.ErrCodeEquals(err, errCodeIncorrectState) {
+		return fmt.Errorf("deprovisioning EC2 BYOIP CIDR (%s): %w", d.Id(), err)
+	}
+
+	if _, err := WaitBYOIPCIDRDeprovisioned(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("waiting for EC2 BYOIP CIDR (%s) deprovision: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCIDRAuthorizationContext(tfMap map[string]interface{}) *ec2.CidrAuthorizationContext {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.CidrAuthorizationContext{}
+
+	if v, ok := tfMap["message"].(string); ok && v != "" {
+		apiObject.Message = aws.String(v)
+	}
+
+	if v, ok := tfMap["signature"].(string); ok && v != "" {
+		apiObject.Signature = aws.String(v)
+	}
+
+	return apiObject
+}
@@ -0,0 +1,118 @@
+package ec2_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// BYOIP CIDRs require an address range registered in an RIR with a ROA and signed
+// authorization message, so the test is driven entirely by environment variables.
+func TestAccEC2BYOIPCIDR_basic(t *testing.T) {
+	if os.Getenv("EC2_BYOIP_CIDR") == "" || os.Getenv("EC2_BYOIP_MESSAGE") == "" || os.Getenv("EC2_BYOIP_SIGNATURE") == "" {
+		t.Skip("Environment variable EC2_BYOIP_CIDR, EC2_BYOIP_MESSAGE, or EC2_BYOIP_SIGNATURE is not set")
+	}
+
+	var v ec2.ByoipCidr
+	resourceName := "aws_ec2_byoip_cidr.test"
+	cidr := os.Getenv("EC2_BYOIP_CIDR")
+	message := os.Getenv("EC2_BYOIP_MESSAGE")
+	signature := os.Getenv("EC2_BYOIP_SIGNATURE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBYOIPCIDRDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBYOIPCIDRConfig_basic(cidr, message, signature),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBYOIPCIDRExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "cidr", cidr),
+					resource.TestCheckResourceAttr(resourceName, "description", "terraform-acc-test"),
+					resource.TestCheckResourceAttr(resourceName, "publicly_advertisable", "false"),
+					resource.TestCheckResourceAttr(resourceName, "state", ec2.ByoipCidrStateProvisionedNotPubliclyAdvertisable),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cidr_authorization_context", "multi_region", "pool_tags", "publicly_advertisable"},
+			},
+		},
+	})
+}
+
+func testAccCheckBYOIPCIDRExists(n string, v *ec2.ByoipCidr) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 BYOIP CIDR ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := tfec2.FindBYOIPCIDRByCIDR(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckBYOIPCIDRDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_byoip_cidr" {
+			continue
+		}
+
+		_, err := tfec2.FindBYOIPCIDRByCIDR(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 BYOIP CIDR %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccBYOIPCIDRConfig_basic(cidr, message, signature string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_byoip_cidr" "test" {
+  cidr                  = %[1]q
+  description           = "terraform-acc-test"
+  publicly_advertisable = false
+
+  cidr_authorization_context {
+    message   = %[2]q
+    signature = %[3]q
+  }
+}
+`, cidr, message, signature)
+}
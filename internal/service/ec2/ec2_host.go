@@ -62,10 +62,15 @@ func ResourceHost() *schema.Resource {
 				Optional:     true,
 				ExactlyOneOf: []string{"instance_family", "instance_type"},
 			},
+			"member_of_service_linked_resource_group": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"outpost_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
 			},
 			"owner_id": {
 				Type:     schema.TypeString,
@@ -151,6 +156,7 @@ func resourceHostRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("host_recovery", host.HostRecovery)
 	d.Set("instance_family", host.HostProperties.InstanceFamily)
 	d.Set("instance_type", host.HostProperties.InstanceType)
+	d.Set("member_of_service_linked_resource_group", host.MemberOfServiceLinkedResourceGroup)
 	d.Set("outpost_arn", host.OutpostArn)
 	d.Set("owner_id", host.OwnerId)
 
@@ -56,6 +56,10 @@ func DataSourceHost() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"member_of_service_linked_resource_group": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"outpost_arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -104,6 +108,7 @@ func dataSourceHostRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("host_recovery", host.HostRecovery)
 	d.Set("instance_family", host.HostProperties.InstanceFamily)
 	d.Set("instance_type", host.HostProperties.InstanceType)
+	d.Set("member_of_service_linked_resource_group", host.MemberOfServiceLinkedResourceGroup)
 	d.Set("outpost_arn", host.OutpostArn)
 	d.Set("owner_id", host.OwnerId)
 	d.Set("sockets", host.HostProperties.Sockets)
@@ -53,6 +53,7 @@ func ResourcePlacementGroup() *schema.Resource {
 			"spread_level": {
 				Type:         schema.TypeString,
 				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice(ec2.SpreadLevel_Values(), false),
 			},
@@ -145,15 +146,19 @@ func resourcePlacementGroupRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error setting tags_all: %w", err)
 	}
 
-	arn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   ec2.ServiceName,
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("placement-group/%s", d.Id()),
-	}.String()
+	if v := aws.StringValue(pg.GroupArn); v != "" {
+		d.Set("arn", v)
+	} else {
+		arn := arn.ARN{
+			Partition: meta.(*conns.AWSClient).Partition,
+			Service:   ec2.ServiceName,
+			Region:    meta.(*conns.AWSClient).Region,
+			AccountID: meta.(*conns.AWSClient).AccountID,
+			Resource:  fmt.Sprintf("placement-group/%s", d.Id()),
+		}.String()
 
-	d.Set("arn", arn)
+		d.Set("arn", arn)
+	}
 
 	return nil
 }
@@ -198,16 +203,41 @@ func resourcePlacementGroupDelete(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourcePlacementGroupCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-	if diff.Id() == "" {
-		if partitionCount, strategy := diff.Get("partition_count").(int), diff.Get("strategy").(string); partitionCount > 0 && strategy != ec2.PlacementGroupStrategyPartition {
+	strategy := diff.Get("strategy").(string)
+	rawConfig := diff.GetRawConfig()
+	configured := func(k string) bool {
+		if !rawConfig.IsKnown() || rawConfig.IsNull() {
+			return diff.Id() == ""
+		}
+
+		v := rawConfig.GetAttr(k)
+
+		return v.IsKnown() && !v.IsNull()
+	}
+
+	if partitionCount := diff.Get("partition_count").(int); partitionCount > 0 && strategy != ec2.PlacementGroupStrategyPartition {
+		if configured("partition_count") {
 			return fmt.Errorf("partition_count must not be set when strategy = %q", strategy)
 		}
+
+		// The value computed for the previous strategy is not carried over to the replacement.
+		if diff.HasChange("strategy") {
+			if err := diff.SetNewComputed("partition_count"); err != nil {
+				return err
+			}
+		}
 	}
 
-	if diff.Id() == "" {
-		if spreadLevel, strategy := diff.Get("spread_level").(string), diff.Get("strategy").(string); spreadLevel != "" && strategy != ec2.PlacementGroupStrategySpread {
+	if spreadLevel := diff.Get("spread_level").(string); spreadLevel != "" && strategy != ec2.PlacementGroupStrategySpread {
+		if configured("spread_level") {
 			return fmt.Errorf("spread_level must not be set when strategy = %q", strategy)
 		}
+
+		if diff.HasChange("strategy") {
+			if err := diff.SetNewComputed("spread_level"); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
@@ -169,6 +169,76 @@ func TestAccEC2PlacementGroup_spreadLevel(t *testing.T) {
 	})
 }
 
+func TestAccEC2PlacementGroup_defaultSpreadLevel(t *testing.T) {
+	var pg ec2.PlacementGroup
+	resourceName := "aws_placement_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPlacementGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlacementGroupConfig_strategy(rName, "spread"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlacementGroupExists(resourceName, &pg),
+					resource.TestCheckResourceAttr(resourceName, "partition_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, "spread_level", "rack"),
+					resource.TestCheckResourceAttr(resourceName, "strategy", "spread"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2PlacementGroup_strategyUpdate(t *testing.T) {
+	var pg ec2.PlacementGroup
+	resourceName := "aws_placement_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPlacementGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlacementGroupConfig_partitionCount(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlacementGroupExists(resourceName, &pg),
+					resource.TestCheckResourceAttr(resourceName, "partition_count", "7"),
+					resource.TestCheckResourceAttr(resourceName, "strategy", "partition"),
+				),
+			},
+			{
+				Config: testAccPlacementGroupConfig_strategy(rName, "spread"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlacementGroupExists(resourceName, &pg),
+					resource.TestCheckResourceAttr(resourceName, "partition_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, "spread_level", "rack"),
+					resource.TestCheckResourceAttr(resourceName, "strategy", "spread"),
+				),
+			},
+			{
+				Config: testAccPlacementGroupConfig_strategy(rName, "cluster"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlacementGroupExists(resourceName, &pg),
+					resource.TestCheckResourceAttr(resourceName, "partition_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, "spread_level", ""),
+					resource.TestCheckResourceAttr(resourceName, "strategy", "cluster"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckPlacementGroupDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
 
@@ -273,3 +343,12 @@ resource "aws_placement_group" "test" {
 }
 `, rName)
 }
+
+func testAccPlacementGroupConfig_strategy(rName, strategy string) string {
+	return fmt.Sprintf(`
+resource "aws_placement_group" "test" {
+  name     = %[1]q
+  strategy = %[2]q
+}
+`, rName, strategy)
+}
@@ -810,6 +810,138 @@ func FindEIPByPublicIP(conn *ec2.EC2, ip string) (*ec2.Address, error) {
 	return output, nil
 }
 
+func FindAddressTransfer(conn *ec2.EC2, input *ec2.DescribeAddressTransfersInput) (*ec2.AddressTransfer, error) {
+	output, err := FindAddressTransfers(conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindAddressTransfers(conn *ec2.EC2, input *ec2.DescribeAddressTransfersInput) ([]*ec2.AddressTransfer, error) {
+	var output []*ec2.AddressTransfer
+
+	err := conn.DescribeAddressTransfersPages(input, func(page *ec2.DescribeAddressTransfersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AddressTransfers {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidAllocationIDNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindAddressTransferByAllocationID(conn *ec2.EC2, id string) (*ec2.AddressTransfer, error) {
+	input := &ec2.DescribeAddressTransfersInput{
+		AllocationIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindAddressTransfer(conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status := aws.StringValue(output.AddressTransferStatus); status == ec2.AddressTransferStatusDisabled {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.AllocationId) != id {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindBYOIPCIDRs(conn *ec2.EC2, input *ec2.DescribeByoipCidrsInput) ([]*ec2.ByoipCidr, error) {
+	var output []*ec2.ByoipCidr
+
+	err := conn.DescribeByoipCidrsPages(input, func(page *ec2.DescribeByoipCidrsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ByoipCidrs {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindBYOIPCIDRByCIDR(conn *ec2.EC2, cidrBlock string) (*ec2.ByoipCidr, error) {
+	input := &ec2.DescribeByoipCidrsInput{
+		MaxResults: aws.Int64(100),
+	}
+
+	output, err := FindBYOIPCIDRs(conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range output {
+		if aws.StringValue(v.Cidr) != cidrBlock {
+			continue
+		}
+
+		if state := aws.StringValue(v.State); state == ec2.ByoipCidrStateDeprovisioned {
+			return nil, &resource.NotFoundError{
+				Message:     state,
+				LastRequest: input,
+			}
+		}
+
+		return v, nil
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest: input,
+	}
+}
+
 func FindHostByID(conn *ec2.EC2, id string) (*ec2.Host, error) {
 	input := &ec2.DescribeHostsInput{
 		HostIds: aws.StringSlice([]string{id}),
@@ -1054,6 +1054,22 @@ func StatusVPNConnectionRouteState(conn *ec2.EC2, vpnConnectionID, cidrBlock str
 	}
 }
 
+func StatusBYOIPCIDRState(conn *ec2.EC2, cidrBlock string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindBYOIPCIDRByCIDR(conn, cidrBlock)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
 func StatusHostState(conn *ec2.EC2, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindHostByID(conn, id)
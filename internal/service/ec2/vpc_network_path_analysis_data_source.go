@@ -0,0 +1,253 @@
+package ec2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	networkPathAnalysisCleanupTimeout = 5 * time.Minute
+	networkPathAnalysisReadTimeout    = 10 * time.Minute
+)
+
+func DataSourceNetworkPathAnalysis() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceNetworkPathAnalysisRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(networkPathAnalysisReadTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"blocking_component_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"blocking_component_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"destination": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"destination_ip": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"destination_port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"explanation_codes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"explanations": networkInsightsAnalysisExplanationsSchema,
+			"filter_in_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"forward_path_components": networkInsightsAnalysisPathComponentsSchema,
+			"path_found": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ec2.ProtocolTcp,
+				ValidateFunc: validation.StringInSlice(ec2.Protocol_Values(), false),
+			},
+			"return_path_components": networkInsightsAnalysisPathComponentsSchema,
+			"source": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"source_ip": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"warning_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNetworkPathAnalysisRead(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	pathInput := &ec2.CreateNetworkInsightsPathInput{
+		Destination: aws.String(d.Get("destination").(string)),
+		Protocol:    aws.String(d.Get("protocol").(string)),
+		Source:      aws.String(d.Get("source").(string)),
+	}
+
+	if v, ok := d.GetOk("destination_ip"); ok {
+		pathInput.DestinationIp = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("destination_port"); ok {
+		pathInput.DestinationPort = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("source_ip"); ok {
+		pathInput.SourceIp = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Network Insights Path: %s", pathInput)
+	pathOutput, err := conn.CreateNetworkInsightsPathWithContext(ctx, pathInput)
+
+	if err != nil {
+		return diag.Errorf("creating EC2 Network Insights Path: %s", err)
+	}
+
+	pathID := aws.StringValue(pathOutput.NetworkInsightsPath.NetworkInsightsPathId)
+
+	// The path and analysis only exist for the duration of the read.
+	defer func() {
+		diags = append(diags, deleteNetworkInsightsPath(conn, pathID)...)
+	}()
+
+	analysisInput := &ec2.StartNetworkInsightsAnalysisInput{
+		NetworkInsightsPathId: aws.String(pathID),
+	}
+
+	if v, ok := d.GetOk("filter_in_arns"); ok && v.(*schema.Set).Len() > 0 {
+		analysisInput.FilterInArns = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Network Insights Analysis: %s", analysisInput)
+	analysisOutput, err := conn.StartNetworkInsightsAnalysisWithContext(ctx, analysisInput)
+
+	if err != nil {
+		return diag.Errorf("creating EC2 Network Insights Analysis (%s): %s", pathID, err)
+	}
+
+	analysisID := aws.StringValue(analysisOutput.NetworkInsightsAnalysis.NetworkInsightsAnalysisId)
+
+	defer func() {
+		diags = append(diags, deleteNetworkInsightsAnalysis(conn, analysisID)...)
+	}()
+
+	output, err := WaitNetworkInsightsAnalysisCreated(ctx, conn, analysisID, d.Timeout(schema.TimeoutRead))
+
+	if err != nil {
+		return diag.Errorf("waiting for EC2 Network Insights Analysis (%s) create: %s", analysisID, err)
+	}
+
+	d.SetId(analysisID)
+
+	var blockingComponentARN, blockingComponentID string
+	var explanationCodes []string
+	for _, v := range output.Explanations {
+		if v == nil {
+			continue
+		}
+
+		if v := v.Component; v != nil && blockingComponentID == "" {
+			blockingComponentARN = aws.StringValue(v.Arn)
+			blockingComponentID = aws.StringValue(v.Id)
+		}
+
+		if v := v.ExplanationCode; v != nil {
+			explanationCodes = append(explanationCodes, aws.StringValue(v))
+		}
+	}
+
+	d.Set("blocking_component_arn", blockingComponentARN)
+	d.Set("blocking_component_id", blockingComponentID)
+	d.Set("explanation_codes", explanationCodes)
+	if err := d.Set("explanations", flattenExplanations(output.Explanations)); err != nil {
+		return diag.Errorf("setting explanations: %s", err)
+	}
+	if err := d.Set("forward_path_components", flattenPathComponents(output.ForwardPathComponents)); err != nil {
+		return diag.Errorf("setting forward_path_components: %s", err)
+	}
+	d.Set("path_found", output.NetworkPathFound)
+	if err := d.Set("return_path_components", flattenPathComponents(output.ReturnPathComponents)); err != nil {
+		return diag.Errorf("setting return_path_components: %s", err)
+	}
+	d.Set("status", output.Status)
+	d.Set("status_message", output.StatusMessage)
+	d.Set("warning_message", output.WarningMessage)
+
+	return nil
+}
+
+// deleteNetworkInsightsAnalysis deletes the analysis, returning any failure as a warning.
+// A fresh context is used as the read context may already have been canceled or timed out.
+func deleteNetworkInsightsAnalysis(conn *ec2.EC2, id string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ctx, cancel := context.WithTimeout(context.Background(), networkPathAnalysisCleanupTimeout)
+	defer cancel()
+
+	// A running analysis cannot be stopped or deleted, so wait for it to complete first.
+	if err := WaitNetworkInsightsAnalysisCompleted(ctx, conn, id, networkPathAnalysisCleanupTimeout); err != nil {
+		if tfresource.NotFound(err) {
+			return diags
+		}
+
+		return errs.AppendWarningf(diags, "waiting for EC2 Network Insights Analysis (%s) to complete before deletion, it must be deleted manually: %s", id, err)
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Network Insights Analysis: %s", id)
+	_, err := conn.DeleteNetworkInsightsAnalysisWithContext(ctx, &ec2.DeleteNetworkInsightsAnalysisInput{
+		NetworkInsightsAnalysisId: aws.String(id),
+	})
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, errCodeInvalidNetworkInsightsAnalysisIdNotFound) {
+		return errs.AppendWarningf(diags, "deleting EC2 Network Insights Analysis (%s): %s", id, err)
+	}
+
+	return diags
+}
+
+// deleteNetworkInsightsPath deletes the path, returning any failure as a warning.
+// A path cannot be deleted while it has analyses, so it must be called after deleteNetworkInsightsAnalysis.
+func deleteNetworkInsightsPath(conn *ec2.EC2, id string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ctx, cancel := context.WithTimeout(context.Background(), networkPathAnalysisCleanupTimeout)
+	defer cancel()
+
+	log.Printf("[DEBUG] Deleting EC2 Network Insights Path: %s", id)
+	_, err := conn.DeleteNetworkInsightsPathWithContext(ctx, &ec2.DeleteNetworkInsightsPathInput{
+		NetworkInsightsPathId: aws.String(id),
+	})
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, errCodeInvalidNetworkInsightsPathIdNotFound) {
+		return errs.AppendWarningf(diags, "deleting EC2 Network Insights Path (%s): %s", id, err)
+	}
+
+	return diags
+}
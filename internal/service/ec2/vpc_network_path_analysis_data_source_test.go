@@ -0,0 +1,109 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccVPCNetworkPathAnalysisDataSource_basic(t *testing.T) {
+	datasourceName := "data.aws_ec2_network_path_analysis.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkPathAnalysisDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "blocking_component_arn", ""),
+					resource.TestCheckResourceAttr(datasourceName, "blocking_component_id", ""),
+					resource.TestCheckResourceAttr(datasourceName, "explanation_codes.#", "0"),
+					resource.TestCheckResourceAttrSet(datasourceName, "forward_path_components.#"),
+					resource.TestCheckResourceAttr(datasourceName, "path_found", "true"),
+					resource.TestCheckResourceAttr(datasourceName, "protocol", "tcp"),
+					resource.TestCheckResourceAttr(datasourceName, "status", "succeeded"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCNetworkPathAnalysisDataSource_blocked(t *testing.T) {
+	datasourceName := "data.aws_ec2_network_path_analysis.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkPathAnalysisDataSourceConfig_blocked(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(datasourceName, "blocking_component_id"),
+					resource.TestCheckResourceAttrSet(datasourceName, "explanation_codes.#"),
+					resource.TestCheckResourceAttr(datasourceName, "path_found", "false"),
+					resource.TestCheckResourceAttr(datasourceName, "status", "succeeded"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCNetworkPathAnalysisDataSourceConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_network_interface" "test" {
+  count = 2
+
+  subnet_id = aws_subnet.test[0].id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccVPCNetworkPathAnalysisDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCNetworkPathAnalysisDataSourceConfig_base(rName), `
+data "aws_ec2_network_path_analysis" "test" {
+  source      = aws_network_interface.test[0].id
+  destination = aws_network_interface.test[1].id
+}
+`)
+}
+
+func testAccVPCNetworkPathAnalysisDataSourceConfig_blocked(rName string) string {
+	return acctest.ConfigCompose(testAccVPCNetworkPathAnalysisDataSourceConfig_base(rName), fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_network_interface" "blocked" {
+  subnet_id       = aws_subnet.test[0].id
+  security_groups = [aws_security_group.test.id]
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_ec2_network_path_analysis" "test" {
+  source           = aws_network_interface.test[0].id
+  destination      = aws_network_interface.blocked.id
+  destination_port = 443
+}
+`, rName))
+}
@@ -0,0 +1,510 @@
+package ec2
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// Maximum number of rules sent in a single authorize, revoke or modify call.
+	securityGroupRulesBatchSize = 100
+)
+
+func ResourceSecurityGroupIngressRules() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSecurityGroupIngressRulesPut,
+		ReadWithoutTimeout:   resourceSecurityGroupIngressRulesRead,
+		UpdateWithoutTimeout: resourceSecurityGroupIngressRulesPut,
+		DeleteWithoutTimeout: resourceSecurityGroupIngressRulesDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"rule": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr_ipv4": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidIPv4CIDRNetworkAddress,
+						},
+						"cidr_ipv6": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidIPv6CIDRNetworkAddress,
+						},
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validSecurityGroupRuleDescription,
+						},
+						"from_port": {
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          -1,
+							ValidateFunc:     validation.IntBetween(-1, 65535),
+							DiffSuppressFunc: suppressSecurityGroupIngressRulesAllProtocolsPort,
+						},
+						"ip_protocol": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"prefix_list_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"referenced_security_group_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"security_group_rule_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"to_port": {
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          -1,
+							ValidateFunc:     validation.IntBetween(-1, 65535),
+							DiffSuppressFunc: suppressSecurityGroupIngressRulesAllProtocolsPort,
+						},
+					},
+				},
+				Set: securityGroupIngressRulesRuleHash,
+			},
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceSecurityGroupIngressRulesPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	groupID := d.Get("security_group_id").(string)
+
+	want := make(map[string]*ec2.SecurityGroupRuleRequest)
+	for _, tfMapRaw := range d.Get("rule").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject, err := expandSecurityGroupRuleRequest(tfMap)
+
+		if err != nil {
+			return diag.Errorf("updating VPC Security Group (%s) ingress rules: %s", groupID, err)
+		}
+
+		key := securityGroupRuleRequestKey(apiObject)
+
+		if _, ok := want[key]; ok {
+			return diag.Errorf("updating VPC Security Group (%s) ingress rules: duplicate rule (%s)", groupID, key)
+		}
+
+		want[key] = apiObject
+	}
+
+	have, err := findSecurityGroupIngressRulesBySecurityGroupID(ctx, conn, groupID)
+
+	if err != nil {
+		return diag.Errorf("reading VPC Security Group (%s) ingress rules: %s", groupID, err)
+	}
+
+	var revokeIDs []*string
+	var modify []*ec2.SecurityGroupRuleUpdate
+
+	for _, v := range have {
+		key := securityGroupRuleKey(v)
+		apiObject, ok := want[key]
+
+		if !ok {
+			revokeIDs = append(revokeIDs, v.SecurityGroupRuleId)
+			continue
+		}
+
+		delete(want, key)
+
+		if aws.StringValue(apiObject.Description) != aws.StringValue(v.Description) {
+			modify = append(modify, &ec2.SecurityGroupRuleUpdate{
+				SecurityGroupRule:   securityGroupRuleRequestForUpdate(apiObject),
+				SecurityGroupRuleId: v.SecurityGroupRuleId,
+			})
+		}
+	}
+
+	authorize := make([]*ec2.IpPermission, 0, len(want))
+	for _, v := range want {
+		authorize = append(authorize, ipPermissionFromSecurityGroupRuleRequest(v))
+	}
+
+	// Revoke first so that rule quota is available for the new rules.
+	for _, chunk := range slices.Chunks(revokeIDs, securityGroupRulesBatchSize) {
+		input := &ec2.RevokeSecurityGroupIngressInput{
+			GroupId:              aws.String(groupID),
+			SecurityGroupRuleIds: chunk,
+		}
+
+		log.Printf("[DEBUG] Revoking VPC Security Group (%s) ingress rules: %s", groupID, input)
+		if _, err := conn.RevokeSecurityGroupIngressWithContext(ctx, input); err != nil {
+			return diag.Errorf("revoking VPC Security Group (%s) ingress rules: %s", groupID, err)
+		}
+	}
+
+	for _, chunk := range slices.Chunks(modify, securityGroupRulesBatchSize) {
+		input := &ec2.ModifySecurityGroupRulesInput{
+			GroupId:            aws.String(groupID),
+			SecurityGroupRules: chunk,
+		}
+
+		log.Printf("[DEBUG] Modifying VPC Security Group (%s) ingress rules: %s", groupID, input)
+		if _, err := conn.ModifySecurityGroupRulesWithContext(ctx, input); err != nil {
+			return diag.Errorf("modifying VPC Security Group (%s) ingress rules: %s", groupID, err)
+		}
+	}
+
+	for _, chunk := range slices.Chunks(authorize, securityGroupRulesBatchSize) {
+		input := &ec2.AuthorizeSecurityGroupIngressInput{
+			GroupId:       aws.String(groupID),
+			IpPermissions: chunk,
+		}
+
+		log.Printf("[DEBUG] Authorizing VPC Security Group (%s) ingress rules: %s", groupID, input)
+		if _, err := conn.AuthorizeSecurityGroupIngressWithContext(ctx, input); err != nil {
+			return diag.Errorf("authorizing VPC Security Group (%s) ingress rules: %s", groupID, err)
+		}
+	}
+
+	d.SetId(groupID)
+
+	return resourceSecurityGroupIngressRulesRead(ctx, d, meta)
+}
+
+func resourceSecurityGroupIngressRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if _, err := FindSecurityGroupByID(ctx, conn, d.Id()); !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPC Security Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.Errorf("reading VPC Security Group (%s): %s", d.Id(), err)
+	}
+
+	rules, err := findSecurityGroupIngressRulesBySecurityGroupID(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("reading VPC Security Group (%s) ingress rules: %s", d.Id(), err)
+	}
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	tfList := make([]interface{}, 0, len(rules))
+	for _, v := range rules {
+		tfList = append(tfList, flattenSecurityGroupRule(v, accountID))
+	}
+
+	if err := d.Set("rule", tfList); err != nil {
+		return diag.Errorf("setting rule: %s", err)
+	}
+	d.Set("security_group_id", d.Id())
+
+	return nil
+}
+
+func resourceSecurityGroupIngressRulesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	var ids []*string
+	for _, tfMapRaw := range d.Get("rule").(*schema.Set).List() {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			if v, ok := tfMap["security_group_rule_id"].(string); ok && v != "" {
+				ids = append(ids, aws.String(v))
+			}
+		}
+	}
+
+	for _, chunk := range slices.Chunks(ids, securityGroupRulesBatchSize) {
+		log.Printf("[DEBUG] Deleting VPC Security Group (%s) ingress rules", d.Id())
+		_, err := conn.RevokeSecurityGroupIngressWithContext(ctx, &ec2.RevokeSecurityGroupIngressInput{
+			GroupId:              aws.String(d.Id()),
+			SecurityGroupRuleIds: chunk,
+		})
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidGroupNotFound) {
+			return nil
+		}
+
+		// Rules may have been removed outside of Terraform; fall back to one-by-one revocation.
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidSecurityGroupRuleIdNotFound) {
+			for _, id := range chunk {
+				_, err := conn.RevokeSecurityGroupIngressWithContext(ctx, &ec2.RevokeSecurityGroupIngressInput{
+					GroupId:              aws.String(d.Id()),
+					SecurityGroupRuleIds: []*string{id},
+				})
+
+				if tfawserr.ErrCodeEquals(err, errCodeInvalidGroupNotFound, errCodeInvalidSecurityGroupRuleIdNotFound) {
+					continue
+				}
+
+				if err != nil {
+					return diag.Errorf("deleting VPC Security Group (%s) ingress rule (%s): %s", d.Id(), aws.StringValue(id), err)
+				}
+			}
+
+			continue
+		}
+
+		if err != nil {
+			return diag.Errorf("deleting VPC Security Group (%s) ingress rules: %s", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+// securityGroupIngressRulesRuleHash hashes the configurable rule attributes only,
+// so that the computed rule ID and protocol number vs. name differences don't cause diffs.
+func securityGroupIngressRulesRuleHash(v interface{}) int {
+	tfMap, ok := v.(map[string]interface{})
+
+	if !ok {
+		return 0
+	}
+
+	protocol := ProtocolForValue(tfMap["ip_protocol"].(string))
+	fromPort, toPort := tfMap["from_port"].(int), tfMap["to_port"].(int)
+
+	// Ports are ignored for "all traffic" and are reported as -1.
+	if protocol == "-1" {
+		fromPort, toPort = -1, -1
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("%s-", protocol))
+	buf.WriteString(fmt.Sprintf("%d-", fromPort))
+	buf.WriteString(fmt.Sprintf("%d-", toPort))
+	for _, k := range []string{"cidr_ipv4", "cidr_ipv6", "prefix_list_id", "referenced_security_group_id", "description"} {
+		if v, ok := tfMap[k].(string); ok {
+			buf.WriteString(fmt.Sprintf("%s-", v))
+		}
+	}
+
+	return create.StringHashcode(buf.String())
+}
+
+// suppressSecurityGroupIngressRulesAllProtocolsPort suppresses port differences for "all traffic" rules,
+// for which the configured ports are ignored and -1 is reported.
+func suppressSecurityGroupIngressRulesAllProtocolsPort(k, old, new string, d *schema.ResourceData) bool {
+	protocol, ok := d.Get(k[:strings.LastIndex(k, ".")+1] + "ip_protocol").(string)
+
+	return ok && ProtocolForValue(protocol) == "-1"
+}
+
+func findSecurityGroupIngressRulesBySecurityGroupID(ctx context.Context, conn *ec2.EC2, id string) ([]*ec2.SecurityGroupRule, error) {
+	rules, err := FindSecurityGroupRulesBySecurityGroupID(ctx, conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var output []*ec2.SecurityGroupRule
+	for _, v := range rules {
+		if !aws.BoolValue(v.IsEgress) {
+			output = append(output, v)
+		}
+	}
+
+	return output, nil
+}
+
+func expandSecurityGroupRuleRequest(tfMap map[string]interface{}) (*ec2.SecurityGroupRuleRequest, error) {
+	apiObject := &ec2.SecurityGroupRuleRequest{
+		FromPort:   aws.Int64(int64(tfMap["from_port"].(int))),
+		IpProtocol: aws.String(ProtocolForValue(tfMap["ip_protocol"].(string))),
+		ToPort:     aws.Int64(int64(tfMap["to_port"].(int))),
+	}
+
+	var sources int
+
+	if v, ok := tfMap["cidr_ipv4"].(string); ok && v != "" {
+		apiObject.CidrIpv4 = aws.String(v)
+		sources++
+	}
+
+	if v, ok := tfMap["cidr_ipv6"].(string); ok && v != "" {
+		apiObject.CidrIpv6 = aws.String(v)
+		sources++
+	}
+
+	if v, ok := tfMap["description"].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap["prefix_list_id"].(string); ok && v != "" {
+		apiObject.PrefixListId = aws.String(v)
+		sources++
+	}
+
+	if v, ok := tfMap["referenced_security_group_id"].(string); ok && v != "" {
+		apiObject.ReferencedGroupId = aws.String(v)
+		sources++
+	}
+
+	if sources != 1 {
+		return nil, fmt.Errorf("exactly one of cidr_ipv4, cidr_ipv6, prefix_list_id or referenced_security_group_id must be specified")
+	}
+
+	return apiObject, nil
+}
+
+// securityGroupRuleRequestForUpdate returns the rule request to send to ModifySecurityGroupRules.
+// The request has no user ID, so the account prefix of a cross-account referenced group is removed.
+func securityGroupRuleRequestForUpdate(apiObject *ec2.SecurityGroupRuleRequest) *ec2.SecurityGroupRuleRequest {
+	if apiObject.ReferencedGroupId == nil {
+		return apiObject
+	}
+
+	v := *apiObject
+
+	// [UserID/]GroupID.
+	parts := strings.Split(aws.StringValue(apiObject.ReferencedGroupId), "/")
+	v.ReferencedGroupId = aws.String(parts[len(parts)-1])
+
+	return &v
+}
+
+func ipPermissionFromSecurityGroupRuleRequest(apiObject *ec2.SecurityGroupRuleRequest) *ec2.IpPermission {
+	ipPermission := &ec2.IpPermission{
+		FromPort:   apiObject.FromPort,
+		IpProtocol: apiObject.IpProtocol,
+		ToPort:     apiObject.ToPort,
+	}
+
+	switch {
+	case apiObject.CidrIpv4 != nil:
+		ipPermission.IpRanges = []*ec2.IpRange{{
+			CidrIp:      apiObject.CidrIpv4,
+			Description: apiObject.Description,
+		}}
+	case apiObject.CidrIpv6 != nil:
+		ipPermission.Ipv6Ranges = []*ec2.Ipv6Range{{
+			CidrIpv6:    apiObject.CidrIpv6,
+			Description: apiObject.Description,
+		}}
+	case apiObject.PrefixListId != nil:
+		ipPermission.PrefixListIds = []*ec2.PrefixListId{{
+			Description:  apiObject.Description,
+			PrefixListId: apiObject.PrefixListId,
+		}}
+	case apiObject.ReferencedGroupId != nil:
+		pair := &ec2.UserIdGroupPair{
+			Description: apiObject.Description,
+		}
+
+		// [UserID/]GroupID.
+		if parts := strings.Split(aws.StringValue(apiObject.ReferencedGroupId), "/"); len(parts) == 2 {
+			pair.GroupId = aws.String(parts[1])
+			pair.UserId = aws.String(parts[0])
+		} else {
+			pair.GroupId = apiObject.ReferencedGroupId
+		}
+
+		ipPermission.UserIdGroupPairs = []*ec2.UserIdGroupPair{pair}
+	}
+
+	return ipPermission
+}
+
+func flattenSecurityGroupRule(apiObject *ec2.SecurityGroupRule, accountID string) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"cidr_ipv4":              aws.StringValue(apiObject.CidrIpv4),
+		"cidr_ipv6":              aws.StringValue(apiObject.CidrIpv6),
+		"description":            aws.StringValue(apiObject.Description),
+		"from_port":              int(aws.Int64Value(apiObject.FromPort)),
+		"ip_protocol":            aws.StringValue(apiObject.IpProtocol),
+		"prefix_list_id":         aws.StringValue(apiObject.PrefixListId),
+		"security_group_rule_id": aws.StringValue(apiObject.SecurityGroupRuleId),
+		"to_port":                int(aws.Int64Value(apiObject.ToPort)),
+	}
+
+	if v := apiObject.ReferencedGroupInfo; v != nil {
+		if v.UserId == nil || aws.StringValue(v.UserId) == accountID {
+			tfMap["referenced_security_group_id"] = aws.StringValue(v.GroupId)
+		} else {
+			tfMap["referenced_security_group_id"] = aws.StringValue(v.UserId) + "/" + aws.StringValue(v.GroupId)
+		}
+	}
+
+	return tfMap
+}
+
+// securityGroupRuleKey returns a key identifying the rule's traffic match, ignoring its description.
+func securityGroupRuleKey(apiObject *ec2.SecurityGroupRule) string {
+	var source string
+
+	switch {
+	case apiObject.CidrIpv4 != nil:
+		source = aws.StringValue(apiObject.CidrIpv4)
+	case apiObject.CidrIpv6 != nil:
+		source = aws.StringValue(apiObject.CidrIpv6)
+	case apiObject.PrefixListId != nil:
+		source = aws.StringValue(apiObject.PrefixListId)
+	case apiObject.ReferencedGroupInfo != nil:
+		source = aws.StringValue(apiObject.ReferencedGroupInfo.GroupId)
+	}
+
+	return securityGroupRuleKeyFromParts(aws.StringValue(apiObject.IpProtocol), aws.Int64Value(apiObject.FromPort), aws.Int64Value(apiObject.ToPort), source)
+}
+
+func securityGroupRuleRequestKey(apiObject *ec2.SecurityGroupRuleRequest) string {
+	var source string
+
+	switch {
+	case apiObject.CidrIpv4 != nil:
+		source = aws.StringValue(apiObject.CidrIpv4)
+	case apiObject.CidrIpv6 != nil:
+		source = aws.StringValue(apiObject.CidrIpv6)
+	case apiObject.PrefixListId != nil:
+		source = aws.StringValue(apiObject.PrefixListId)
+	case apiObject.ReferencedGroupId != nil:
+		// [UserID/]GroupID.
+		parts := strings.Split(aws.StringValue(apiObject.ReferencedGroupId), "/")
+		source = parts[len(parts)-1]
+	}
+
+	return securityGroupRuleKeyFromParts(aws.StringValue(apiObject.IpProtocol), aws.Int64Value(apiObject.FromPort), aws.Int64Value(apiObject.ToPort), source)
+}
+
+func securityGroupRuleKeyFromParts(protocol string, fromPort, toPort int64, source string) string {
+	protocol = ProtocolForValue(protocol)
+
+	// Ports are ignored for "all traffic" and ICMP-less protocols are reported as -1.
+	if protocol == "-1" {
+		fromPort, toPort = -1, -1
+	}
+
+	return fmt.Sprintf("%s_%d_%d_%s", protocol, fromPort, toPort, source)
+}
@@ -0,0 +1,339 @@
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+)
+
+func TestSecurityGroupRuleRequestForUpdate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		referencedGroupID string
+		expected          string
+	}{
+		"same account": {
+			referencedGroupID: "sg-0123456789abcdef0",
+			expected:          "sg-0123456789abcdef0",
+		},
+		"cross account": {
+			referencedGroupID: "123456789012/sg-0123456789abcdef0",
+			expected:          "sg-0123456789abcdef0",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			input := &ec2.SecurityGroupRuleRequest{
+				Description:       aws.String("test"),
+				FromPort:          aws.Int64(443),
+				IpProtocol:        aws.String("tcp"),
+				ReferencedGroupId: aws.String(testCase.referencedGroupID),
+				ToPort:            aws.Int64(443),
+			}
+
+			got := tfec2.SecurityGroupRuleRequestForUpdate(input)
+
+			if v := aws.StringValue(got.ReferencedGroupId); v != testCase.expected {
+				t.Errorf("ReferencedGroupId = %q, expected %q", v, testCase.expected)
+			}
+
+			if v := aws.StringValue(got.Description); v != "test" {
+				t.Errorf("Description = %q, expected %q", v, "test")
+			}
+
+			if v := aws.StringValue(input.ReferencedGroupId); v != testCase.referencedGroupID {
+				t.Errorf("input ReferencedGroupId modified: %q", v)
+			}
+		})
+	}
+}
+
+func TestAccVPCSecurityGroupIngressRules_basic(t *testing.T) {
+	resourceName := "aws_vpc_security_group_ingress_rules.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupIngressRulesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupIngressRulesConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupIngressRulesCount(resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"cidr_ipv4":   "10.0.0.0/8",
+						"from_port":   "80",
+						"ip_protocol": "tcp",
+						"to_port":     "80",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"cidr_ipv4":   "10.0.0.0/8",
+						"description": "https",
+						"from_port":   "443",
+						"ip_protocol": "tcp",
+						"to_port":     "443",
+					}),
+					resource.TestCheckResourceAttrPair(resourceName, "security_group_id", "aws_security_group.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCSecurityGroupIngressRules_update(t *testing.T) {
+	resourceName := "aws_vpc_security_group_ingress_rules.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupIngressRulesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupIngressRulesConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupIngressRulesCount(resourceName, 2),
+				),
+			},
+			{
+				Config: testAccVPCSecurityGroupIngressRulesConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupIngressRulesCount(resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"cidr_ipv4":   "10.0.0.0/8",
+						"description": "https updated",
+						"from_port":   "443",
+						"ip_protocol": "tcp",
+						"to_port":     "443",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"cidr_ipv6":   "::/0",
+						"ip_protocol": "-1",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCSecurityGroupIngressRules_allProtocolsPorts(t *testing.T) {
+	resourceName := "aws_vpc_security_group_ingress_rules.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupIngressRulesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupIngressRulesConfig_allProtocolsPorts(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupIngressRulesCount(resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+				),
+			},
+			{
+				Config:   testAccVPCSecurityGroupIngressRulesConfig_allProtocolsPorts(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCSecurityGroupIngressRules_many(t *testing.T) {
+	resourceName := "aws_vpc_security_group_ingress_rules.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupIngressRulesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupIngressRulesConfig_many(rName, 50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupIngressRulesCount(resourceName, 50),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "50"),
+				),
+			},
+			{
+				Config: testAccVPCSecurityGroupIngressRulesConfig_many(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupIngressRulesCount(resourceName, 10),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "10"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSecurityGroupIngressRulesCount(n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No VPC Security Group ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := tfec2.FindSecurityGroupRulesBySecurityGroupID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		var got int
+		for _, v := range output {
+			if !aws.BoolValue(v.IsEgress) {
+				got++
+			}
+		}
+
+		if got != want {
+			return fmt.Errorf("VPC Security Group (%s) has %d ingress rules, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSecurityGroupIngressRulesDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_vpc_security_group_ingress_rules" {
+			continue
+		}
+
+		for k, v := range rs.Primary.Attributes {
+			if v == "" || !strings.HasSuffix(k, ".security_group_rule_id") {
+				continue
+			}
+
+			_, err := tfec2.FindSecurityGroupIngressRuleByID(context.Background(), conn, v)
+
+			if err == nil {
+				return fmt.Errorf("VPC Security Group Ingress Rule %s still exists", v)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccVPCSecurityGroupIngressRulesConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfig_base(rName), `
+resource "aws_vpc_security_group_ingress_rules" "test" {
+  security_group_id = aws_security_group.test.id
+
+  rule {
+    cidr_ipv4   = "10.0.0.0/8"
+    from_port   = 80
+    ip_protocol = "tcp"
+    to_port     = 80
+  }
+
+  rule {
+    cidr_ipv4   = "10.0.0.0/8"
+    description = "https"
+    from_port   = 443
+    ip_protocol = "tcp"
+    to_port     = 443
+  }
+}
+`)
+}
+
+func testAccVPCSecurityGroupIngressRulesConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfig_base(rName), `
+resource "aws_vpc_security_group_ingress_rules" "test" {
+  security_group_id = aws_security_group.test.id
+
+  rule {
+    cidr_ipv4   = "10.0.0.0/8"
+    from_port   = 80
+    ip_protocol = "tcp"
+    to_port     = 80
+  }
+
+  rule {
+    cidr_ipv4   = "10.0.0.0/8"
+    description = "https updated"
+    from_port   = 443
+    ip_protocol = "tcp"
+    to_port     = 443
+  }
+
+  rule {
+    cidr_ipv6   = "::/0"
+    ip_protocol = "-1"
+  }
+}
+`)
+}
+
+func testAccVPCSecurityGroupIngressRulesConfig_allProtocolsPorts(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfig_base(rName), `
+resource "aws_vpc_security_group_ingress_rules" "test" {
+  security_group_id = aws_security_group.test.id
+
+  rule {
+    cidr_ipv4   = "10.0.0.0/8"
+    from_port   = 0
+    ip_protocol = "-1"
+    to_port     = 0
+  }
+}
+`)
+}
+
+func testAccVPCSecurityGroupIngressRulesConfig_many(rName string, n int) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfig_base(rName), fmt.Sprintf(`
+resource "aws_vpc_security_group_ingress_rules" "test" {
+  security_group_id = aws_security_group.test.id
+
+  dynamic "rule" {
+    for_each = range(%[1]d)
+
+    content {
+      cidr_ipv4   = "10.0.${rule.value}.0/24"
+      from_port   = 8000 + rule.value
+      ip_protocol = "tcp"
+      to_port     = 8000 + rule.value
+    }
+  }
+}
+`, n))
+}
@@ -2189,6 +2189,50 @@ const (
 	HostDeletedTimeout = 20 * time.Minute
 )
 
+func WaitBYOIPCIDRProvisioned(conn *ec2.EC2, cidrBlock string, timeout time.Duration) (*ec2.ByoipCidr, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.ByoipCidrStatePendingProvision},
+		Target:  []string{ec2.ByoipCidrStateProvisioned, ec2.ByoipCidrStateProvisionedNotPubliclyAdvertisable},
+		Refresh: StatusBYOIPCIDRState(conn, cidrBlock),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.ByoipCidr); ok {
+		if state := aws.StringValue(output.State); state == ec2.ByoipCidrStateFailedProvision {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitBYOIPCIDRDeprovisioned(conn *ec2.EC2, cidrBlock string, timeout time.Duration) (*ec2.ByoipCidr, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.ByoipCidrStatePendingDeprovision, ec2.ByoipCidrStateProvisioned, ec2.ByoipCidrStateProvisionedNotPubliclyAdvertisable},
+		Target:  []string{},
+		Refresh: StatusBYOIPCIDRState(conn, cidrBlock),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.ByoipCidr); ok {
+		if state := aws.StringValue(output.State); state == ec2.ByoipCidrStateFailedDeprovision {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func WaitHostCreated(conn *ec2.EC2, id string) (*ec2.Host, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{ec2.AllocationStatePending},
@@ -2367,6 +2411,21 @@ func WaitNetworkInsightsAnalysisCreated(ctx context.Context, conn *ec2.EC2, id s
 	return nil, err
 }
 
+// WaitNetworkInsightsAnalysisCompleted waits for the analysis to either succeed or fail.
+func WaitNetworkInsightsAnalysisCompleted(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ec2.AnalysisStatusRunning},
+		Target:     []string{ec2.AnalysisStatusSucceeded, ec2.AnalysisStatusFailed},
+		Timeout:    timeout,
+		Refresh:    StatusNetworkInsightsAnalysis(ctx, conn, id),
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
 const (
 	networkInterfaceAttachedTimeout = 5 * time.Minute
 	NetworkInterfaceDetachedTimeout = 10 * time.Minute
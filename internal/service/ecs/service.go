@@ -1268,6 +1268,63 @@ func serviceCreateWithRetry(conn *ecs.ECS, input ecs.CreateServiceInput) (*ecs.C
 	return output, err
 }
 
+// serviceDeploymentFailureError returns an error describing why a deployment was failed by the deployment circuit breaker.
+// The stopped reasons of the deployment's most recently stopped tasks are included as they usually identify the root cause.
+func serviceDeploymentFailureError(conn *ecs.ECS, cluster string, deployment *ecs.Deployment) error {
+	id := aws.StringValue(deployment.Id)
+	reasons := []string{aws.StringValue(deployment.RolloutStateReason)}
+
+	// Tasks launched by a service deployment are started by the deployment ID.
+	listInput := &ecs.ListTasksInput{
+		DesiredStatus: aws.String(ecs.DesiredStatusStopped),
+		MaxResults:    aws.Int64(serviceDeploymentFailureMaxTasks),
+		StartedBy:     aws.String(id),
+	}
+
+	if cluster != "" {
+		listInput.Cluster = aws.String(cluster)
+	}
+
+	listOutput, err := conn.ListTasks(listInput)
+
+	if err != nil {
+		log.Printf("[WARN] listing ECS Service deployment (%s) stopped tasks: %s", id, err)
+	} else if len(listOutput.TaskArns) > 0 {
+		describeInput := &ecs.DescribeTasksInput{
+			Cluster: listInput.Cluster,
+			Tasks:   listOutput.TaskArns,
+		}
+
+		describeOutput, err := conn.DescribeTasks(describeInput)
+
+		if err != nil {
+			log.Printf("[WARN] describing ECS Service deployment (%s) stopped tasks: %s", id, err)
+		} else {
+			for _, task := range describeOutput.Tasks {
+				if task == nil {
+					continue
+				}
+
+				reasons = append(reasons, fmt.Sprintf("task (%s) stopped: %s", aws.StringValue(task.TaskArn), aws.StringValue(task.StoppedReason)))
+
+				for _, container := range task.Containers {
+					if container == nil {
+						continue
+					}
+
+					if v := aws.StringValue(container.Reason); v != "" {
+						reasons = append(reasons, fmt.Sprintf("container (%s): %s", aws.StringValue(container.Name), v))
+					} else if v := aws.Int64Value(container.ExitCode); v != 0 {
+						reasons = append(reasons, fmt.Sprintf("container (%s): exited with code %d", aws.StringValue(container.Name), v))
+					}
+				}
+			}
+		}
+	}
+
+	return fmt.Errorf("deployment (%s) failed: %s", id, strings.Join(reasons, "; "))
+}
+
 func buildFamilyAndRevisionFromARN(arn string) string {
 	return strings.Split(arn, "/")[1]
 }
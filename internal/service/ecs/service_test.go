@@ -557,6 +557,27 @@ func TestAccECSService_deploymentCircuitBreaker(t *testing.T) {
 	})
 }
 
+func TestAccECSService_DeploymentCircuitBreaker_failure(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccServiceConfig_deploymentCircuitBreakerFailure(rName),
+				ExpectError: regexp.MustCompile(`deployment \(ecs-svc/[0-9]+\) failed: .*task \(.+\) stopped: Essential container in task exited`),
+			},
+		},
+	})
+}
+
 // Regression for https://github.com/hashicorp/terraform/issues/3444
 func TestAccECSService_loadBalancerChanges(t *testing.T) {
 	var s1, s2 ecs.Service
@@ -995,6 +1016,23 @@ func TestAccECSService_LaunchTypeFargate_updateWaitForSteadyState(t *testing.T)
 	})
 }
 
+func TestAccECSService_LaunchTypeFargate_waitForSteadyStateTimeout(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccServiceConfig_launchTypeFargateWaitTimeout(rName),
+				ExpectError: regexp.MustCompile(`timeout while waiting for state to become 'tfSTABLE'`),
+			},
+		},
+	})
+}
+
 func TestAccECSService_LaunchTypeEC2_network(t *testing.T) {
 	var service ecs.Service
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1551,7 +1589,7 @@ resource "aws_ecs_service" "test" {
 `, rName)
 }
 
-func testAccServiceConfig_launchTypeFargateAndWait(rName string, desiredCount int, waitForSteadyState bool) string {
+func testAccServiceConfig_launchTypeFargateBase(rName string) string {
 	return fmt.Sprintf(`
 data "aws_availability_zones" "available" {
   state = "available"
@@ -1626,7 +1664,11 @@ resource "aws_security_group" "test" {
 resource "aws_ecs_cluster" "test" {
   name = %[1]q
 }
+`, rName)
+}
 
+func testAccServiceConfig_launchTypeFargateAndWait(rName string, desiredCount int, waitForSteadyState bool) string {
+	return acctest.ConfigCompose(testAccServiceConfig_launchTypeFargateBase(rName), fmt.Sprintf(`
 resource "aws_ecs_task_definition" "test" {
   family                   = %[1]q
   network_mode             = "awsvpc"
@@ -1661,10 +1703,48 @@ resource "aws_ecs_service" "test" {
     assign_public_ip = true
   }
 
-  wait_for_steady_state = %t
+  wait_for_steady_state = %[3]t
+}
+`, rName, desiredCount, waitForSteadyState))
+}
+
+func testAccServiceConfig_launchTypeFargateWaitTimeout(rName string) string {
+	return acctest.ConfigCompose(testAccServiceConfig_launchTypeFargateBase(rName), fmt.Sprintf(`
+# The image does not exist, so no task ever reaches the RUNNING state.
+resource "aws_ecs_task_definition" "test" {
+  family                   = %[1]q
+  network_mode             = "awsvpc"
+  requires_compatibilities = ["FARGATE"]
+  cpu                      = "256"
+  memory                   = "512"
+
+  container_definitions = jsonencode([{
+    essential = true
+    image     = "%[1]s.invalid/does-not-exist:latest"
+    name      = "test"
+  }])
 }
 
-`, rName, desiredCount, waitForSteadyState)
+resource "aws_ecs_service" "test" {
+  name            = %[1]q
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.test.arn
+  desired_count   = 1
+  launch_type     = "FARGATE"
+
+  network_configuration {
+    security_groups  = [aws_security_group.test.id]
+    subnets          = aws_subnet.test[*].id
+    assign_public_ip = true
+  }
+
+  wait_for_steady_state = true
+
+  timeouts {
+    create = "2m"
+  }
+}
+`, rName))
 }
 
 func testAccServiceConfig_interchangeablePlacementStrategy(rName string) string {
@@ -4099,6 +4179,78 @@ resource "aws_ecs_service" "test" {
 `, rName)
 }
 
+func testAccServiceConfig_deploymentCircuitBreakerFailure(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
+
+  route {
+    cidr_block = "0.0.0.0/0"
+    gateway_id = aws_internet_gateway.test.id
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table_association" "test" {
+  count          = 2
+  subnet_id      = aws_subnet.test[count.index].id
+  route_table_id = aws_route_table.test.id
+}
+
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family                   = %[1]q
+  network_mode             = "awsvpc"
+  requires_compatibilities = ["FARGATE"]
+  cpu                      = "256"
+  memory                   = "512"
+
+  container_definitions = jsonencode([{
+    command   = ["false"]
+    essential = true
+    image     = "public.ecr.aws/docker/library/busybox:latest"
+    name      = "test"
+  }])
+}
+
+resource "aws_ecs_service" "test" {
+  cluster         = aws_ecs_cluster.test.id
+  desired_count   = 1
+  launch_type     = "FARGATE"
+  name            = %[1]q
+  task_definition = aws_ecs_task_definition.test.arn
+
+  deployment_circuit_breaker {
+    enable   = true
+    rollback = true
+  }
+
+  network_configuration {
+    assign_public_ip = true
+    subnets          = aws_subnet.test[*].id
+  }
+
+  wait_for_steady_state = true
+
+  depends_on = [aws_route_table_association.test]
+}
+`, rName))
+}
+
 func testAccServiceConfig_tags1(rName, tag1Key, tag1Value string) string {
 	return fmt.Sprintf(`
 resource "aws_ecs_cluster" "test" {
@@ -2,6 +2,8 @@ package ecs
 
 import (
 	"context"
+	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
@@ -14,6 +16,7 @@ const (
 	serviceStatusActive   = "ACTIVE"
 	serviceStatusDraining = "DRAINING"
 	// Non-standard statuses for statusServiceWaitForStable()
+	serviceStatusFailed  = "tfFAILED"
 	serviceStatusPending = "tfPENDING"
 	serviceStatusStable  = "tfSTABLE"
 
@@ -72,6 +75,9 @@ func statusServiceNoTags(conn *ecs.ECS, id, cluster string) resource.StateRefres
 }
 
 func statusServiceWaitForStable(conn *ecs.ECS, id, cluster string) resource.StateRefreshFunc {
+	// Only service events emitted after the wait started are logged.
+	eventsSince := time.Now()
+
 	return func() (interface{}, string, error) {
 		serviceRaw, status, err := statusServiceNoTags(conn, id, cluster)()
 		if err != nil {
@@ -84,9 +90,18 @@ func statusServiceWaitForStable(conn *ecs.ECS, id, cluster string) resource.Stat
 
 		service := serviceRaw.(*ecs.Service)
 
-		if d, dc, rc := len(service.Deployments),
-			aws.Int64Value(service.DesiredCount),
-			aws.Int64Value(service.RunningCount); d == 1 && dc == rc {
+		eventsSince = logServiceEvents(service, eventsSince)
+
+		if failedServiceDeployment(service) != nil {
+			return service, serviceStatusFailed, nil
+		}
+
+		d, dc, rc := len(service.Deployments), aws.Int64Value(service.DesiredCount), aws.Int64Value(service.RunningCount)
+
+		log.Printf("[INFO] ECS Service (%s) waiting for steady state: %d of %d desired tasks running, %d pending, %d deployments",
+			aws.StringValue(service.ServiceName), rc, dc, aws.Int64Value(service.PendingCount), d)
+
+		if d == 1 && dc == rc {
 			status = serviceStatusStable
 		} else {
 			status = serviceStatusPending
@@ -96,6 +111,40 @@ func statusServiceWaitForStable(conn *ecs.ECS, id, cluster string) resource.Stat
 	}
 }
 
+// logServiceEvents logs, oldest first, the service events created after the specified time
+// and returns the creation time of the newest event logged.
+func logServiceEvents(service *ecs.Service, since time.Time) time.Time {
+	newest := since
+
+	// Events are returned newest first.
+	for i := len(service.Events) - 1; i >= 0; i-- {
+		event := service.Events[i]
+
+		if event == nil || !aws.TimeValue(event.CreatedAt).After(since) {
+			continue
+		}
+
+		log.Printf("[INFO] ECS Service (%s) event: %s", aws.StringValue(service.ServiceName), aws.StringValue(event.Message))
+
+		if createdAt := aws.TimeValue(event.CreatedAt); createdAt.After(newest) {
+			newest = createdAt
+		}
+	}
+
+	return newest
+}
+
+// failedServiceDeployment returns the service deployment, if any, whose rollout has been failed by the deployment circuit breaker.
+func failedServiceDeployment(service *ecs.Service) *ecs.Deployment {
+	for _, deployment := range service.Deployments {
+		if deployment != nil && aws.StringValue(deployment.RolloutState) == ecs.DeploymentRolloutStateFailed {
+			return deployment
+		}
+	}
+
+	return nil
+}
+
 func statusCluster(ctx context.Context, conn *ecs.ECS, arn string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		cluster, err := FindClusterByNameOrARN(ctx, conn, arn)
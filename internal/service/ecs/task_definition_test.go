@@ -1019,6 +1019,79 @@ func TestAccECSTaskDefinition_invalidContainerDefinition(t *testing.T) {
 	})
 }
 
+func TestAccECSTaskDefinition_containerDefinitionBlock(t *testing.T) {
+	var def ecs.TaskDefinition
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskDefinitionConfig_containerDefinitionBlock(rName, "nginx:latest"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.name", "web"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.image", "nginx:latest"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.essential", "true"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.environment.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.environment.VARNAME", "VARVAL"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.depends_on.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.depends_on.0.condition", "START"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.depends_on.0.container_name", "sidecar"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.health_check.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.health_check.0.command.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.health_check.0.interval", "30"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.health_check.0.retries", "3"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.health_check.0.start_period", "10"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.health_check.0.timeout", "5"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.port_mapping.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.port_mapping.0.container_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.port_mapping.0.host_port", "8080"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.port_mapping.0.protocol", "tcp"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.ulimit.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.1.name", "sidecar"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.1.essential", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "container_definitions"),
+				),
+			},
+			{
+				Config: testAccTaskDefinitionConfig_containerDefinitionBlock(rName, "nginx:stable"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.image", "nginx:stable"),
+					resource.TestCheckResourceAttr(resourceName, "revision", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECSTaskDefinition_ContainerDefinitionBlock_invalid(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTaskDefinitionConfig_containerDefinitionBlockUnknownDependency(rName),
+				ExpectError: regexp.MustCompile(`depends_on references unknown container \(missing\)`),
+			},
+			{
+				Config:      testAccTaskDefinitionConfig_containerDefinitionBlockHealthCheckInterval(rName),
+				ExpectError: regexp.MustCompile(`expected container_definition.0.health_check.0.interval to be in the range \(5 - 300\)`),
+			},
+		},
+	})
+}
+
 func testAccTaskDefinitionConfig_proxyConfiguration(rName string, containerName string, proxyType string,
 	ignoredUid string, ignoredGid string, appPorts string, proxyIngressPort string, proxyEgressPort string,
 	egressIgnoredPorts string, egressIgnoredIPs string) string {
@@ -2660,3 +2733,90 @@ TASK_DEFINITION
 }
 `, rName)
 }
+
+func testAccTaskDefinitionConfig_containerDefinitionBlock(rName, image string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definition {
+    name   = "web"
+    image  = %[2]q
+    cpu    = 10
+    memory = 128
+
+    environment = {
+      VARNAME = "VARVAL"
+      OTHER   = "VALUE"
+    }
+
+    depends_on {
+      container_name = "sidecar"
+      condition      = "START"
+    }
+
+    health_check {
+      command      = ["CMD-SHELL", "curl -f http://localhost/ || exit 1"]
+      start_period = 10
+    }
+
+    port_mapping {
+      container_port = 80
+      host_port      = 8080
+    }
+
+    ulimit {
+      name       = "nofile"
+      soft_limit = 1024
+      hard_limit = 4096
+    }
+  }
+
+  container_definition {
+    name      = "sidecar"
+    image     = "busybox:latest"
+    essential = false
+    command   = ["sleep", "3600"]
+    memory    = 64
+  }
+}
+`, rName, image)
+}
+
+func testAccTaskDefinitionConfig_containerDefinitionBlockUnknownDependency(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definition {
+    name   = "web"
+    image  = "nginx:latest"
+    memory = 128
+
+    depends_on {
+      container_name = "missing"
+      condition      = "START"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccTaskDefinitionConfig_containerDefinitionBlockHealthCheckInterval(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definition {
+    name   = "web"
+    image  = "nginx:latest"
+    memory = 128
+
+    health_check {
+      command  = ["CMD-SHELL", "exit 0"]
+      interval = 1
+    }
+  }
+}
+`, rName)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -18,6 +19,8 @@ const (
 	serviceDescribeTimeout    = 2 * time.Minute
 	serviceUpdateTimeout      = 2 * time.Minute
 
+	serviceDeploymentFailureMaxTasks = 10
+
 	clusterAvailableDelay   = 10 * time.Second
 	clusterAvailableTimeout = 10 * time.Minute
 	clusterDeleteTimeout    = 10 * time.Minute
@@ -82,6 +85,10 @@ func waitServiceStable(conn *ecs.ECS, id, cluster string, timeout time.Duration)
 	outputRaw, err := stateConf.WaitForState()
 
 	if v, ok := outputRaw.(*ecs.Service); ok {
+		if deployment := failedServiceDeployment(v); deployment != nil {
+			tfresource.SetLastError(err, serviceDeploymentFailureError(conn, cluster, deployment))
+		}
+
 		return v, err
 	}
 
@@ -6,8 +6,10 @@ import (
 	"log"
 	"math"
 	"regexp"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/eventbridge"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -44,6 +46,8 @@ func ResourceTarget() *schema.Resource {
 			},
 		},
 
+		CustomizeDiff: resourceTargetCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:         schema.TypeString,
@@ -86,7 +90,7 @@ func ResourceTarget() *schema.Resource {
 						"arn": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: validateTargetDeadLetterARN,
 						},
 					},
 				},
@@ -420,6 +424,56 @@ func ResourceTarget() *schema.Resource {
 	}
 }
 
+func resourceTargetCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("arn") || !diff.NewValueKnown("role_arn") {
+		return nil
+	}
+
+	if diff.Get("role_arn").(string) != "" {
+		return nil
+	}
+
+	targetType := targetTypeRequiringRoleARN(
+		diff.Get("arn").(string),
+		len(diff.Get("batch_target").([]interface{})) > 0,
+		len(diff.Get("ecs_target").([]interface{})) > 0,
+		len(diff.Get("run_command_targets").([]interface{})) > 0,
+	)
+
+	if targetType != "" {
+		return fmt.Errorf("role_arn is required for %s targets: EventBridge assumes this IAM role to invoke the target", targetType)
+	}
+
+	return nil
+}
+
+// targetTypeRequiringRoleARN returns a description of the target type if EventBridge can only invoke it with an IAM role.
+func targetTypeRequiringRoleARN(targetARN string, hasBatchTarget, hasECSTarget, hasRunCommandTargets bool) string {
+	switch {
+	case hasBatchTarget:
+		return "Batch job"
+	case hasECSTarget:
+		return "ECS task"
+	case hasRunCommandTargets:
+		return "SSM Run Command"
+	}
+
+	parsedARN, err := arn.Parse(targetARN)
+
+	if err != nil {
+		return ""
+	}
+
+	switch {
+	case parsedARN.Service == "states" && strings.HasPrefix(parsedARN.Resource, "stateMachine:"):
+		return "Step Functions state machine"
+	case parsedARN.Service == "events" && strings.HasPrefix(parsedARN.Resource, "api-destination/"):
+		return "API destination"
+	}
+
+	return ""
+}
+
 func resourceTargetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EventsConn
 
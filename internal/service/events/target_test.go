@@ -426,6 +426,23 @@ func TestAccEventsTarget_ecs(t *testing.T) {
 	})
 }
 
+func TestAccEventsTarget_ecsWithoutRoleARN(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, eventbridge.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTargetConfig_ecsWithoutRoleARN(rName),
+				ExpectError: regexp.MustCompile(`role_arn is required for ECS task targets`),
+			},
+		},
+	})
+}
+
 func TestAccEventsTarget_redshift(t *testing.T) {
 	var v eventbridge.Target
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1542,6 +1559,31 @@ resource "aws_cloudwatch_event_target" "test" {
 `)
 }
 
+func testAccTargetConfig_ecsWithoutRoleARN(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_cloudwatch_event_rule" "test" {
+  name                = %[1]q
+  schedule_expression = "rate(1 hour)"
+}
+
+resource "aws_cloudwatch_event_target" "test" {
+  rule = aws_cloudwatch_event_rule.test.name
+  arn  = "arn:${data.aws_partition.current.partition}:ecs:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:cluster/%[1]s"
+
+  ecs_target {
+    task_count          = 1
+    task_definition_arn = "arn:${data.aws_partition.current.partition}:ecs:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:task-definition/%[1]s:1"
+  }
+}
+`, rName)
+}
+
 func testAccTargetConfig_redshift(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
 data "aws_partition" "current" {}
@@ -149,3 +149,92 @@ func TestValidRuleName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateTargetDeadLetterARN(t *testing.T) {
+	validARNs := []string{
+		"",
+		"arn:aws:sqs:us-west-2:123456789012:dlq", //lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range validARNs {
+		_, errors := validateTargetDeadLetterARN(v, "arn")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid dead-letter queue ARN: %q", v, errors)
+		}
+	}
+
+	invalidARNs := []string{
+		"dlq",
+		"arn:aws:sns:us-west-2:123456789012:topic", //lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range invalidARNs {
+		_, errors := validateTargetDeadLetterARN(v, "arn")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid dead-letter queue ARN", v)
+		}
+	}
+}
+
+func TestTargetTypeRequiringRoleARN(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		arn                  string
+		hasBatchTarget       bool
+		hasECSTarget         bool
+		hasRunCommandTargets bool
+		expected             string
+	}{
+		{
+			name:     "Lambda function",
+			arn:      "arn:aws:lambda:us-west-2:123456789012:function:test", //lintignore:AWSAT003,AWSAT005
+			expected: "",
+		},
+		{
+			name:     "invalid ARN",
+			arn:      "test",
+			expected: "",
+		},
+		{
+			name:           "Batch job queue",
+			arn:            "arn:aws:batch:us-west-2:123456789012:job-queue/test", //lintignore:AWSAT003,AWSAT005
+			hasBatchTarget: true,
+			expected:       "Batch job",
+		},
+		{
+			name:         "ECS cluster",
+			arn:          "arn:aws:ecs:us-west-2:123456789012:cluster/test", //lintignore:AWSAT003,AWSAT005
+			hasECSTarget: true,
+			expected:     "ECS task",
+		},
+		{
+			name:                 "SSM document",
+			arn:                  "arn:aws:ssm:us-west-2:123456789012:document/test", //lintignore:AWSAT003,AWSAT005
+			hasRunCommandTargets: true,
+			expected:             "SSM Run Command",
+		},
+		{
+			name:     "Step Functions state machine",
+			arn:      "arn:aws:states:us-west-2:123456789012:stateMachine:test", //lintignore:AWSAT003,AWSAT005
+			expected: "Step Functions state machine",
+		},
+		{
+			name:     "API destination",
+			arn:      "arn:aws:events:us-west-2:123456789012:api-destination/test/abcd", //lintignore:AWSAT003,AWSAT005
+			expected: "API destination",
+		},
+		{
+			name:     "event bus",
+			arn:      "arn:aws:events:us-west-2:123456789012:event-bus/test", //lintignore:AWSAT003,AWSAT005
+			expected: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := targetTypeRequiringRoleARN(testCase.arn, testCase.hasBatchTarget, testCase.hasECSTarget, testCase.hasRunCommandTargets)
+
+			if got != testCase.expected {
+				t.Errorf("got %q, expected %q", got, testCase.expected)
+			}
+		})
+	}
+}
@@ -179,6 +179,11 @@ func ResourceExperimentTemplate() *schema.Resource {
 							Required:     true,
 							ValidateFunc: validation.StringLenBetween(0, 64),
 						},
+						"parameters": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
 						"resource_arns": {
 							Type:     schema.TypeSet,
 							Optional: true,
@@ -545,8 +550,13 @@ func expandExperimentTemplateTargets(l *schema.Set) (map[string]types.CreateExpe
 			hasSeenResourceTag = true
 		}
 
-		if !hasSeenResourceArns && !hasSeenResourceTag {
-			return nil, errors.New("A target block requires one of resource_arns, resource_tag")
+		if v, ok := raw["parameters"].(map[string]interface{}); ok && len(v) > 0 {
+			config.Parameters = flex.ExpandStringValueMap(v)
+		}
+
+		// Targets such as EKS pods and ECS tasks can be identified by parameters alone.
+		if !hasSeenResourceArns && !hasSeenResourceTag && len(config.Parameters) == 0 {
+			return nil, errors.New("A target block requires one of resource_arns, resource_tag, parameters")
 		}
 
 		if v, ok := raw["resource_type"].(string); ok && v != "" {
@@ -597,8 +607,13 @@ func expandExperimentTemplateTargetsForUpdate(l *schema.Set) (map[string]types.U
 			hasSeenResourceTag = true
 		}
 
-		if !hasSeenResourceArns && !hasSeenResourceTag {
-			return nil, errors.New("A target block requires one of resource_arns, resource_tag")
+		if v, ok := raw["parameters"].(map[string]interface{}); ok && len(v) > 0 {
+			config.Parameters = flex.ExpandStringValueMap(v)
+		}
+
+		// Targets such as EKS pods and ECS tasks can be identified by parameters alone.
+		if !hasSeenResourceArns && !hasSeenResourceTag && len(config.Parameters) == 0 {
+			return nil, errors.New("A target block requires one of resource_arns, resource_tag, parameters")
 		}
 
 		if v, ok := raw["resource_type"].(string); ok && v != "" {
@@ -735,6 +750,7 @@ func flattenExperimentTemplateTargets(configured map[string]types.ExperimentTemp
 	for k, v := range configured {
 		item := make(map[string]interface{})
 		item["filter"] = flattenExperimentTemplateTargetFilters(v.Filters)
+		item["parameters"] = v.Parameters
 		item["resource_arns"] = v.ResourceArns
 		item["resource_tag"] = flattenExperimentTemplateTargetResourceTags(v.ResourceTags)
 		item["resource_type"] = aws.ToString(v.ResourceType)
@@ -821,7 +837,9 @@ func validExperimentTemplateActionTargetKey() schema.SchemaValidateFunc {
 		"Instances",
 		"SpotInstances",
 		"Nodegroups",
+		"Pods",
 		"Roles",
+		"Tasks",
 	}
 
 	return validation.All(
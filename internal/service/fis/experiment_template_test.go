@@ -205,6 +205,39 @@ func TestAccFISExperimentTemplate_spot(t *testing.T) {
 	})
 }
 
+func TestAccFISExperimentTemplate_ecsTaskParameters(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_fis_experiment_template.test"
+	var conf types.ExperimentTemplate
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, fis.ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccExperimentTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExperimentTemplateConfig_ecsTaskParameters(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccExperimentTemplateExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "action.0.action_id", "aws:ecs:stop-task"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.target.0.key", "Tasks"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.target.0.value", "ecs-tasks"),
+					resource.TestCheckResourceAttr(resourceName, "target.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.name", "ecs-tasks"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.parameters.%", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "target.0.parameters.cluster", "aws_ecs_cluster.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.parameters.service", rName),
+					resource.TestCheckResourceAttr(resourceName, "target.0.resource_arns.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.resource_tag.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.resource_type", "aws:ecs:task"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.selection_mode", "ALL"),
+				),
+			},
+		},
+	})
+}
+
 func testAccExperimentTemplateExists(resourceName string, config *types.ExperimentTemplate) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -367,3 +400,64 @@ resource "aws_fis_experiment_template" "test" {
 }
 `, rName, desc, actionName, actionDesc, actionID, actionTargetK, actionTargetV, paramK, paramV, targetResType, targetSelectMode, targetResTagK, targetResTagV)
 }
+
+func testAccExperimentTemplateConfig_ecsTaskParameters(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = [
+          "fis.${data.aws_partition.current.dns_suffix}",
+        ]
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_fis_experiment_template" "test" {
+  description = "Stop ECS tasks"
+  role_arn    = aws_iam_role.test.arn
+
+  stop_condition {
+    source = "none"
+  }
+
+  action {
+    name      = "stop-tasks"
+    action_id = "aws:ecs:stop-task"
+
+    target {
+      key   = "Tasks"
+      value = "ecs-tasks"
+    }
+  }
+
+  target {
+    name           = "ecs-tasks"
+    resource_type  = "aws:ecs:task"
+    selection_mode = "ALL"
+
+    parameters = {
+      cluster = aws_ecs_cluster.test.name
+      service = %[1]q
+    }
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
@@ -3,6 +3,7 @@ package glue
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
@@ -81,6 +82,10 @@ func ResourceJob() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ValidateFunc: validation.StringInSlice(glue.ExecutionClass_Values(), true),
+				// Removing the argument reverts the job to the standard execution class.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return new == "" && strings.EqualFold(old, glue.ExecutionClassStandard)
+				},
 			},
 			"execution_property": {
 				Type:     schema.TypeList,
@@ -347,6 +352,8 @@ func resourceJobUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		if v, ok := d.GetOk("execution_class"); ok {
 			jobUpdate.ExecutionClass = aws.String(v.(string))
+		} else if d.HasChange("execution_class") {
+			jobUpdate.ExecutionClass = aws.String(glue.ExecutionClassStandard)
 		}
 
 		if v, ok := d.GetOk("execution_property"); ok {
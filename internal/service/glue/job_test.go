@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/glue"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -328,6 +329,20 @@ func TestAccGlueJob_executionClass(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccJobConfig_executionClass(rName, "FLEX"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "execution_class", "FLEX"),
+				),
+			},
+			{
+				Config: testAccJobConfig_versionNumberOfWorkers(rName, "3.0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "execution_class", "STANDARD"),
+				),
+			},
 		},
 	})
 }
@@ -738,6 +753,57 @@ func TestAccGlueJob_maxCapacity(t *testing.T) {
 	})
 }
 
+func TestAccGlueJob_maxCapacityToWorkerType(t *testing.T) {
+	var job1, job2, job3 glue.Job
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, glue.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig_versionMaxCapacity(rName, "2.0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &job1),
+					resource.TestCheckResourceAttr(resourceName, "max_capacity", "10"),
+					resource.TestCheckResourceAttr(resourceName, "worker_type", ""),
+				),
+			},
+			{
+				Config: testAccJobConfig_versionNumberOfWorkers(rName, "2.0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &job2),
+					testAccCheckJobNotRecreated(&job1, &job2),
+					resource.TestCheckResourceAttr(resourceName, "number_of_workers", "2"),
+					resource.TestCheckResourceAttr(resourceName, "worker_type", "Standard"),
+				),
+			},
+			{
+				Config: testAccJobConfig_versionMaxCapacity(rName, "2.0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &job3),
+					testAccCheckJobNotRecreated(&job2, &job3),
+					resource.TestCheckResourceAttr(resourceName, "max_capacity", "10"),
+					resource.TestCheckResourceAttr(resourceName, "worker_type", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJobNotRecreated(i, j *glue.Job) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !aws.TimeValue(i.CreatedOn).Equal(aws.TimeValue(j.CreatedOn)) {
+			return fmt.Errorf("Glue Job (%s) recreated", aws.StringValue(i.Name))
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckJobExists(n string, v *glue.Job) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
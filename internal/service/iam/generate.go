@@ -1,4 +1,5 @@
 //go:generate go run ../../generate/tags/main.go -ServiceTagsSlice
+//go:generate go run -tags generate ../../generate/listdatasource/main.go -Types=Role -SkipFields=Role.Tags
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package iam
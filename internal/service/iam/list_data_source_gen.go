@@ -0,0 +1,174 @@
+// Code generated by "internal/generate/listdatasource/main.go -Types=Role -SkipFields=Role.Tags"; DO NOT EDIT.
+
+package iam
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAttachedPermissionsBoundarySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"permissions_boundary_arn": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"permissions_boundary_type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func flattenDataSourceAttachedPermissionsBoundary(apiObject *iam.AttachedPermissionsBoundary) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.PermissionsBoundaryArn; v != nil {
+		tfMap["permissions_boundary_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.PermissionsBoundaryType; v != nil {
+		tfMap["permissions_boundary_type"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func dataSourceRoleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"arn": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"assume_role_policy_document": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"create_date": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"max_session_duration": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"path": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"permissions_boundary": {
+			Type: schema.TypeList,
+			Elem: &schema.Resource{
+				Schema: dataSourceAttachedPermissionsBoundarySchema(),
+			},
+			Computed: true,
+		},
+		"role_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"role_last_used": {
+			Type: schema.TypeList,
+			Elem: &schema.Resource{
+				Schema: dataSourceRoleLastUsedSchema(),
+			},
+			Computed: true,
+		},
+		"role_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func flattenDataSourceRole(apiObject *iam.Role) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Arn; v != nil {
+		tfMap["arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.AssumeRolePolicyDocument; v != nil {
+		tfMap["assume_role_policy_document"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.CreateDate; v != nil {
+		tfMap["create_date"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.Description; v != nil {
+		tfMap["description"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.MaxSessionDuration; v != nil {
+		tfMap["max_session_duration"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Path; v != nil {
+		tfMap["path"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.PermissionsBoundary; v != nil {
+		tfMap["permissions_boundary"] = []interface{}{flattenDataSourceAttachedPermissionsBoundary(v)}
+	}
+
+	if v := apiObject.RoleId; v != nil {
+		tfMap["role_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.RoleLastUsed; v != nil {
+		tfMap["role_last_used"] = []interface{}{flattenDataSourceRoleLastUsed(v)}
+	}
+
+	if v := apiObject.RoleName; v != nil {
+		tfMap["role_name"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func dataSourceRoleLastUsedSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"last_used_date": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"region": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func flattenDataSourceRoleLastUsed(apiObject *iam.RoleLastUsed) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.LastUsedDate; v != nil {
+		tfMap["last_used_date"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.Region; v != nil {
+		tfMap["region"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
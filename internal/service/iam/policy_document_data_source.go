@@ -1,11 +1,13 @@
 package iam
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,6 +17,23 @@ import (
 
 var dataSourcePolicyDocumentVarReplacer = strings.NewReplacer("&{", "${")
 
+const (
+	policyDocumentMergeSidsStrategyError     = "error"
+	policyDocumentMergeSidsStrategyKeepFirst = "keep_first"
+	policyDocumentMergeSidsStrategyKeepLast  = "keep_last"
+
+	// Managed policy size is counted without whitespace.
+	managedPolicyDocumentMaxLen = 6144
+)
+
+func policyDocumentMergeSidsStrategy_Values() []string {
+	return []string{
+		policyDocumentMergeSidsStrategyError,
+		policyDocumentMergeSidsStrategyKeepFirst,
+		policyDocumentMergeSidsStrategyKeepLast,
+	}
+}
+
 func DataSourcePolicyDocument() *schema.Resource {
 	setOfString := &schema.Schema{
 		Type:     schema.TypeSet,
@@ -28,10 +47,21 @@ func DataSourcePolicyDocument() *schema.Resource {
 		Read: dataSourcePolicyDocumentRead,
 
 		Schema: map[string]*schema.Schema{
+			"check_managed_policy_size": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"json": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"merge_sids_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      policyDocumentMergeSidsStrategyError,
+				ValidateFunc: validation.StringInSlice(policyDocumentMergeSidsStrategy_Values(), false),
+			},
 			"override_json": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -142,6 +172,8 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 			}
 		}
 
+		mergeSidsStrategy := d.Get("merge_sids_strategy").(string)
+
 		// merge sourceDocs in order specified
 		for sourceJSONIndex, sourceJSON := range v.([]interface{}) {
 			if sourceJSON == nil {
@@ -154,14 +186,33 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 			}
 
 			// assure all statements in sourceDoc are unique before merging
+			uniqueDoc := &IAMPolicyDoc{
+				Statements: make([]*IAMPolicyStatement, 0, len(sourceDoc.Statements)),
+			}
 			for stmtIndex, stmt := range sourceDoc.Statements {
+				// identical statements are redundant, whatever their Sid,
+				// both across source documents and within one
+				if mergedDoc.hasStatement(stmt) || uniqueDoc.hasStatement(stmt) {
+					continue
+				}
+
 				if stmt.Sid != "" {
 					if _, sidExists := sidMap[stmt.Sid]; sidExists {
-						return fmt.Errorf("duplicate Sid (%s) in source_policy_documents (item %d; statement %d). Remove the Sid or ensure Sids are unique.", stmt.Sid, sourceJSONIndex, stmtIndex)
+						switch mergeSidsStrategy {
+						case policyDocumentMergeSidsStrategyKeepFirst:
+							continue
+						case policyDocumentMergeSidsStrategyKeepLast:
+							// Merge overwrites the existing statement with the same Sid.
+						default:
+							return fmt.Errorf("duplicate Sid (%s) in source_policy_documents (item %d; statement %d). Remove the Sid, ensure Sids are unique or set merge_sids_strategy.", stmt.Sid, sourceJSONIndex, stmtIndex)
+						}
 					}
 					sidMap[stmt.Sid] = struct{}{}
 				}
+
+				uniqueDoc.Statements = append(uniqueDoc.Statements, stmt)
 			}
+			sourceDoc.Statements = uniqueDoc.Statements
 
 			mergedDoc.Merge(sourceDoc)
 		}
@@ -288,12 +339,54 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 	}
 	jsonString := string(jsonDoc)
 
+	if d.Get("check_managed_policy_size").(bool) {
+		n, err := managedPolicyDocumentLen(mergedDoc)
+		if err != nil {
+			return err
+		}
+
+		if n > managedPolicyDocumentMaxLen {
+			return fmt.Errorf("policy document is %d characters (excluding whitespace), which exceeds the IAM managed policy limit of %d characters", n, managedPolicyDocumentMaxLen)
+		}
+	}
+
 	d.Set("json", jsonString)
 	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
 
 	return nil
 }
 
+// managedPolicyDocumentLen returns the number of characters IAM counts against the managed policy size limit.
+// IAM does not count whitespace, and sees characters such as "<", ">" and "&" as themselves,
+// not as the \u003c style escapes json.Marshal produces for them.
+func managedPolicyDocumentLen(doc *IAMPolicyDoc) (int, error) {
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return 0, err
+	}
+
+	// The principal and condition set marshalers escape HTML characters themselves,
+	// so the document is decoded into plain values before being encoded without escaping.
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		return 0, err
+	}
+
+	var buf bytes.Buffer
+
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(v); err != nil {
+		return 0, err
+	}
+
+	return utf8.RuneCountInString(strings.Join(strings.Fields(buf.String()), "")), nil
+}
+
 func dataSourcePolicyDocumentReplaceVarsInList(in interface{}, version string) (interface{}, error) {
 	switch v := in.(type) {
 	case string:
@@ -147,6 +147,99 @@ func TestAccIAMPolicyDocumentDataSource_sourceListConflicting(t *testing.T) {
 	})
 }
 
+func TestAccIAMPolicyDocumentDataSource_sourceListDuplicate(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_listDuplicate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_iam_policy_document.test_source_list_duplicate", "json",
+						testAccPolicyDocumentSourceListDuplicateExpectedJSON,
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyDocumentDataSource_sourceListDuplicateWithinDocument(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_listDuplicateWithinDocument,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_iam_policy_document.test", "json",
+						testAccPolicyDocumentSourceListDuplicateWithinDocumentExpectedJSON,
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyDocumentDataSource_sourceListMergeSidsStrategy(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_listMergeSidsStrategy("keep_first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_iam_policy_document.test_source_list_conflicting", "json",
+						testAccPolicyDocumentSourceListKeepFirstExpectedJSON,
+					),
+				),
+			},
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_listMergeSidsStrategy("keep_last"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_iam_policy_document.test_source_list_conflicting", "json",
+						testAccPolicyDocumentSourceListKeepLastExpectedJSON,
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyDocumentDataSource_checkManagedPolicySize(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyDocumentDataSourceConfig_checkManagedPolicySize,
+				ExpectError: regexp.MustCompile(`exceeds the IAM managed policy limit`),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyDocumentDataSource_checkManagedPolicySizeHTMLCharacters(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Each "&" is one character for IAM, not the six of its \u0026 JSON escape.
+				Config: testAccPolicyDocumentDataSourceConfig_checkManagedPolicySizeHTMLCharacters,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.aws_iam_policy_document.test", "json"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIAMPolicyDocumentDataSource_override(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
@@ -961,6 +1054,162 @@ data "aws_iam_policy_document" "test_source_list_conflicting" {
 }
 `
 
+var testAccPolicyDocumentDataSourceConfig_listDuplicate = `
+data "aws_iam_policy_document" "policy_a" {
+  statement {
+    sid     = "sharedSid"
+    effect  = "Allow"
+    actions = ["foo:ActionOne"]
+  }
+
+  statement {
+    effect  = "Allow"
+    actions = ["bar:ActionOne"]
+  }
+}
+
+data "aws_iam_policy_document" "test_source_list_duplicate" {
+  version = "2012-10-17"
+
+  source_policy_documents = [
+    data.aws_iam_policy_document.policy_a.json,
+    data.aws_iam_policy_document.policy_a.json
+  ]
+}
+`
+
+var testAccPolicyDocumentSourceListDuplicateExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "sharedSid",
+      "Effect": "Allow",
+      "Action": "foo:ActionOne"
+    },
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Action": "bar:ActionOne"
+    }
+  ]
+}`
+
+var testAccPolicyDocumentDataSourceConfig_listDuplicateWithinDocument = `
+data "aws_iam_policy_document" "test" {
+  version = "2012-10-17"
+
+  source_policy_documents = [
+    jsonencode({
+      Version = "2012-10-17"
+      Statement = [
+        {
+          Effect   = "Allow"
+          Action   = "foo:ActionOne"
+          Resource = "*"
+        },
+        {
+          Effect   = "Allow"
+          Action   = "foo:ActionOne"
+          Resource = "*"
+        },
+      ]
+    })
+  ]
+}
+`
+
+var testAccPolicyDocumentSourceListDuplicateWithinDocumentExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Action": "foo:ActionOne",
+      "Resource": "*"
+    }
+  ]
+}`
+
+func testAccPolicyDocumentDataSourceConfig_listMergeSidsStrategy(strategy string) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "policy_a" {
+  statement {
+    sid     = "conflictSid"
+    effect  = "Allow"
+    actions = ["bar:ActionOne"]
+  }
+}
+
+data "aws_iam_policy_document" "policy_b" {
+  statement {
+    sid     = "conflictSid"
+    effect  = "Allow"
+    actions = ["bar:ActionTwo"]
+  }
+}
+
+data "aws_iam_policy_document" "test_source_list_conflicting" {
+  version             = "2012-10-17"
+  merge_sids_strategy = %[1]q
+
+  source_policy_documents = [
+    data.aws_iam_policy_document.policy_a.json,
+    data.aws_iam_policy_document.policy_b.json
+  ]
+}
+`, strategy)
+}
+
+var testAccPolicyDocumentSourceListKeepFirstExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "conflictSid",
+      "Effect": "Allow",
+      "Action": "bar:ActionOne"
+    }
+  ]
+}`
+
+var testAccPolicyDocumentSourceListKeepLastExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "conflictSid",
+      "Effect": "Allow",
+      "Action": "bar:ActionTwo"
+    }
+  ]
+}`
+
+var testAccPolicyDocumentDataSourceConfig_checkManagedPolicySize = `
+data "aws_iam_policy_document" "test" {
+  check_managed_policy_size = true
+
+  statement {
+    actions   = [for i in range(500) : format("s3:Action%04d", i)]
+    resources = ["*"]
+  }
+}
+`
+
+var testAccPolicyDocumentDataSourceConfig_checkManagedPolicySizeHTMLCharacters = `
+data "aws_iam_policy_document" "test" {
+  check_managed_policy_size = true
+
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["*"]
+
+    condition {
+      test     = "StringEquals"
+      variable = "aws:UserAgent"
+      values   = [join("", [for i in range(1200) : "&"])]
+    }
+  }
+}
+`
+
 var testAccPolicyDocumentDataSourceConfig_overrideDeprecated = `
 data "aws_partition" "current" {}
 
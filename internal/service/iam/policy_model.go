@@ -3,6 +3,7 @@ package iam
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"sort"
 	"strconv"
 )
@@ -43,6 +44,17 @@ type IAMPolicyStatementCondition struct {
 type IAMPolicyStatementPrincipalSet []IAMPolicyStatementPrincipal
 type IAMPolicyStatementConditionSet []IAMPolicyStatementCondition
 
+// hasStatement returns whether the document already contains a statement identical to stmt.
+func (s *IAMPolicyDoc) hasStatement(stmt *IAMPolicyStatement) bool {
+	for _, existingStatement := range s.Statements {
+		if reflect.DeepEqual(existingStatement, stmt) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (s *IAMPolicyDoc) Merge(newDoc *IAMPolicyDoc) {
 	// adopt newDoc's Id
 	if len(newDoc.Id) > 0 {
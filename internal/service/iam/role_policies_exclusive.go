@@ -0,0 +1,125 @@
+package iam
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceRolePoliciesExclusive() *schema.Resource {
+	return &schema.Resource{
+		// Create and Update both delete inline policies on the role that are not named in policy_names.
+		// Managed policy attachments are never touched.
+		Create: resourceRolePoliciesExclusivePut,
+		Update: resourceRolePoliciesExclusivePut,
+
+		Read:   resourceRolePoliciesExclusiveRead,
+		Delete: resourceRolePoliciesExclusiveDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"role_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validRolePolicyRole,
+			},
+		},
+	}
+}
+
+func resourceRolePoliciesExclusivePut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	roleName := d.Get("role_name").(string)
+	policyNames := flex.ExpandStringValueSet(d.Get("policy_names").(*schema.Set))
+
+	if err := syncRolePolicies(conn, roleName, policyNames); err != nil {
+		return fmt.Errorf("synchronizing IAM Role (%s) inline policies: %w", roleName, err)
+	}
+
+	d.SetId(roleName)
+
+	return resourceRolePoliciesExclusiveRead(d, meta)
+}
+
+func resourceRolePoliciesExclusiveRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	policyNames, err := FindRolePolicyNamesByRoleName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Role (%s) not found, removing inline policies exclusive management from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading IAM Role (%s) inline policies: %w", d.Id(), err)
+	}
+
+	d.Set("policy_names", policyNames)
+	d.Set("role_name", d.Id())
+
+	return nil
+}
+
+func resourceRolePoliciesExclusiveDelete(d *schema.ResourceData, meta interface{}) error {
+	// No inline policy is deleted here: the named ones are owned by their aws_iam_role_policy resources,
+	// and policies added to the role afterwards are simply no longer removed.
+	log.Printf("[DEBUG] Removing IAM Role (%s) inline policies exclusive management from state", d.Id())
+
+	return nil
+}
+
+// syncRolePolicies deletes any inline policy on the role that is not in the
+// wanted set. Policies in the set are expected to be created elsewhere, e.g.
+// with aws_iam_role_policy.
+func syncRolePolicies(conn *iam.IAM, roleName string, want []string) error {
+	have, err := FindRolePolicyNamesByRoleName(conn, roleName)
+
+	if err != nil {
+		return err
+	}
+
+	wanted := make(map[string]struct{}, len(want))
+	for _, name := range want {
+		wanted[name] = struct{}{}
+	}
+
+	for _, name := range have {
+		if _, ok := wanted[name]; ok {
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting IAM Role (%s) inline policy: %s", roleName, name)
+		_, err := conn.DeleteRolePolicy(&iam.DeleteRolePolicyInput{
+			PolicyName: aws.String(name),
+			RoleName:   aws.String(roleName),
+		})
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting inline policy (%s): %w", name, err)
+		}
+	}
+
+	return nil
+}
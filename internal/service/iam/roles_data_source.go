@@ -2,6 +2,7 @@ package iam
 
 import (
 	"fmt"
+	"net/url"
 	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -9,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func DataSourceRoles() *schema.Resource {
@@ -35,12 +38,36 @@ func DataSourceRoles() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"roles": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: dataSourceRolesRoleSchema(),
+				},
+			},
+			"tags": tftags.TagsSchema(),
 		},
 	}
 }
 
+// dataSourceRolesRoleSchema returns the schema of a single role, as returned by GetRole.
+func dataSourceRolesRoleSchema() map[string]*schema.Schema {
+	s := dataSourceRoleSchema()
+	s["tags"] = tftags.TagsSchemaComputed()
+
+	return s
+}
+
 func dataSourceRolesRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).IAMConn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+
+	filterTags := tftags.New(d.Get("tags").(map[string]interface{}))
 
 	input := &iam.ListRolesInput{}
 
@@ -60,7 +87,7 @@ func dataSourceRolesRead(d *schema.ResourceData, meta interface{}) error {
 				continue
 			}
 
-			if v, ok := d.GetOk("name_regex"); ok && !regexp.MustCompile(v.(string)).MatchString(aws.StringValue(role.RoleName)) {
+			if nameRegex != nil && !nameRegex.MatchString(aws.StringValue(role.RoleName)) {
 				continue
 			}
 
@@ -74,15 +101,46 @@ func dataSourceRolesRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error reading IAM roles: %w", err)
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
-
 	var arns, names []string
+	var roles []interface{}
 
 	for _, r := range results {
-		arns = append(arns, aws.StringValue(r.Arn))
-		names = append(names, aws.StringValue(r.RoleName))
+		// ListRoles does not return tags, permissions boundaries or last used information.
+		name := aws.StringValue(r.RoleName)
+		role, err := FindRoleByName(conn, name)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading IAM role (%s): %w", name, err)
+		}
+
+		tags := KeyValueTags(role.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+		if !tags.ContainsAll(filterTags) {
+			continue
+		}
+
+		tfMap := flattenDataSourceRole(role)
+
+		assumeRolePolicy, err := url.QueryUnescape(aws.StringValue(role.AssumeRolePolicyDocument))
+
+		if err != nil {
+			return fmt.Errorf("error parsing IAM role (%s) assume role policy: %w", name, err)
+		}
+
+		tfMap["assume_role_policy_document"] = assumeRolePolicy
+		tfMap["tags"] = tags.Map()
+
+		arns = append(arns, aws.StringValue(role.Arn))
+		names = append(names, name)
+		roles = append(roles, tfMap)
 	}
 
+	d.SetId(meta.(*conns.AWSClient).Region)
+
 	if err := d.Set("arns", arns); err != nil {
 		return fmt.Errorf("error setting arns: %w", err)
 	}
@@ -91,5 +149,9 @@ func dataSourceRolesRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting names: %w", err)
 	}
 
+	if err := d.Set("roles", roles); err != nil {
+		return fmt.Errorf("error setting roles: %w", err)
+	}
+
 	return nil
 }
@@ -114,6 +114,38 @@ func TestAccIAMRolesDataSource_nameRegexAndPathPrefix(t *testing.T) {
 	})
 }
 
+func TestAccIAMRolesDataSource_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_iam_roles.test"
+	resourceName := "aws_iam_role.test.0"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRolesDataSourceConfig_tags(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "names.*", resourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "roles.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "roles.*", map[string]string{
+						"max_session_duration": "3600",
+						"path":                 "/",
+						"role_name":            rName + "-0",
+						"tags.%":               "2",
+						"tags.Seed":            rName,
+						"tags.Selected":        "true",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "roles.*.arn", resourceName, "arn"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "roles.*.role_id", resourceName, "unique_id"),
+				),
+			},
+		},
+	})
+}
+
 const testAccRolesDataSourceConfig_basic = `
 data "aws_iam_roles" "test" {}
 `
@@ -227,3 +259,41 @@ data "aws_iam_roles" "test" {
 }
 `, rCount, rName, rPathPrefix, rIndex)
 }
+
+func testAccRolesDataSourceConfig_tags(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  count = 2
+  name  = "%[1]s-${count.index}"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ec2.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+
+  tags = {
+    Seed     = %[1]q
+    Selected = count.index == 0 ? "true" : "false"
+  }
+}
+
+data "aws_iam_roles" "test" {
+  name_regex = "^%[1]s-"
+
+  tags = {
+    Seed     = %[1]q
+    Selected = "true"
+  }
+
+  depends_on = [aws_iam_role.test]
+}
+`, rName)
+}
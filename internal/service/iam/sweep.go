@@ -340,6 +340,7 @@ func sweepServiceSpecificCredentials(region string) error {
 	var sweeperErrs *multierror.Error
 
 	prefixes := []string{
+		sweep.ResourcePrefix,
 		"test-user",
 		"test_user",
 		"tf-acc",
@@ -660,6 +661,7 @@ func sweepUsers(region string) error {
 	}
 	conn := client.(*conns.AWSClient).IAMConn
 	prefixes := []string{
+		sweep.ResourcePrefix,
 		"test-user",
 		"test_user",
 		"tf-acc",
@@ -848,6 +850,7 @@ func roleNameFilter(name string) bool {
 	// We have a lot of role name prefixes for role names that don't match the standard pattern. This is not an
 	// exhaustive list.
 	prefixes := []string{
+		sweep.ResourcePrefix,
 		"another_rds",
 		"aws_batch_service_role",
 		"aws_elastictranscoder_pipeline_tf_test",
@@ -948,6 +951,7 @@ func sweepSigningCertificates(region string) error {
 	var sweeperErrs *multierror.Error
 
 	prefixes := []string{
+		sweep.ResourcePrefix,
 		"test-user",
 		"test_user",
 		"tf-acc",
@@ -337,6 +337,11 @@ func resourceStreamUpdate(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[DEBUG] Updating Kinesis Stream stream mode: %s", input)
 		_, err := conn.UpdateStreamMode(input)
 
+		// The capacity mode of a stream can be switched at most twice in a rolling 24-hour period.
+		if tfawserr.ErrCodeEquals(err, kinesis.ErrCodeLimitExceededException) {
+			return fmt.Errorf("error updating Kinesis Stream (%s) stream mode: the stream mode can be switched at most twice in a rolling 24-hour period, retry once 24 hours have passed since the earlier of the last two switches: %w", name, err)
+		}
+
 		if err != nil {
 			return fmt.Errorf("error updating Kinesis Stream (%s) stream mode: %w", name, err)
 		}
@@ -348,7 +353,7 @@ func resourceStreamUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if streamMode := getStreamMode(d); streamMode == kinesis.StreamModeProvisioned && d.HasChange("shard_count") {
+	if streamMode := getStreamMode(d); streamMode == kinesis.StreamModeProvisioned && d.HasChange("shard_count") && !streamHasOpenShardCount(conn, name, d.Get("shard_count").(int)) {
 		input := &kinesis.UpdateShardCountInput{
 			ScalingType:      aws.String(kinesis.ScalingTypeUniformScaling),
 			StreamName:       aws.String(name),
@@ -655,6 +660,18 @@ func waitStreamUpdated(conn *kinesis.Kinesis, name string, timeout time.Duration
 	return nil, err
 }
 
+// streamHasOpenShardCount returns whether the stream already has the specified
+// number of open shards, e.g. after switching from ON_DEMAND to PROVISIONED.
+func streamHasOpenShardCount(conn *kinesis.Kinesis, name string, shardCount int) bool {
+	stream, err := FindStreamByName(conn, name)
+
+	if err != nil {
+		return false
+	}
+
+	return int(aws.Int64Value(stream.OpenShardCount)) == shardCount
+}
+
 func getStreamMode(d *schema.ResourceData) string {
 	streamMode, ok := d.GetOk("stream_mode_details.0.stream_mode")
 	if !ok {
@@ -0,0 +1,304 @@
+package kinesisvideo
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesisvideo"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceSignalingChannel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSignalingChannelCreate,
+		Read:   resourceSignalingChannelRead,
+		Update: resourceSignalingChannelUpdate,
+		Delete: resourceSignalingChannelDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`), "must only include alphanumeric, underscore, period, or hyphen characters"),
+				),
+			},
+
+			"message_ttl_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(5, 120),
+			},
+
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      kinesisvideo.ChannelTypeSingleMaster,
+				ValidateFunc: validation.StringInSlice(kinesisvideo.ChannelType_Values(), false),
+			},
+
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tftags.TagsSchema(),
+
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceSignalingChannelCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KinesisVideoConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &kinesisvideo.CreateSignalingChannelInput{
+		ChannelName: aws.String(name),
+		ChannelType: aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("message_ttl_seconds"); ok {
+		input.SingleMasterConfiguration = &kinesisvideo.SingleMasterConfiguration{
+			MessageTtlSeconds: aws.Int64(int64(v.(int))),
+		}
+	}
+
+	if len(tags) > 0 {
+		input.Tags = resourceTags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateSignalingChannel(input)
+
+	if err != nil {
+		return fmt.Errorf("creating Kinesis Video Signaling Channel (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ChannelARN))
+
+	if _, err := waitSignalingChannelActive(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("waiting for Kinesis Video Signaling Channel (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceSignalingChannelRead(d, meta)
+}
+
+func resourceSignalingChannelRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KinesisVideoConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	channel, err := FindSignalingChannelByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Kinesis Video Signaling Channel (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading Kinesis Video Signaling Channel (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", channel.ChannelARN)
+	d.Set("name", channel.ChannelName)
+	d.Set("type", channel.ChannelType)
+	d.Set("version", channel.Version)
+	if channel.CreationTime != nil {
+		d.Set("creation_time", aws.TimeValue(channel.CreationTime).Format(time.RFC3339))
+	}
+	if channel.SingleMasterConfiguration != nil {
+		d.Set("message_ttl_seconds", channel.SingleMasterConfiguration.MessageTtlSeconds)
+	} else {
+		d.Set("message_ttl_seconds", nil)
+	}
+
+	tags, err := listResourceTags(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("listing tags for Kinesis Video Signaling Channel (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceSignalingChannelUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KinesisVideoConn
+
+	if d.HasChange("message_ttl_seconds") {
+		input := &kinesisvideo.UpdateSignalingChannelInput{
+			ChannelARN:     aws.String(d.Id()),
+			CurrentVersion: aws.String(d.Get("version").(string)),
+			SingleMasterConfiguration: &kinesisvideo.SingleMasterConfiguration{
+				MessageTtlSeconds: aws.Int64(int64(d.Get("message_ttl_seconds").(int))),
+			},
+		}
+
+		if _, err := conn.UpdateSignalingChannel(input); err != nil {
+			return fmt.Errorf("updating Kinesis Video Signaling Channel (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitSignalingChannelActive(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("waiting for Kinesis Video Signaling Channel (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := updateResourceTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("updating Kinesis Video Signaling Channel (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceSignalingChannelRead(d, meta)
+}
+
+func resourceSignalingChannelDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KinesisVideoConn
+
+	log.Printf("[DEBUG] Deleting Kinesis Video Signaling Channel: %s", d.Id())
+	_, err := conn.DeleteSignalingChannel(&kinesisvideo.DeleteSignalingChannelInput{
+		ChannelARN:     aws.String(d.Id()),
+		CurrentVersion: aws.String(d.Get("version").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, kinesisvideo.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting Kinesis Video Signaling Channel (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitSignalingChannelDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("waiting for Kinesis Video Signaling Channel (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindSignalingChannelByARN(conn *kinesisvideo.KinesisVideo, arn string) (*kinesisvideo.ChannelInfo, error) {
+	input := &kinesisvideo.DescribeSignalingChannelInput{
+		ChannelARN: aws.String(arn),
+	}
+
+	output, err := conn.DescribeSignalingChannel(input)
+
+	if tfawserr.ErrCodeEquals(err, kinesisvideo.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ChannelInfo == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ChannelInfo, nil
+}
+
+func statusSignalingChannel(conn *kinesisvideo.KinesisVideo, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindSignalingChannelByARN(conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ChannelStatus), nil
+	}
+}
+
+func waitSignalingChannelActive(conn *kinesisvideo.KinesisVideo, arn string, timeout time.Duration) (*kinesisvideo.ChannelInfo, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{kinesisvideo.StatusCreating, kinesisvideo.StatusUpdating},
+		Target:     []string{kinesisvideo.StatusActive},
+		Refresh:    statusSignalingChannel(conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 3 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kinesisvideo.ChannelInfo); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSignalingChannelDeleted(conn *kinesisvideo.KinesisVideo, arn string, timeout time.Duration) (*kinesisvideo.ChannelInfo, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{kinesisvideo.StatusDeleting},
+		Target:     []string{},
+		Refresh:    statusSignalingChannel(conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 3 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kinesisvideo.ChannelInfo); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -48,10 +48,10 @@ func ResourceStream() *schema.Resource {
 			},
 
 			"data_retention_in_hours": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				ForceNew: true,
-				Default:  0,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
 			},
 
 			"device_name": {
@@ -73,6 +73,10 @@ func ResourceStream() *schema.Resource {
 			"media_type": {
 				Type:     schema.TypeString,
 				Optional: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[\w\-\.\+]+/[\w\-\.\+]+(,[\w\-\.\+]+/[\w\-\.\+]+)*$`), "must be one or more comma-separated media types, e.g. video/h264"),
+				),
 			},
 
 			"creation_time": {
@@ -208,8 +212,49 @@ func resourceStreamUpdate(d *schema.ResourceData, meta interface{}) error {
 		updateOpts.MediaType = aws.String(v.(string))
 	}
 
-	if _, err := conn.UpdateStream(updateOpts); err != nil {
-		return fmt.Errorf("Error updating Kinesis Video Stream (%s): %w", d.Id(), err)
+	if d.HasChanges("device_name", "media_type") {
+		if _, err := conn.UpdateStream(updateOpts); err != nil {
+			return fmt.Errorf("Error updating Kinesis Video Stream (%s): %w", d.Id(), err)
+		}
+
+		if err := waitStreamUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("Error waiting for updating Kinesis Video Stream (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("data_retention_in_hours") {
+		o, n := d.GetChange("data_retention_in_hours")
+		change := n.(int) - o.(int)
+
+		input := &kinesisvideo.UpdateDataRetentionInput{
+			StreamARN:                  aws.String(d.Id()),
+			DataRetentionChangeInHours: aws.Int64(int64(change)),
+			Operation:                  aws.String(kinesisvideo.UpdateDataRetentionOperationIncreaseDataRetention),
+		}
+
+		if change < 0 {
+			input.DataRetentionChangeInHours = aws.Int64(int64(-change))
+			input.Operation = aws.String(kinesisvideo.UpdateDataRetentionOperationDecreaseDataRetention)
+		}
+
+		// Each update changes the stream version.
+		output, err := conn.DescribeStream(&kinesisvideo.DescribeStreamInput{
+			StreamARN: aws.String(d.Id()),
+		})
+
+		if err != nil {
+			return fmt.Errorf("Error describing Kinesis Video Stream (%s): %w", d.Id(), err)
+		}
+
+		input.CurrentVersion = output.StreamInfo.Version
+
+		if _, err := conn.UpdateDataRetention(input); err != nil {
+			return fmt.Errorf("Error updating Kinesis Video Stream (%s) data retention: %w", d.Id(), err)
+		}
+
+		if err := waitStreamUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("Error waiting for updating Kinesis Video Stream (%s): %w", d.Id(), err)
+		}
 	}
 
 	if d.HasChange("tags_all") {
@@ -220,19 +265,6 @@ func resourceStreamUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{kinesisvideo.StatusUpdating},
-		Target:     []string{kinesisvideo.StatusActive},
-		Refresh:    StreamStateRefresh(conn, d.Id()),
-		Timeout:    d.Timeout(schema.TimeoutUpdate),
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
-
-	if _, err := stateConf.WaitForState(); err != nil {
-		return fmt.Errorf("Error waiting for updating Kinesis Video Stream (%s): %w", d.Id(), err)
-	}
-
 	return resourceStreamRead(d, meta)
 }
 
@@ -282,3 +314,18 @@ func StreamStateRefresh(conn *kinesisvideo.KinesisVideo, arn string) resource.St
 		return resp, aws.StringValue(resp.StreamInfo.Status), nil
 	}
 }
+
+func waitStreamUpdated(conn *kinesisvideo.KinesisVideo, arn string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{kinesisvideo.StatusUpdating},
+		Target:     []string{kinesisvideo.StatusActive},
+		Refresh:    StreamStateRefresh(conn, arn),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
@@ -147,6 +147,45 @@ func TestAccKinesisVideoStream_tags(t *testing.T) {
 	})
 }
 
+func TestAccKinesisVideoStream_dataRetention(t *testing.T) {
+	var stream1, stream2 kinesisvideo.StreamInfo
+
+	resourceName := "aws_kinesis_video_stream.default"
+	rInt := sdkacctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kinesisvideo.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kinesisvideo.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStreamConfig_dataRetention(rInt, 24),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStreamExists(resourceName, &stream1),
+					resource.TestCheckResourceAttr(resourceName, "data_retention_in_hours", "24"),
+				),
+			},
+			{
+				Config: testAccStreamConfig_dataRetention(rInt, 48),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStreamExists(resourceName, &stream2),
+					testAccCheckStreamNotRecreated(&stream1, &stream2),
+					resource.TestCheckResourceAttr(resourceName, "data_retention_in_hours", "48"),
+				),
+			},
+			{
+				Config: testAccStreamConfig_dataRetention(rInt, 12),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStreamExists(resourceName, &stream2),
+					testAccCheckStreamNotRecreated(&stream1, &stream2),
+					resource.TestCheckResourceAttr(resourceName, "data_retention_in_hours", "12"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKinesisVideoStream_disappears(t *testing.T) {
 	var stream kinesisvideo.StreamInfo
 
@@ -236,6 +275,16 @@ func testAccCheckStreamExists(n string, stream *kinesisvideo.StreamInfo) resourc
 	}
 }
 
+func testAccCheckStreamNotRecreated(before, after *kinesisvideo.StreamInfo) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.TimeValue(before.CreationTime), aws.TimeValue(after.CreationTime); !before.Equal(after) {
+			return fmt.Errorf("Kinesis Video Stream was recreated")
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckStreamDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_kinesis_video_stream" {
@@ -284,6 +333,15 @@ resource "aws_kinesis_video_stream" "default" {
 `, rInt, rName, mediaType)
 }
 
+func testAccStreamConfig_dataRetention(rInt, hours int) string {
+	return fmt.Sprintf(`
+resource "aws_kinesis_video_stream" "default" {
+  name                    = "terraform-kinesis-video-stream-test-%[1]d"
+  data_retention_in_hours = %[2]d
+}
+`, rInt, hours)
+}
+
 func testAccStreamConfig_tags1(rInt int, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_kinesis_video_stream" "default" {
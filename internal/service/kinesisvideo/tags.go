@@ -0,0 +1,82 @@
+//go:build !generate
+// +build !generate
+
+package kinesisvideo
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesisvideo"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// Custom Kinesis Video signaling channel tag service functions using the same format as generated code.
+// The generated functions operate on streams only.
+
+// listResourceTags lists kinesisvideo service tags for a signaling channel.
+// The identifier is the channel ARN.
+func listResourceTags(conn *kinesisvideo.KinesisVideo, identifier string) (tftags.KeyValueTags, error) {
+	input := &kinesisvideo.ListTagsForResourceInput{
+		ResourceARN: aws.String(identifier),
+	}
+
+	output, err := conn.ListTagsForResource(input)
+
+	if err != nil {
+		return tftags.New(nil), err
+	}
+
+	return KeyValueTags(output.Tags), nil
+}
+
+// resourceTags returns kinesisvideo service tags in the list form used by signaling channel APIs.
+func resourceTags(tags tftags.KeyValueTags) []*kinesisvideo.Tag {
+	result := make([]*kinesisvideo.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &kinesisvideo.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// updateResourceTags updates kinesisvideo signaling channel tags.
+// The identifier is the channel ARN.
+func updateResourceTags(conn *kinesisvideo.KinesisVideo, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := tftags.New(oldTagsMap)
+	newTags := tftags.New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
+		input := &kinesisvideo.UntagResourceInput{
+			ResourceARN: aws.String(identifier),
+			TagKeyList:  aws.StringSlice(removedTags.IgnoreAWS().Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("untagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags); len(updatedTags) > 0 {
+		input := &kinesisvideo.TagResourceInput{
+			ResourceARN: aws.String(identifier),
+			Tags:        resourceTags(updatedTags.IgnoreAWS()),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("tagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	return nil
+}
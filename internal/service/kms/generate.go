@@ -1,4 +1,5 @@
 //go:generate go run ../../generate/tags/main.go -ListTags -ListTagsOp=ListResourceTags -ListTagsInIDElem=KeyId -ServiceTagsSlice -TagInIDElem=KeyId -TagTypeKeyElem=TagKey -TagTypeValElem=TagValue -UpdateTags
+//go:generate go run -tags generate ../../generate/listdatasource/main.go -Types=KeyMetadata
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package kms
@@ -0,0 +1,193 @@
+package kms
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceKeys() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceKeysRead,
+
+		Schema: map[string]*schema.Schema{
+			"alias_name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"arns": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"key_manager": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(kms.KeyManagerType_Values(), false),
+			},
+			"keys": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: dataSourceKeysKeySchema(),
+				},
+			},
+			"tags": tftags.TagsSchema(),
+		},
+	}
+}
+
+// dataSourceKeysKeySchema returns the schema of a single key, as returned by DescribeKey, plus its alias names and tags.
+func dataSourceKeysKeySchema() map[string]*schema.Schema {
+	s := dataSourceKeyMetadataSchema()
+	s["aliases"] = &schema.Schema{
+		Type:     schema.TypeSet,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+	s["tags"] = tftags.TagsSchemaComputed()
+
+	return s
+}
+
+func dataSourceKeysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KMSConn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	var aliasNameRegex *regexp.Regexp
+	if v, ok := d.GetOk("alias_name_regex"); ok {
+		aliasNameRegex = regexp.MustCompile(v.(string))
+	}
+
+	keyManager := d.Get("key_manager").(string)
+	filterTags := tftags.New(d.Get("tags").(map[string]interface{}))
+
+	// Alias names by target key ID.
+	aliasNames := make(map[string][]string)
+
+	err := conn.ListAliasesPagesWithContext(ctx, &kms.ListAliasesInput{}, func(page *kms.ListAliasesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Aliases {
+			if v == nil || v.TargetKeyId == nil {
+				continue
+			}
+
+			keyID := aws.StringValue(v.TargetKeyId)
+			aliasNames[keyID] = append(aliasNames[keyID], aws.StringValue(v.AliasName))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return diag.Errorf("listing KMS Aliases: %s", err)
+	}
+
+	var keyIDs []string
+
+	err = conn.ListKeysPagesWithContext(ctx, &kms.ListKeysInput{}, func(page *kms.ListKeysOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Keys {
+			if v == nil {
+				continue
+			}
+
+			keyID := aws.StringValue(v.KeyId)
+
+			if aliasNameRegex != nil && !anyMatch(aliasNameRegex, aliasNames[keyID]) {
+				continue
+			}
+
+			keyIDs = append(keyIDs, keyID)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return diag.Errorf("listing KMS Keys: %s", err)
+	}
+
+	var arns, ids []string
+	var keys []interface{}
+
+	for _, keyID := range keyIDs {
+		output, err := conn.DescribeKeyWithContext(ctx, &kms.DescribeKeyInput{
+			KeyId: aws.String(keyID),
+		})
+
+		if err != nil {
+			return diag.Errorf("reading KMS Key (%s): %s", keyID, err)
+		}
+
+		keyMetadata := output.KeyMetadata
+
+		if keyManager != "" && aws.StringValue(keyMetadata.KeyManager) != keyManager {
+			continue
+		}
+
+		// AWS managed keys cannot be tagged.
+		tags := tftags.New(nil)
+
+		if aws.StringValue(keyMetadata.KeyManager) == kms.KeyManagerTypeCustomer {
+			tags, err = ListTagsWithContext(ctx, conn, keyID)
+
+			if err != nil {
+				return diag.Errorf("listing tags for KMS Key (%s): %s", keyID, err)
+			}
+
+			tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+		}
+
+		if !tags.ContainsAll(filterTags) {
+			continue
+		}
+
+		tfMap := flattenDataSourceKeyMetadata(keyMetadata)
+		tfMap["aliases"] = aliasNames[keyID]
+		tfMap["tags"] = tags.Map()
+
+		arns = append(arns, aws.StringValue(keyMetadata.Arn))
+		ids = append(ids, keyID)
+		keys = append(keys, tfMap)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("arns", arns)
+	d.Set("ids", ids)
+
+	if err := d.Set("keys", keys); err != nil {
+		return diag.Errorf("setting keys: %s", err)
+	}
+
+	return nil
+}
+
+func anyMatch(re *regexp.Regexp, values []string) bool {
+	for _, v := range values {
+		if re.MatchString(v) {
+			return true
+		}
+	}
+
+	return false
+}
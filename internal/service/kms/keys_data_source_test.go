@@ -0,0 +1,115 @@
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccKMSKeysDataSource_basic(t *testing.T) {
+	resourceName := "aws_kms_key.test"
+	dataSourceName := "data.aws_kms_keys.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeysDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "arns.*", resourceName, "arn"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "ids.*", resourceName, "key_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKMSKeysDataSource_filters(t *testing.T) {
+	resourceName := "aws_kms_key.test.0"
+	dataSourceName := "data.aws_kms_keys.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeysDataSourceConfig_filters(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "ids.*", resourceName, "key_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "keys.*", map[string]string{
+						"aliases.#":     "1",
+						"description":   rName,
+						"enabled":       "true",
+						"key_manager":   "CUSTOMER",
+						"key_spec":      "SYMMETRIC_DEFAULT",
+						"key_state":     "Enabled",
+						"key_usage":     "ENCRYPT_DECRYPT",
+						"tags.%":        "2",
+						"tags.Seed":     rName,
+						"tags.Selected": "true",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "keys.*.arn", resourceName, "arn"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "keys.*.aliases.*", "aws_kms_alias.test.0", "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKeysDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+data "aws_kms_keys" "test" {
+  depends_on = [aws_kms_key.test]
+}
+`, rName)
+}
+
+func testAccKeysDataSourceConfig_filters(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  count = 2
+
+  description             = %[1]q
+  deletion_window_in_days = 7
+
+  tags = {
+    Seed     = %[1]q
+    Selected = count.index == 0 ? "true" : "false"
+  }
+}
+
+resource "aws_kms_alias" "test" {
+  count = 2
+
+  name          = "alias/%[1]s-${count.index}"
+  target_key_id = aws_kms_key.test[count.index].key_id
+}
+
+data "aws_kms_keys" "test" {
+  alias_name_regex = "^alias/%[1]s-"
+  key_manager      = "CUSTOMER"
+
+  tags = {
+    Selected = "true"
+  }
+
+  depends_on = [aws_kms_alias.test]
+}
+`, rName)
+}
@@ -0,0 +1,294 @@
+// Code generated by "internal/generate/listdatasource/main.go -Types=KeyMetadata"; DO NOT EDIT.
+
+package kms
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceKeyMetadataSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"arn": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"aws_account_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"cloud_hsm_cluster_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"creation_date": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"custom_key_store_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"deletion_date": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"enabled": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"encryption_algorithms": {
+			Type:     schema.TypeList,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Computed: true,
+		},
+		"expiration_model": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"key_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"key_manager": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"key_spec": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"key_state": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"key_usage": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"mac_algorithms": {
+			Type:     schema.TypeList,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Computed: true,
+		},
+		"multi_region": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"multi_region_configuration": {
+			Type: schema.TypeList,
+			Elem: &schema.Resource{
+				Schema: dataSourceMultiRegionConfigurationSchema(),
+			},
+			Computed: true,
+		},
+		"origin": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"pending_deletion_window_in_days": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"signing_algorithms": {
+			Type:     schema.TypeList,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Computed: true,
+		},
+		"valid_to": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func flattenDataSourceKeyMetadata(apiObject *kms.KeyMetadata) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Arn; v != nil {
+		tfMap["arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.AWSAccountId; v != nil {
+		tfMap["aws_account_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.CloudHsmClusterId; v != nil {
+		tfMap["cloud_hsm_cluster_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.CreationDate; v != nil {
+		tfMap["creation_date"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.CustomKeyStoreId; v != nil {
+		tfMap["custom_key_store_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.DeletionDate; v != nil {
+		tfMap["deletion_date"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.Description; v != nil {
+		tfMap["description"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Enabled; v != nil {
+		tfMap["enabled"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.EncryptionAlgorithms; v != nil {
+		tfMap["encryption_algorithms"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.ExpirationModel; v != nil {
+		tfMap["expiration_model"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.KeyId; v != nil {
+		tfMap["key_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.KeyManager; v != nil {
+		tfMap["key_manager"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.KeySpec; v != nil {
+		tfMap["key_spec"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.KeyState; v != nil {
+		tfMap["key_state"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.KeyUsage; v != nil {
+		tfMap["key_usage"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.MacAlgorithms; v != nil {
+		tfMap["mac_algorithms"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.MultiRegion; v != nil {
+		tfMap["multi_region"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.MultiRegionConfiguration; v != nil {
+		tfMap["multi_region_configuration"] = []interface{}{flattenDataSourceMultiRegionConfiguration(v)}
+	}
+
+	if v := apiObject.Origin; v != nil {
+		tfMap["origin"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.PendingDeletionWindowInDays; v != nil {
+		tfMap["pending_deletion_window_in_days"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.SigningAlgorithms; v != nil {
+		tfMap["signing_algorithms"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.ValidTo; v != nil {
+		tfMap["valid_to"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
+
+func dataSourceMultiRegionConfigurationSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"multi_region_key_type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"primary_key": {
+			Type: schema.TypeList,
+			Elem: &schema.Resource{
+				Schema: dataSourceMultiRegionKeySchema(),
+			},
+			Computed: true,
+		},
+		"replica_keys": {
+			Type: schema.TypeList,
+			Elem: &schema.Resource{
+				Schema: dataSourceMultiRegionKeySchema(),
+			},
+			Computed: true,
+		},
+	}
+}
+
+func flattenDataSourceMultiRegionConfiguration(apiObject *kms.MultiRegionConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.MultiRegionKeyType; v != nil {
+		tfMap["multi_region_key_type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.PrimaryKey; v != nil {
+		tfMap["primary_key"] = []interface{}{flattenDataSourceMultiRegionKey(v)}
+	}
+
+	if v := apiObject.ReplicaKeys; v != nil {
+		var tfList []interface{}
+
+		for _, v := range v {
+			if v == nil {
+				continue
+			}
+
+			tfList = append(tfList, flattenDataSourceMultiRegionKey(v))
+		}
+
+		tfMap["replica_keys"] = tfList
+	}
+
+	return tfMap
+}
+
+func dataSourceMultiRegionKeySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"arn": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"region": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func flattenDataSourceMultiRegionKey(apiObject *kms.MultiRegionKey) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Arn; v != nil {
+		tfMap["arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Region; v != nil {
+		tfMap["region"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,131 @@
+package lambda
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceFunctions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFunctionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"function_arns": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"function_names": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"functions": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: dataSourceFunctionsFunctionSchema(),
+				},
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"tags": tftags.TagsSchema(),
+		},
+	}
+}
+
+// dataSourceFunctionsFunctionSchema returns the schema of a single function, as returned by ListFunctions, plus its tags.
+func dataSourceFunctionsFunctionSchema() map[string]*schema.Schema {
+	s := dataSourceFunctionConfigurationSchema()
+	s["tags"] = tftags.TagsSchemaComputed()
+
+	return s
+}
+
+func dataSourceFunctionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LambdaConn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+
+	filterTags := tftags.New(d.Get("tags").(map[string]interface{}))
+
+	input := &lambda.ListFunctionsInput{}
+	var functionConfigurations []*lambda.FunctionConfiguration
+
+	err := conn.ListFunctionsPagesWithContext(ctx, input, func(page *lambda.ListFunctionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Functions {
+			if v == nil {
+				continue
+			}
+
+			if nameRegex != nil && !nameRegex.MatchString(aws.StringValue(v.FunctionName)) {
+				continue
+			}
+
+			functionConfigurations = append(functionConfigurations, v)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return diag.Errorf("listing Lambda Functions: %s", err)
+	}
+
+	var functionARNs, functionNames []string
+	var functions []interface{}
+
+	for _, v := range functionConfigurations {
+		functionARN := aws.StringValue(v.FunctionArn)
+
+		output, err := conn.ListTagsWithContext(ctx, &lambda.ListTagsInput{
+			Resource: aws.String(functionARN),
+		})
+
+		if err != nil {
+			return diag.Errorf("listing tags for Lambda Function (%s): %s", functionARN, err)
+		}
+
+		tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+		if !tags.ContainsAll(filterTags) {
+			continue
+		}
+
+		tfMap := flattenDataSourceFunctionConfiguration(v)
+		tfMap["tags"] = tags.Map()
+
+		functionARNs = append(functionARNs, functionARN)
+		functionNames = append(functionNames, aws.StringValue(v.FunctionName))
+		functions = append(functions, tfMap)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("function_arns", functionARNs)
+	d.Set("function_names", functionNames)
+
+	if err := d.Set("functions", functions); err != nil {
+		return diag.Errorf("setting functions: %s", err)
+	}
+
+	return nil
+}
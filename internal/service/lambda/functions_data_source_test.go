@@ -0,0 +1,149 @@
+package lambda_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lambda"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccLambdaFunctionsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_lambda_functions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionsDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "function_names.#", regexp.MustCompile("^[0-9]+$")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunctionsDataSource_nameRegex(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lambda_functions.test"
+	resourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionsDataSourceConfig_nameRegex(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "function_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "function_arns.*", resourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "function_names.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "function_names.*", resourceName, "function_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "functions.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "functions.*", map[string]string{
+						"function_name": rName,
+						"handler":       "exports.example",
+						"memory_size":   "128",
+						"runtime":       "nodejs16.x",
+						"tags.%":        "0",
+						"timeout":       "3",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "functions.*.role", resourceName, "role"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunctionsDataSource_tags(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lambda_functions.test"
+	resourceName := "aws_lambda_function.test.0"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionsDataSourceConfig_tags(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "function_names.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "function_names.*", resourceName, "function_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "functions.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "functions.*", map[string]string{
+						"tags.%":        "2",
+						"tags.Seed":     rName,
+						"tags.Selected": "true",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "functions.*.function_arn", resourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
+const testAccFunctionsDataSourceConfig_basic = `
+data "aws_lambda_functions" "test" {}
+`
+
+func testAccFunctionsDataSourceConfig_nameRegex(rName string) string {
+	return acctest.ConfigCompose(testAccFunctionDataSourceConfig_base(rName), fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  handler       = "exports.example"
+  role          = aws_iam_role.lambda.arn
+  runtime       = "nodejs16.x"
+}
+
+data "aws_lambda_functions" "test" {
+  name_regex = "^${aws_lambda_function.test.function_name}$"
+}
+`, rName))
+}
+
+func testAccFunctionsDataSourceConfig_tags(rName string) string {
+	return acctest.ConfigCompose(testAccFunctionDataSourceConfig_base(rName), fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  count = 2
+
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = "%[1]s-${count.index}"
+  handler       = "exports.example"
+  role          = aws_iam_role.lambda.arn
+  runtime       = "nodejs16.x"
+
+  tags = {
+    Seed     = %[1]q
+    Selected = count.index == 0 ? "true" : "false"
+  }
+}
+
+data "aws_lambda_functions" "test" {
+  name_regex = "^%[1]s-"
+
+  tags = {
+    Seed     = %[1]q
+    Selected = "true"
+  }
+
+  depends_on = [aws_lambda_function.test]
+}
+`, rName))
+}
@@ -0,0 +1,699 @@
+// Code generated by "internal/generate/listdatasource/main.go -Types=FunctionConfiguration"; DO NOT EDIT.
+
+package lambda
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDeadLetterConfigSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"target_arn": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func flattenDataSourceDeadLetterConfig(apiObject *lambda.DeadLetterConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.TargetArn; v != nil {
+		tfMap["target_arn"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func dataSourceEnvironmentErrorSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"error_code": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"message": {
+			Type:      schema.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+func flattenDataSourceEnvironmentError(apiObject *lambda.EnvironmentError) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ErrorCode; v != nil {
+		tfMap["error_code"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Message; v != nil {
+		tfMap["message"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func dataSourceEnvironmentResponseSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"error": {
+			Type: schema.TypeList,
+			Elem: &schema.Resource{
+				Schema: dataSourceEnvironmentErrorSchema(),
+			},
+			Computed: true,
+		},
+		"variables": {
+			Type:      schema.TypeMap,
+			Elem:      &schema.Schema{Type: schema.TypeString},
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+func flattenDataSourceEnvironmentResponse(apiObject *lambda.EnvironmentResponse) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Error; v != nil {
+		tfMap["error"] = []interface{}{flattenDataSourceEnvironmentError(v)}
+	}
+
+	if v := apiObject.Variables; v != nil {
+		tfMap["variables"] = aws.StringValueMap(v)
+	}
+
+	return tfMap
+}
+
+func dataSourceEphemeralStorageSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"size": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func flattenDataSourceEphemeralStorage(apiObject *lambda.EphemeralStorage) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Size; v != nil {
+		tfMap["size"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
+
+func dataSourceFileSystemConfigSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"arn": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"local_mount_path": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func flattenDataSourceFileSystemConfig(apiObject *lambda.FileSystemConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Arn; v != nil {
+		tfMap["arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.LocalMountPath; v != nil {
+		tfMap["local_mount_path"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func dataSourceFunctionConfigurationSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"architectures": {
+			Type:     schema.TypeList,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Computed: true,
+		},
+		"code_sha256": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"code_size": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"dead_letter_config": {
+			Type: schema.TypeList,
+			Elem: &schema.Resource{
+				Schema: dataSourceDeadLetterConfigSchema(),
+			},
+			Computed: true,
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"environment": {
+			Type: schema.TypeList,
+			Elem: &schema.Resource{
+				Schema: dataSourceEnvironmentResponseSchema(),
+			},
+			Computed: true,
+		},
+		"ephemeral_storage": {
+			Type: schema.TypeList,
+			Elem: &schema.Resource{
+				Schema: dataSourceEphemeralStorageSchema(),
+			},
+			Computed: true,
+		},
+		"file_system_configs": {
+			Type: schema.TypeList,
+			Elem: &schema.Resource{
+				Schema: dataSourceFileSystemConfigSchema(),
+			},
+			Computed: true,
+		},
+		"function_arn": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"function_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"handler": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"image_config_response": {
+			Type: schema.TypeList,
+			Elem: &schema.Resource{
+				Schema: dataSourceImageConfigResponseSchema(),
+			},
+			Computed: true,
+		},
+		"kms_key_arn": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"last_modified": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"last_update_status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"last_update_status_reason": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"last_update_status_reason_code": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"layers": {
+			Type: schema.TypeList,
+			Elem: &schema.Resource{
+				Schema: dataSourceLayerSchema(),
+			},
+			Computed: true,
+		},
+		"master_arn": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"memory_size": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"package_type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"revision_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"role": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"runtime": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"signing_job_arn": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"signing_profile_version_arn": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"state": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"state_reason": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"state_reason_code": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"timeout": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"tracing_config": {
+			Type: schema.TypeList,
+			Elem: &schema.Resource{
+				Schema: dataSourceTracingConfigResponseSchema(),
+			},
+			Computed: true,
+		},
+		"version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"vpc_config": {
+			Type: schema.TypeList,
+			Elem: &schema.Resource{
+				Schema: dataSourceVpcConfigResponseSchema(),
+			},
+			Computed: true,
+		},
+	}
+}
+
+func flattenDataSourceFunctionConfiguration(apiObject *lambda.FunctionConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Architectures; v != nil {
+		tfMap["architectures"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.CodeSha256; v != nil {
+		tfMap["code_sha256"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.CodeSize; v != nil {
+		tfMap["code_size"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.DeadLetterConfig; v != nil {
+		tfMap["dead_letter_config"] = []interface{}{flattenDataSourceDeadLetterConfig(v)}
+	}
+
+	if v := apiObject.Description; v != nil {
+		tfMap["description"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Environment; v != nil {
+		tfMap["environment"] = []interface{}{flattenDataSourceEnvironmentResponse(v)}
+	}
+
+	if v := apiObject.EphemeralStorage; v != nil {
+		tfMap["ephemeral_storage"] = []interface{}{flattenDataSourceEphemeralStorage(v)}
+	}
+
+	if v := apiObject.FileSystemConfigs; v != nil {
+		var tfList []interface{}
+
+		for _, v := range v {
+			if v == nil {
+				continue
+			}
+
+			tfList = append(tfList, flattenDataSourceFileSystemConfig(v))
+		}
+
+		tfMap["file_system_configs"] = tfList
+	}
+
+	if v := apiObject.FunctionArn; v != nil {
+		tfMap["function_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.FunctionName; v != nil {
+		tfMap["function_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Handler; v != nil {
+		tfMap["handler"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ImageConfigResponse; v != nil {
+		tfMap["image_config_response"] = []interface{}{flattenDataSourceImageConfigResponse(v)}
+	}
+
+	if v := apiObject.KMSKeyArn; v != nil {
+		tfMap["kms_key_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.LastModified; v != nil {
+		tfMap["last_modified"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.LastUpdateStatus; v != nil {
+		tfMap["last_update_status"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.LastUpdateStatusReason; v != nil {
+		tfMap["last_update_status_reason"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.LastUpdateStatusReasonCode; v != nil {
+		tfMap["last_update_status_reason_code"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Layers; v != nil {
+		var tfList []interface{}
+
+		for _, v := range v {
+			if v == nil {
+				continue
+			}
+
+			tfList = append(tfList, flattenDataSourceLayer(v))
+		}
+
+		tfMap["layers"] = tfList
+	}
+
+	if v := apiObject.MasterArn; v != nil {
+		tfMap["master_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.MemorySize; v != nil {
+		tfMap["memory_size"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.PackageType; v != nil {
+		tfMap["package_type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.RevisionId; v != nil {
+		tfMap["revision_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Role; v != nil {
+		tfMap["role"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Runtime; v != nil {
+		tfMap["runtime"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.SigningJobArn; v != nil {
+		tfMap["signing_job_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.SigningProfileVersionArn; v != nil {
+		tfMap["signing_profile_version_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.State; v != nil {
+		tfMap["state"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.StateReason; v != nil {
+		tfMap["state_reason"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.StateReasonCode; v != nil {
+		tfMap["state_reason_code"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Timeout; v != nil {
+		tfMap["timeout"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.TracingConfig; v != nil {
+		tfMap["tracing_config"] = []interface{}{flattenDataSourceTracingConfigResponse(v)}
+	}
+
+	if v := apiObject.Version; v != nil {
+		tfMap["version"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.VpcConfig; v != nil {
+		tfMap["vpc_config"] = []interface{}{flattenDataSourceVpcConfigResponse(v)}
+	}
+
+	return tfMap
+}
+
+func dataSourceImageConfigSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"command": {
+			Type:     schema.TypeList,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Computed: true,
+		},
+		"entry_point": {
+			Type:     schema.TypeList,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Computed: true,
+		},
+		"working_directory": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func flattenDataSourceImageConfig(apiObject *lambda.ImageConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Command; v != nil {
+		tfMap["command"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.EntryPoint; v != nil {
+		tfMap["entry_point"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.WorkingDirectory; v != nil {
+		tfMap["working_directory"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func dataSourceImageConfigErrorSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"error_code": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"message": {
+			Type:      schema.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+func flattenDataSourceImageConfigError(apiObject *lambda.ImageConfigError) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ErrorCode; v != nil {
+		tfMap["error_code"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Message; v != nil {
+		tfMap["message"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func dataSourceImageConfigResponseSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"error": {
+			Type: schema.TypeList,
+			Elem: &schema.Resource{
+				Schema: dataSourceImageConfigErrorSchema(),
+			},
+			Computed: true,
+		},
+		"image_config": {
+			Type: schema.TypeList,
+			Elem: &schema.Resource{
+				Schema: dataSourceImageConfigSchema(),
+			},
+			Computed: true,
+		},
+	}
+}
+
+func flattenDataSourceImageConfigResponse(apiObject *lambda.ImageConfigResponse) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Error; v != nil {
+		tfMap["error"] = []interface{}{flattenDataSourceImageConfigError(v)}
+	}
+
+	if v := apiObject.ImageConfig; v != nil {
+		tfMap["image_config"] = []interface{}{flattenDataSourceImageConfig(v)}
+	}
+
+	return tfMap
+}
+
+func dataSourceLayerSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"arn": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"code_size": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"signing_job_arn": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"signing_profile_version_arn": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func flattenDataSourceLayer(apiObject *lambda.Layer) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Arn; v != nil {
+		tfMap["arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.CodeSize; v != nil {
+		tfMap["code_size"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.SigningJobArn; v != nil {
+		tfMap["signing_job_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.SigningProfileVersionArn; v != nil {
+		tfMap["signing_profile_version_arn"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func dataSourceTracingConfigResponseSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"mode": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func flattenDataSourceTracingConfigResponse(apiObject *lambda.TracingConfigResponse) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Mode; v != nil {
+		tfMap["mode"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func dataSourceVpcConfigResponseSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"security_group_ids": {
+			Type:     schema.TypeList,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Computed: true,
+		},
+		"subnet_ids": {
+			Type:     schema.TypeList,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Computed: true,
+		},
+		"vpc_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func flattenDataSourceVpcConfigResponse(apiObject *lambda.VpcConfigResponse) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.SecurityGroupIds; v != nil {
+		tfMap["security_group_ids"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.SubnetIds; v != nil {
+		tfMap["subnet_ids"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.VpcId; v != nil {
+		tfMap["vpc_id"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
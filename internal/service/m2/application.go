@@ -0,0 +1,282 @@
+package m2
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/m2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceApplication() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceApplicationCreate,
+		ReadWithoutTimeout:   resourceApplicationRead,
+		UpdateWithoutTimeout: resourceApplicationUpdate,
+		DeleteWithoutTimeout: resourceApplicationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"current_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"definition": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"content": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+							StateFunc: func(v interface{}) string {
+								json, _ := structure.NormalizeJsonString(v)
+								return json
+							},
+							ExactlyOneOf: []string{"definition.0.content", "definition.0.s3_location"},
+						},
+						"s3_location": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"definition.0.content", "definition.0.s3_location"},
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 500),
+			},
+			"engine_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(m2.EngineType_Values(), false),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 60),
+					validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_\-]*$`), "must start with an alphanumeric character and contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+const (
+	ResNameApplication = "Application"
+)
+
+func resourceApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).M2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &m2.CreateApplicationInput{
+		EngineType: aws.String(d.Get("engine_type").(string)),
+		Name:       aws.String(name),
+	}
+
+	if v, ok := d.GetOk("definition"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Definition = expandDefinition(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateApplicationWithContext(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.M2, create.ErrActionCreating, ResNameApplication, name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ApplicationId))
+
+	if _, err := waitApplicationCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.M2, create.ErrActionWaitingForCreation, ResNameApplication, d.Id(), err)
+	}
+
+	return resourceApplicationRead(ctx, d, meta)
+}
+
+func resourceApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).M2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	app, err := FindApplicationByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] M2 Application (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.M2, create.ErrActionReading, ResNameApplication, d.Id(), err)
+	}
+
+	d.Set("application_id", app.ApplicationId)
+	d.Set("arn", app.ApplicationArn)
+	d.Set("current_version", app.LatestVersion.ApplicationVersion)
+	d.Set("description", app.Description)
+	d.Set("engine_type", app.EngineType)
+	d.Set("name", app.Name)
+
+	// The S3 location of a definition is not returned by the API, so only inline content is refreshed.
+	if _, ok := d.GetOk("definition.0.s3_location"); !ok {
+		version, err := FindApplicationVersionByTwoPartKey(ctx, conn, d.Id(), aws.Int64Value(app.LatestVersion.ApplicationVersion))
+
+		if err != nil {
+			return create.DiagError(names.M2, create.ErrActionReading, ResNameApplication, d.Id(), err)
+		}
+
+		if err := d.Set("definition", []interface{}{map[string]interface{}{
+			"content": aws.StringValue(version.DefinitionContent),
+		}}); err != nil {
+			return create.DiagError(names.M2, create.ErrActionSetting, ResNameApplication, d.Id(), err)
+		}
+	}
+
+	tags := KeyValueTags(app.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.M2, create.ErrActionSetting, ResNameApplication, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.M2, create.ErrActionSetting, ResNameApplication, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).M2Conn
+
+	if d.HasChanges("definition", "description") {
+		input := &m2.UpdateApplicationInput{
+			ApplicationId:             aws.String(d.Id()),
+			CurrentApplicationVersion: aws.Int64(int64(d.Get("current_version").(int))),
+		}
+
+		if d.HasChange("definition") {
+			if v, ok := d.GetOk("definition"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.Definition = expandDefinition(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		output, err := conn.UpdateApplicationWithContext(ctx, input)
+
+		if err != nil {
+			return create.DiagError(names.M2, create.ErrActionUpdating, ResNameApplication, d.Id(), err)
+		}
+
+		if _, err := waitApplicationVersionAvailable(ctx, conn, d.Id(), aws.Int64Value(output.ApplicationVersion), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return create.DiagError(names.M2, create.ErrActionWaitingForUpdate, ResNameApplication, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return create.DiagError(names.M2, create.ErrActionUpdating, ResNameApplication, d.Id(), err)
+		}
+	}
+
+	return resourceApplicationRead(ctx, d, meta)
+}
+
+func resourceApplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).M2Conn
+
+	log.Printf("[INFO] Deleting M2 Application: %s", d.Id())
+	_, err := conn.DeleteApplicationWithContext(ctx, &m2.DeleteApplicationInput{
+		ApplicationId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, m2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.M2, create.ErrActionDeleting, ResNameApplication, d.Id(), err)
+	}
+
+	if _, err := waitApplicationDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.DiagError(names.M2, create.ErrActionWaitingForDeletion, ResNameApplication, d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandDefinition(tfMap map[string]interface{}) *m2.Definition {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &m2.Definition{}
+
+	if v, ok := tfMap["content"].(string); ok && v != "" {
+		apiObject.Content = aws.String(v)
+	}
+
+	if v, ok := tfMap["s3_location"].(string); ok && v != "" {
+		apiObject.S3Location = aws.String(v)
+	}
+
+	return apiObject
+}
@@ -0,0 +1,297 @@
+package m2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/m2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceDeployment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDeploymentCreate,
+		ReadWithoutTimeout:   resourceDeploymentRead,
+		UpdateWithoutTimeout: resourceDeploymentUpdate,
+		DeleteWithoutTimeout: resourceDeploymentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"application_version": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"deployment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"environment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"force_stop": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"start": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+const (
+	ResNameDeployment = "Deployment"
+)
+
+func resourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).M2Conn
+
+	applicationID := d.Get("application_id").(string)
+	deploymentID, err := createDeployment(ctx, conn, applicationID, d.Get("environment_id").(string), int64(d.Get("application_version").(int)), d.Timeout(schema.TimeoutCreate))
+
+	if deploymentID != "" {
+		d.SetId(DeploymentCreateResourceID(applicationID, deploymentID))
+	}
+
+	if err != nil {
+		return create.DiagError(names.M2, create.ErrActionCreating, ResNameDeployment, d.Id(), err)
+	}
+
+	if d.Get("start").(bool) {
+		if err := startApplication(ctx, conn, applicationID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return create.DiagError(names.M2, create.ErrActionCreating, ResNameDeployment, d.Id(), err)
+		}
+	}
+
+	return resourceDeploymentRead(ctx, d, meta)
+}
+
+func resourceDeploymentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).M2Conn
+
+	applicationID, deploymentID, err := DeploymentParseResourceID(d.Id())
+
+	if err != nil {
+		return create.DiagError(names.M2, create.ErrActionReading, ResNameDeployment, d.Id(), err)
+	}
+
+	deployment, err := FindDeploymentByTwoPartKey(ctx, conn, applicationID, deploymentID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] M2 Deployment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.M2, create.ErrActionReading, ResNameDeployment, d.Id(), err)
+	}
+
+	app, err := FindApplicationByID(ctx, conn, applicationID)
+
+	if err != nil {
+		return create.DiagError(names.M2, create.ErrActionReading, ResNameDeployment, d.Id(), err)
+	}
+
+	d.Set("application_id", deployment.ApplicationId)
+	d.Set("application_version", deployment.ApplicationVersion)
+	d.Set("deployment_id", deployment.DeploymentId)
+	d.Set("environment_id", deployment.EnvironmentId)
+	d.Set("start", aws.StringValue(app.Status) == m2.ApplicationLifecycleRunning)
+
+	return nil
+}
+
+func resourceDeploymentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).M2Conn
+
+	applicationID := d.Get("application_id").(string)
+
+	if d.HasChange("application_version") {
+		// A running application must be stopped before a new version can be deployed.
+		if err := stopApplicationIfRunning(ctx, conn, applicationID, d.Get("force_stop").(bool), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return create.DiagError(names.M2, create.ErrActionUpdating, ResNameDeployment, d.Id(), err)
+		}
+
+		deploymentID, err := createDeployment(ctx, conn, applicationID, d.Get("environment_id").(string), int64(d.Get("application_version").(int)), d.Timeout(schema.TimeoutUpdate))
+
+		if deploymentID != "" {
+			d.SetId(DeploymentCreateResourceID(applicationID, deploymentID))
+		}
+
+		if err != nil {
+			return create.DiagError(names.M2, create.ErrActionUpdating, ResNameDeployment, d.Id(), err)
+		}
+	}
+
+	if d.Get("start").(bool) {
+		app, err := FindApplicationByID(ctx, conn, applicationID)
+
+		if err != nil {
+			return create.DiagError(names.M2, create.ErrActionUpdating, ResNameDeployment, d.Id(), err)
+		}
+
+		if aws.StringValue(app.Status) != m2.ApplicationLifecycleRunning {
+			if err := startApplication(ctx, conn, applicationID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return create.DiagError(names.M2, create.ErrActionUpdating, ResNameDeployment, d.Id(), err)
+			}
+		}
+	} else if d.HasChange("start") {
+		if err := stopApplicationIfRunning(ctx, conn, applicationID, d.Get("force_stop").(bool), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return create.DiagError(names.M2, create.ErrActionUpdating, ResNameDeployment, d.Id(), err)
+		}
+	}
+
+	return resourceDeploymentRead(ctx, d, meta)
+}
+
+func resourceDeploymentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).M2Conn
+
+	applicationID := d.Get("application_id").(string)
+
+	if err := stopApplicationIfRunning(ctx, conn, applicationID, d.Get("force_stop").(bool), d.Timeout(schema.TimeoutDelete)); err != nil {
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		return create.DiagError(names.M2, create.ErrActionDeleting, ResNameDeployment, d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting M2 Deployment: %s", d.Id())
+	_, err := conn.DeleteApplicationFromEnvironmentWithContext(ctx, &m2.DeleteApplicationFromEnvironmentInput{
+		ApplicationId: aws.String(applicationID),
+		EnvironmentId: aws.String(d.Get("environment_id").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, m2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.M2, create.ErrActionDeleting, ResNameDeployment, d.Id(), err)
+	}
+
+	if _, err := waitApplicationUndeployed(ctx, conn, applicationID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.DiagError(names.M2, create.ErrActionWaitingForDeletion, ResNameDeployment, d.Id(), err)
+	}
+
+	return nil
+}
+
+// createDeployment deploys the given application version to the environment and waits for the deployment to succeed.
+// The deployment ID is returned whenever the deployment was started, even if waiting for it failed.
+func createDeployment(ctx context.Context, conn *m2.M2, applicationID, environmentID string, applicationVersion int64, timeout time.Duration) (string, error) {
+	input := &m2.CreateDeploymentInput{
+		ApplicationId:      aws.String(applicationID),
+		ApplicationVersion: aws.Int64(applicationVersion),
+		EnvironmentId:      aws.String(environmentID),
+	}
+
+	output, err := conn.CreateDeploymentWithContext(ctx, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	deploymentID := aws.StringValue(output.DeploymentId)
+
+	if _, err := waitDeploymentSucceeded(ctx, conn, applicationID, deploymentID, timeout); err != nil {
+		return deploymentID, fmt.Errorf("waiting for deployment (%s): %w", deploymentID, err)
+	}
+
+	return deploymentID, nil
+}
+
+func startApplication(ctx context.Context, conn *m2.M2, applicationID string, timeout time.Duration) error {
+	_, err := conn.StartApplicationWithContext(ctx, &m2.StartApplicationInput{
+		ApplicationId: aws.String(applicationID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("starting application (%s): %w", applicationID, err)
+	}
+
+	if _, err := waitApplicationStarted(ctx, conn, applicationID, timeout); err != nil {
+		return fmt.Errorf("waiting for application (%s) start: %w", applicationID, err)
+	}
+
+	return nil
+}
+
+func stopApplicationIfRunning(ctx context.Context, conn *m2.M2, applicationID string, forceStop bool, timeout time.Duration) error {
+	app, err := FindApplicationByID(ctx, conn, applicationID)
+
+	if err != nil {
+		return err
+	}
+
+	if aws.StringValue(app.Status) != m2.ApplicationLifecycleRunning {
+		return nil
+	}
+
+	_, err = conn.StopApplicationWithContext(ctx, &m2.StopApplicationInput{
+		ApplicationId: aws.String(applicationID),
+		ForceStop:     aws.Bool(forceStop),
+	})
+
+	if err != nil {
+		return fmt.Errorf("stopping application (%s): %w", applicationID, err)
+	}
+
+	if _, err := waitApplicationStopped(ctx, conn, applicationID, timeout); err != nil {
+		return fmt.Errorf("waiting for application (%s) stop: %w", applicationID, err)
+	}
+
+	return nil
+}
+
+const deploymentIDSeparator = ","
+
+func DeploymentCreateResourceID(applicationID, deploymentID string) string {
+	parts := []string{applicationID, deploymentID}
+	id := strings.Join(parts, deploymentIDSeparator)
+
+	return id
+}
+
+func DeploymentParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, deploymentIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected APPLICATION-ID%[2]sDEPLOYMENT-ID", id, deploymentIDSeparator)
+}
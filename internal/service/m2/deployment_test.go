@@ -0,0 +1,156 @@
+package m2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/m2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfm2 "github.com/hashicorp/terraform-provider-aws/internal/service/m2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccM2Deployment_basic(t *testing.T) {
+	var v m2.GetDeploymentOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_m2_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, m2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_basic(rName, 1, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "application_id", "aws_m2_application.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "application_version", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "deployment_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "environment_id", "aws_m2_environment.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "start", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_stop"},
+			},
+			{
+				Config: testAccDeploymentConfig_basic(rName, 1, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "start", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccM2Deployment_disappears(t *testing.T) {
+	var v m2.GetDeploymentOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_m2_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, m2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_basic(rName, 1, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfm2.ResourceDeployment(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDeploymentExists(n string, v *m2.GetDeploymentOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No M2 Deployment ID is set")
+		}
+
+		applicationID, deploymentID, err := tfm2.DeploymentParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).M2Conn
+
+		output, err := tfm2.FindDeploymentByTwoPartKey(context.Background(), conn, applicationID, deploymentID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckDeploymentDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).M2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_m2_deployment" {
+			continue
+		}
+
+		output, err := tfm2.FindApplicationByID(context.Background(), conn, rs.Primary.Attributes["application_id"])
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output.EnvironmentId == nil {
+			continue
+		}
+
+		return fmt.Errorf("M2 Deployment %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccDeploymentConfig_basic(rName string, applicationVersion int, start bool) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig_base(rName), testAccApplicationConfig_basic(rName, "v1"), fmt.Sprintf(`
+resource "aws_m2_environment" "test" {
+  name               = %[1]q
+  engine_type        = "bluage"
+  instance_type      = "M2.m5.large"
+  security_group_ids = [aws_security_group.test.id]
+  subnet_ids         = aws_subnet.test[*].id
+}
+
+resource "aws_m2_deployment" "test" {
+  environment_id      = aws_m2_environment.test.id
+  application_id      = aws_m2_application.test.id
+  application_version = %[2]d
+  start               = %[3]t
+}
+`, rName, applicationVersion, start))
+}
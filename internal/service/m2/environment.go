@@ -0,0 +1,483 @@
+package m2
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/m2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceEnvironment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEnvironmentCreate,
+		ReadWithoutTimeout:   resourceEnvironmentRead,
+		UpdateWithoutTimeout: resourceEnvironmentUpdate,
+		DeleteWithoutTimeout: resourceEnvironmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"apply_changes_during_maintenance_window": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 500),
+			},
+			"engine_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(m2.EngineType_Values(), false),
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"environment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"high_availability_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"desired_capacity": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 100),
+						},
+					},
+				},
+			},
+			"instance_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"load_balancer_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 60),
+					validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_\-]*$`), "must start with an alphanumeric character and contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"preferred_maintenance_window": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"publicly_accessible": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"storage_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"efs": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem:     fileSystemStorageConfigurationResource(),
+						},
+						"fsx": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem:     fileSystemStorageConfigurationResource(),
+						},
+					},
+				},
+			},
+			"subnet_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func fileSystemStorageConfigurationResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"file_system_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"mount_point": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+const (
+	ResNameEnvironment = "Environment"
+)
+
+func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).M2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &m2.CreateEnvironmentInput{
+		EngineType:   aws.String(d.Get("engine_type").(string)),
+		InstanceType: aws.String(d.Get("instance_type").(string)),
+		Name:         aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("engine_version"); ok {
+		input.EngineVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("high_availability_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.HighAvailabilityConfig = expandHighAvailabilityConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("preferred_maintenance_window"); ok {
+		input.PreferredMaintenanceWindow = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOkExists("publicly_accessible"); ok {
+		input.PubliclyAccessible = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.SecurityGroupIds = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("storage_configuration"); ok && len(v.([]interface{})) > 0 {
+		input.StorageConfigurations = expandStorageConfigurations(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("subnet_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.SubnetIds = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateEnvironmentWithContext(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.M2, create.ErrActionCreating, ResNameEnvironment, name, err)
+	}
+
+	d.SetId(aws.StringValue(output.EnvironmentId))
+
+	if _, err := waitEnvironmentCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.M2, create.ErrActionWaitingForCreation, ResNameEnvironment, d.Id(), err)
+	}
+
+	return resourceEnvironmentRead(ctx, d, meta)
+}
+
+func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).M2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	env, err := FindEnvironmentByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] M2 Environment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.M2, create.ErrActionReading, ResNameEnvironment, d.Id(), err)
+	}
+
+	d.Set("arn", env.EnvironmentArn)
+	d.Set("description", env.Description)
+	d.Set("engine_type", env.EngineType)
+	d.Set("engine_version", env.EngineVersion)
+	d.Set("environment_id", env.EnvironmentId)
+	if env.HighAvailabilityConfig != nil {
+		if err := d.Set("high_availability_config", []interface{}{flattenHighAvailabilityConfig(env.HighAvailabilityConfig)}); err != nil {
+			return create.DiagError(names.M2, create.ErrActionSetting, ResNameEnvironment, d.Id(), err)
+		}
+	} else {
+		d.Set("high_availability_config", nil)
+	}
+	d.Set("instance_type", env.InstanceType)
+	d.Set("load_balancer_arn", env.LoadBalancerArn)
+	d.Set("name", env.Name)
+	d.Set("preferred_maintenance_window", env.PreferredMaintenanceWindow)
+	d.Set("publicly_accessible", env.PubliclyAccessible)
+	d.Set("security_group_ids", aws.StringValueSlice(env.SecurityGroupIds))
+	if err := d.Set("storage_configuration", flattenStorageConfigurations(env.StorageConfigurations)); err != nil {
+		return create.DiagError(names.M2, create.ErrActionSetting, ResNameEnvironment, d.Id(), err)
+	}
+	d.Set("subnet_ids", aws.StringValueSlice(env.SubnetIds))
+
+	tags := KeyValueTags(env.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.M2, create.ErrActionSetting, ResNameEnvironment, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.M2, create.ErrActionSetting, ResNameEnvironment, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).M2Conn
+
+	if d.HasChanges("engine_version", "high_availability_config", "instance_type", "preferred_maintenance_window") {
+		input := &m2.UpdateEnvironmentInput{
+			EnvironmentId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("engine_version") {
+			input.EngineVersion = aws.String(d.Get("engine_version").(string))
+			// Engine upgrades may optionally be deferred to the maintenance window.
+			input.ApplyDuringMaintenanceWindow = aws.Bool(d.Get("apply_changes_during_maintenance_window").(bool))
+		}
+
+		if d.HasChange("high_availability_config") {
+			if v, ok := d.GetOk("high_availability_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.DesiredCapacity = expandHighAvailabilityConfig(v.([]interface{})[0].(map[string]interface{})).DesiredCapacity
+			}
+		}
+
+		if d.HasChange("instance_type") {
+			input.InstanceType = aws.String(d.Get("instance_type").(string))
+		}
+
+		if d.HasChange("preferred_maintenance_window") {
+			input.PreferredMaintenanceWindow = aws.String(d.Get("preferred_maintenance_window").(string))
+		}
+
+		_, err := conn.UpdateEnvironmentWithContext(ctx, input)
+
+		if err != nil {
+			return create.DiagError(names.M2, create.ErrActionUpdating, ResNameEnvironment, d.Id(), err)
+		}
+
+		if _, err := waitEnvironmentUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return create.DiagError(names.M2, create.ErrActionWaitingForUpdate, ResNameEnvironment, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return create.DiagError(names.M2, create.ErrActionUpdating, ResNameEnvironment, d.Id(), err)
+		}
+	}
+
+	return resourceEnvironmentRead(ctx, d, meta)
+}
+
+func resourceEnvironmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).M2Conn
+
+	log.Printf("[INFO] Deleting M2 Environment: %s", d.Id())
+	_, err := conn.DeleteEnvironmentWithContext(ctx, &m2.DeleteEnvironmentInput{
+		EnvironmentId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, m2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.M2, create.ErrActionDeleting, ResNameEnvironment, d.Id(), err)
+	}
+
+	if _, err := waitEnvironmentDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.DiagError(names.M2, create.ErrActionWaitingForDeletion, ResNameEnvironment, d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandHighAvailabilityConfig(tfMap map[string]interface{}) *m2.HighAvailabilityConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &m2.HighAvailabilityConfig{}
+
+	if v, ok := tfMap["desired_capacity"].(int); ok && v != 0 {
+		apiObject.DesiredCapacity = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandFileSystemStorageConfiguration(tfMap map[string]interface{}) (*string, *string) {
+	var fileSystemID, mountPoint *string
+
+	if v, ok := tfMap["file_system_id"].(string); ok && v != "" {
+		fileSystemID = aws.String(v)
+	}
+
+	if v, ok := tfMap["mount_point"].(string); ok && v != "" {
+		mountPoint = aws.String(v)
+	}
+
+	return fileSystemID, mountPoint
+}
+
+func expandStorageConfigurations(tfList []interface{}) []*m2.StorageConfiguration {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*m2.StorageConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &m2.StorageConfiguration{}
+
+		if v, ok := tfMap["efs"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			fileSystemID, mountPoint := expandFileSystemStorageConfiguration(v[0].(map[string]interface{}))
+			apiObject.Efs = &m2.EfsStorageConfiguration{
+				FileSystemId: fileSystemID,
+				MountPoint:   mountPoint,
+			}
+		}
+
+		if v, ok := tfMap["fsx"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			fileSystemID, mountPoint := expandFileSystemStorageConfiguration(v[0].(map[string]interface{}))
+			apiObject.Fsx = &m2.FsxStorageConfiguration{
+				FileSystemId: fileSystemID,
+				MountPoint:   mountPoint,
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenHighAvailabilityConfig(apiObject *m2.HighAvailabilityConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DesiredCapacity; v != nil {
+		tfMap["desired_capacity"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
+
+func flattenStorageConfigurations(apiObjects []*m2.StorageConfiguration) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Efs; v != nil {
+			tfMap["efs"] = []interface{}{map[string]interface{}{
+				"file_system_id": aws.StringValue(v.FileSystemId),
+				"mount_point":    aws.StringValue(v.MountPoint),
+			}}
+		}
+
+		if v := apiObject.Fsx; v != nil {
+			tfMap["fsx"] = []interface{}{map[string]interface{}{
+				"file_system_id": aws.StringValue(v.FileSystemId),
+				"mount_point":    aws.StringValue(v.MountPoint),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
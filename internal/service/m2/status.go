@@ -0,0 +1,92 @@
+package m2
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/m2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusEnvironment(ctx context.Context, conn *m2.M2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindEnvironmentByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusApplication(ctx context.Context, conn *m2.M2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindApplicationByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+// statusApplicationDeployed reports whether the application is currently deployed to an environment.
+func statusApplicationDeployed(ctx context.Context, conn *m2.M2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindApplicationByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, strconv.FormatBool(output.EnvironmentId != nil), nil
+	}
+}
+
+func statusApplicationVersion(ctx context.Context, conn *m2.M2, applicationID string, version int64) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindApplicationVersionByTwoPartKey(ctx, conn, applicationID, version)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusDeployment(ctx context.Context, conn *m2.M2, applicationID, deploymentID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDeploymentByTwoPartKey(ctx, conn, applicationID, deploymentID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
@@ -0,0 +1,175 @@
+//go:build sweep
+// +build sweep
+
+package m2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/m2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_m2_application", &resource.Sweeper{
+		Name: "aws_m2_application",
+		F:    sweepApplications,
+		Dependencies: []string{
+			"aws_m2_deployment",
+		},
+	})
+
+	resource.AddTestSweepers("aws_m2_deployment", &resource.Sweeper{
+		Name: "aws_m2_deployment",
+		F:    sweepDeployments,
+	})
+
+	resource.AddTestSweepers("aws_m2_environment", &resource.Sweeper{
+		Name: "aws_m2_environment",
+		F:    sweepEnvironments,
+		Dependencies: []string{
+			"aws_m2_application",
+		},
+	})
+}
+
+func sweepApplications(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).M2Conn
+	input := &m2.ListApplicationsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListApplicationsPages(input, func(page *m2.ListApplicationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Applications {
+			r := ResourceApplication()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.ApplicationId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping M2 Application sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing M2 Applications (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping M2 Applications (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepDeployments(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).M2Conn
+	input := &m2.ListApplicationsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListApplicationsPages(input, func(page *m2.ListApplicationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Applications {
+			// Only applications deployed to an environment have a deployment to remove.
+			if v.EnvironmentId == nil {
+				continue
+			}
+
+			r := ResourceDeployment()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.ApplicationId))
+			d.Set("application_id", v.ApplicationId)
+			d.Set("environment_id", v.EnvironmentId)
+			d.Set("force_stop", true)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping M2 Deployment sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing M2 Applications (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping M2 Deployments (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepEnvironments(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).M2Conn
+	input := &m2.ListEnvironmentsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListEnvironmentsPages(input, func(page *m2.ListEnvironmentsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Environments {
+			r := ResourceEnvironment()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.EnvironmentId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping M2 Environment sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing M2 Environments (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping M2 Environments (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,203 @@
+package m2
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/m2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitEnvironmentCreated(ctx context.Context, conn *m2.M2, id string, timeout time.Duration) (*m2.GetEnvironmentOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{m2.EnvironmentLifecycleCreating},
+		Target:  []string{m2.EnvironmentLifecycleAvailable},
+		Refresh: statusEnvironment(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*m2.GetEnvironmentOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEnvironmentUpdated(ctx context.Context, conn *m2.M2, id string, timeout time.Duration) (*m2.GetEnvironmentOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{m2.EnvironmentLifecycleCreating},
+		Target:     []string{m2.EnvironmentLifecycleAvailable},
+		Refresh:    statusEnvironment(ctx, conn, id),
+		Timeout:    timeout,
+		Delay:      30 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*m2.GetEnvironmentOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEnvironmentDeleted(ctx context.Context, conn *m2.M2, id string, timeout time.Duration) (*m2.GetEnvironmentOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{m2.EnvironmentLifecycleAvailable, m2.EnvironmentLifecycleCreating, m2.EnvironmentLifecycleDeleting},
+		Target:  []string{},
+		Refresh: statusEnvironment(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*m2.GetEnvironmentOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitApplicationCreated(ctx context.Context, conn *m2.M2, id string, timeout time.Duration) (*m2.GetApplicationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{m2.ApplicationLifecycleCreating},
+		Target:  []string{m2.ApplicationLifecycleCreated, m2.ApplicationLifecycleAvailable},
+		Refresh: statusApplication(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*m2.GetApplicationOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitApplicationDeleted(ctx context.Context, conn *m2.M2, id string, timeout time.Duration) (*m2.GetApplicationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{m2.ApplicationLifecycleDeleting},
+		Target:  []string{},
+		Refresh: statusApplication(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*m2.GetApplicationOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitApplicationStarted(ctx context.Context, conn *m2.M2, id string, timeout time.Duration) (*m2.GetApplicationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{m2.ApplicationLifecycleStarting},
+		Target:  []string{m2.ApplicationLifecycleRunning},
+		Refresh: statusApplication(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*m2.GetApplicationOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitApplicationStopped(ctx context.Context, conn *m2.M2, id string, timeout time.Duration) (*m2.GetApplicationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{m2.ApplicationLifecycleStopping},
+		Target:  []string{m2.ApplicationLifecycleStopped},
+		Refresh: statusApplication(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*m2.GetApplicationOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitApplicationUndeployed(ctx context.Context, conn *m2.M2, id string, timeout time.Duration) (*m2.GetApplicationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{strconv.FormatBool(true)},
+		Target:  []string{strconv.FormatBool(false)},
+		Refresh: statusApplicationDeployed(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*m2.GetApplicationOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitApplicationVersionAvailable(ctx context.Context, conn *m2.M2, applicationID string, version int64, timeout time.Duration) (*m2.GetApplicationVersionOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{m2.ApplicationVersionLifecycleCreating},
+		Target:  []string{m2.ApplicationVersionLifecycleAvailable},
+		Refresh: statusApplicationVersion(ctx, conn, applicationID, version),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*m2.GetApplicationVersionOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDeploymentSucceeded(ctx context.Context, conn *m2.M2, applicationID, deploymentID string, timeout time.Duration) (*m2.GetDeploymentOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{m2.DeploymentLifecycleDeploying},
+		Target:  []string{m2.DeploymentLifecycleSucceeded},
+		Refresh: statusDeployment(ctx, conn, applicationID, deploymentID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*m2.GetDeploymentOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,383 @@
+package mediaconvert
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceJobTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceJobTemplateCreate,
+		ReadWithoutTimeout:   resourceJobTemplateRead,
+		UpdateWithoutTimeout: resourceJobTemplateUpdate,
+		DeleteWithoutTimeout: resourceJobTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"acceleration_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(mediaconvert.AccelerationMode_Values(), false),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"category": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"hop_destination": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"priority": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(-50, 50),
+						},
+						"queue": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: suppressQueueNameOrARNDiffs,
+						},
+						"wait_minutes": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(-50, 50),
+			},
+			"queue": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressQueueNameOrARNDiffs,
+			},
+			"settings": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"status_update_interval": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(mediaconvert.StatusUpdateInterval_Values(), false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceJobTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := GetAccountClient(meta.(*conns.AWSClient))
+	if err != nil {
+		return diag.Errorf("getting Media Convert Account Client: %s", err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	settings, err := expandJobTemplateSettings(d.Get("settings").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+	input := &mediaconvert.CreateJobTemplateInput{
+		Name:     aws.String(name),
+		Priority: aws.Int64(int64(d.Get("priority").(int))),
+		Settings: settings,
+		Tags:     Tags(tags.IgnoreAWS()),
+	}
+
+	if v, ok := d.GetOk("acceleration_mode"); ok {
+		input.AccelerationSettings = &mediaconvert.AccelerationSettings{
+			Mode: aws.String(v.(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("category"); ok {
+		input.Category = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("hop_destination"); ok && len(v.([]interface{})) > 0 {
+		input.HopDestinations = expandHopDestinations(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("queue"); ok {
+		input.Queue = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("status_update_interval"); ok {
+		input.StatusUpdateInterval = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateJobTemplateWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Media Convert Job Template (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.JobTemplate.Name))
+
+	return resourceJobTemplateRead(ctx, d, meta)
+}
+
+func resourceJobTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := GetAccountClient(meta.(*conns.AWSClient))
+	if err != nil {
+		return diag.Errorf("getting Media Convert Account Client: %s", err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	jobTemplate, err := FindJobTemplateByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Media Convert Job Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Media Convert Job Template (%s): %s", d.Id(), err)
+	}
+
+	settings, err := flattenSettings(jobTemplate.Settings, d.Get("settings").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if jobTemplate.AccelerationSettings != nil {
+		d.Set("acceleration_mode", jobTemplate.AccelerationSettings.Mode)
+	} else {
+		d.Set("acceleration_mode", nil)
+	}
+	d.Set("arn", jobTemplate.Arn)
+	d.Set("category", jobTemplate.Category)
+	d.Set("description", jobTemplate.Description)
+	if err := d.Set("hop_destination", flattenHopDestinations(jobTemplate.HopDestinations)); err != nil {
+		return diag.Errorf("setting hop_destination: %s", err)
+	}
+	d.Set("name", jobTemplate.Name)
+	d.Set("priority", jobTemplate.Priority)
+	d.Set("queue", jobTemplate.Queue)
+	d.Set("settings", settings)
+	d.Set("status_update_interval", jobTemplate.StatusUpdateInterval)
+	d.Set("type", jobTemplate.Type)
+
+	tags, err := ListTagsWithContext(ctx, conn, aws.StringValue(jobTemplate.Arn))
+
+	if err != nil {
+		return diag.Errorf("listing tags for Media Convert Job Template (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceJobTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := GetAccountClient(meta.(*conns.AWSClient))
+	if err != nil {
+		return diag.Errorf("getting Media Convert Account Client: %s", err)
+	}
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		settings, err := expandJobTemplateSettings(d.Get("settings").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input := &mediaconvert.UpdateJobTemplateInput{
+			Category:        aws.String(d.Get("category").(string)),
+			Description:     aws.String(d.Get("description").(string)),
+			HopDestinations: expandHopDestinations(d.Get("hop_destination").([]interface{})),
+			Name:            aws.String(d.Id()),
+			Priority:        aws.Int64(int64(d.Get("priority").(int))),
+			Settings:        settings,
+		}
+
+		if v, ok := d.GetOk("acceleration_mode"); ok {
+			input.AccelerationSettings = &mediaconvert.AccelerationSettings{
+				Mode: aws.String(v.(string)),
+			}
+		}
+
+		if v, ok := d.GetOk("queue"); ok {
+			input.Queue = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("status_update_interval"); ok {
+			input.StatusUpdateInterval = aws.String(v.(string))
+		}
+
+		_, err = conn.UpdateJobTemplateWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Media Convert Job Template (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating Media Convert Job Template (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceJobTemplateRead(ctx, d, meta)
+}
+
+func resourceJobTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := GetAccountClient(meta.(*conns.AWSClient))
+	if err != nil {
+		return diag.Errorf("getting Media Convert Account Client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Deleting Media Convert Job Template: %s", d.Id())
+	_, err = conn.DeleteJobTemplateWithContext(ctx, &mediaconvert.DeleteJobTemplateInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, mediaconvert.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Media Convert Job Template (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// suppressQueueNameOrARNDiffs suppresses differences between a queue name and the equivalent queue ARN.
+// The API accepts either but always returns the ARN.
+func suppressQueueNameOrARNDiffs(k, old, new string, d *schema.ResourceData) bool {
+	if old == new {
+		return true
+	}
+
+	return strings.HasSuffix(old, ":queues/"+new) || strings.HasSuffix(new, ":queues/"+old)
+}
+
+func expandJobTemplateSettings(s string) (*mediaconvert.JobTemplateSettings, error) {
+	apiObject := &mediaconvert.JobTemplateSettings{}
+
+	if err := jsonutil.UnmarshalJSON(apiObject, strings.NewReader(s)); err != nil {
+		return nil, err
+	}
+
+	return apiObject, nil
+}
+
+func expandHopDestinations(tfList []interface{}) []*mediaconvert.HopDestination {
+	apiObjects := []*mediaconvert.HopDestination{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &mediaconvert.HopDestination{}
+
+		if v, ok := tfMap["priority"].(int); ok {
+			apiObject.Priority = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["queue"].(string); ok && v != "" {
+			apiObject.Queue = aws.String(v)
+		}
+
+		if v, ok := tfMap["wait_minutes"].(int); ok && v != 0 {
+			apiObject.WaitMinutes = aws.Int64(int64(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenHopDestinations(apiObjects []*mediaconvert.HopDestination) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"priority":     aws.Int64Value(apiObject.Priority),
+			"queue":        aws.StringValue(apiObject.Queue),
+			"wait_minutes": aws.Int64Value(apiObject.WaitMinutes),
+		})
+	}
+
+	return tfList
+}
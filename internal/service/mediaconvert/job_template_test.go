@@ -0,0 +1,245 @@
+package mediaconvert_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediaconvert "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccMediaConvertJobTemplate_basic(t *testing.T) {
+	var jobTemplate mediaconvert.JobTemplate
+	resourceName := "aws_media_convert_job_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediaconvert.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobTemplateConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobTemplateExists(resourceName, &jobTemplate),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "mediaconvert", regexp.MustCompile(`jobTemplates/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "hop_destination.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "priority", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "queue", "aws_media_convert_queue.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "type", mediaconvert.TypeCustom),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"settings"},
+			},
+		},
+	})
+}
+
+func TestAccMediaConvertJobTemplate_hopDestination(t *testing.T) {
+	var jobTemplate mediaconvert.JobTemplate
+	resourceName := "aws_media_convert_job_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediaconvert.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobTemplateConfig_hopDestination(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobTemplateExists(resourceName, &jobTemplate),
+					resource.TestCheckResourceAttr(resourceName, "hop_destination.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "hop_destination.0.queue", "aws_media_convert_queue.hop", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "hop_destination.0.wait_minutes", "10"),
+				),
+			},
+			{
+				Config: testAccJobTemplateConfig_hopDestination(rName, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobTemplateExists(resourceName, &jobTemplate),
+					resource.TestCheckResourceAttr(resourceName, "hop_destination.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "hop_destination.0.wait_minutes", "20"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConvertJobTemplate_disappears(t *testing.T) {
+	var jobTemplate mediaconvert.JobTemplate
+	resourceName := "aws_media_convert_job_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediaconvert.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobTemplateConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobTemplateExists(resourceName, &jobTemplate),
+					acctest.CheckResourceDisappears(acctest.Provider, tfmediaconvert.ResourceJobTemplate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckJobTemplateDestroy(s *terraform.State) error {
+	conn, err := tfmediaconvert.GetAccountClient(acctest.Provider.Meta().(*conns.AWSClient))
+	if err != nil {
+		return fmt.Errorf("Error getting Media Convert Account Client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_media_convert_job_template" {
+			continue
+		}
+
+		_, err := tfmediaconvert.FindJobTemplateByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Media Convert Job Template %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckJobTemplateExists(n string, v *mediaconvert.JobTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Media Convert Job Template ID is set")
+		}
+
+		conn, err := tfmediaconvert.GetAccountClient(acctest.Provider.Meta().(*conns.AWSClient))
+		if err != nil {
+			return fmt.Errorf("Error getting Media Convert Account Client: %s", err)
+		}
+
+		output, err := tfmediaconvert.FindJobTemplateByName(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccJobTemplateConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_queue" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccJobTemplateConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccJobTemplateConfig_base(rName), fmt.Sprintf(`
+resource "aws_media_convert_job_template" "test" {
+  name  = %[1]q
+  queue = aws_media_convert_queue.test.arn
+
+  settings = jsonencode({
+    OutputGroups = [{
+      OutputGroupSettings = {
+        Type = "FILE_GROUP_SETTINGS"
+        FileGroupSettings = {
+          Destination = "s3://%[1]s/output/"
+        }
+      }
+      Outputs = [{
+        ContainerSettings = {
+          Container = "MP4"
+        }
+        VideoDescription = {
+          CodecSettings = {
+            Codec = "H_264"
+            H264Settings = {
+              Bitrate         = 5000000
+              RateControlMode = "CBR"
+            }
+          }
+        }
+      }]
+    }]
+  })
+}
+`, rName))
+}
+
+func testAccJobTemplateConfig_hopDestination(rName string, waitMinutes int) string {
+	return acctest.ConfigCompose(testAccJobTemplateConfig_base(rName), fmt.Sprintf(`
+resource "aws_media_convert_queue" "hop" {
+  name = "%[1]s-hop"
+}
+
+resource "aws_media_convert_job_template" "test" {
+  name  = %[1]q
+  queue = aws_media_convert_queue.test.arn
+
+  hop_destination {
+    queue        = aws_media_convert_queue.hop.arn
+    wait_minutes = %[2]d
+  }
+
+  settings = jsonencode({
+    OutputGroups = [{
+      OutputGroupSettings = {
+        Type = "FILE_GROUP_SETTINGS"
+        FileGroupSettings = {
+          Destination = "s3://%[1]s/output/"
+        }
+      }
+      Outputs = [{
+        ContainerSettings = {
+          Container = "MP4"
+        }
+        VideoDescription = {
+          CodecSettings = {
+            Codec = "H_264"
+            H264Settings = {
+              Bitrate         = 5000000
+              RateControlMode = "CBR"
+            }
+          }
+        }
+      }]
+    }]
+  })
+}
+`, rName, waitMinutes))
+}
@@ -0,0 +1,119 @@
+package mediaconvert
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourcePolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePolicyPut,
+		ReadWithoutTimeout:   resourcePolicyRead,
+		UpdateWithoutTimeout: resourcePolicyPut,
+		DeleteWithoutTimeout: resourcePolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"http_inputs": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      mediaconvert.InputPolicyAllowed,
+				ValidateFunc: validation.StringInSlice(mediaconvert.InputPolicy_Values(), false),
+			},
+			"https_inputs": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      mediaconvert.InputPolicyAllowed,
+				ValidateFunc: validation.StringInSlice(mediaconvert.InputPolicy_Values(), false),
+			},
+			"s3_inputs": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      mediaconvert.InputPolicyAllowed,
+				ValidateFunc: validation.StringInSlice(mediaconvert.InputPolicy_Values(), false),
+			},
+		},
+	}
+}
+
+func resourcePolicyPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := GetAccountClient(meta.(*conns.AWSClient))
+	if err != nil {
+		return diag.Errorf("getting Media Convert Account Client: %s", err)
+	}
+
+	input := &mediaconvert.PutPolicyInput{
+		Policy: &mediaconvert.Policy{
+			HttpInputs:  aws.String(d.Get("http_inputs").(string)),
+			HttpsInputs: aws.String(d.Get("https_inputs").(string)),
+			S3Inputs:    aws.String(d.Get("s3_inputs").(string)),
+		},
+	}
+
+	_, err = conn.PutPolicyWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("putting Media Convert Policy: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	return resourcePolicyRead(ctx, d, meta)
+}
+
+func resourcePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := GetAccountClient(meta.(*conns.AWSClient))
+	if err != nil {
+		return diag.Errorf("getting Media Convert Account Client: %s", err)
+	}
+
+	policy, err := FindPolicy(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Media Convert Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Media Convert Policy (%s): %s", d.Id(), err)
+	}
+
+	d.Set("http_inputs", policy.HttpInputs)
+	d.Set("https_inputs", policy.HttpsInputs)
+	d.Set("s3_inputs", policy.S3Inputs)
+
+	return nil
+}
+
+func resourcePolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := GetAccountClient(meta.(*conns.AWSClient))
+	if err != nil {
+		return diag.Errorf("getting Media Convert Account Client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Deleting Media Convert Policy: %s", d.Id())
+	_, err = conn.DeletePolicyWithContext(ctx, &mediaconvert.DeletePolicyInput{})
+
+	if tfawserr.ErrCodeEquals(err, mediaconvert.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Media Convert Policy (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
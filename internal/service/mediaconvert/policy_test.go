@@ -0,0 +1,112 @@
+package mediaconvert_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediaconvert "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// The Media Convert policy is a per-Region singleton, so these tests must not run in parallel.
+func TestAccMediaConvertPolicy_basic(t *testing.T) {
+	resourceName := "aws_media_convert_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediaconvert.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyConfig_basic(mediaconvert.InputPolicyDisallowed),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "http_inputs", mediaconvert.InputPolicyDisallowed),
+					resource.TestCheckResourceAttr(resourceName, "https_inputs", mediaconvert.InputPolicyAllowed),
+					resource.TestCheckResourceAttr(resourceName, "s3_inputs", mediaconvert.InputPolicyAllowed),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPolicyConfig_basic(mediaconvert.InputPolicyAllowed),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "http_inputs", mediaconvert.InputPolicyAllowed),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPolicyDestroy(s *terraform.State) error {
+	conn, err := tfmediaconvert.GetAccountClient(acctest.Provider.Meta().(*conns.AWSClient))
+	if err != nil {
+		return fmt.Errorf("Error getting Media Convert Account Client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_media_convert_policy" {
+			continue
+		}
+
+		policy, err := tfmediaconvert.FindPolicy(context.Background(), conn)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		// Deleting the policy resets all inputs to ALLOWED.
+		for _, v := range []*string{policy.HttpInputs, policy.HttpsInputs, policy.S3Inputs} {
+			if v != nil && *v != mediaconvert.InputPolicyAllowed {
+				return fmt.Errorf("Media Convert Policy %s still exists", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckPolicyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Media Convert Policy ID is set")
+		}
+
+		conn, err := tfmediaconvert.GetAccountClient(acctest.Provider.Meta().(*conns.AWSClient))
+		if err != nil {
+			return fmt.Errorf("Error getting Media Convert Account Client: %s", err)
+		}
+
+		_, err = tfmediaconvert.FindPolicy(context.Background(), conn)
+
+		return err
+	}
+}
+
+func testAccPolicyConfig_basic(httpInputs string) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_policy" "test" {
+  http_inputs = %[1]q
+}
+`, httpInputs)
+}
@@ -0,0 +1,483 @@
+package mediaconvert
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourcePreset() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePresetCreate,
+		ReadWithoutTimeout:   resourcePresetRead,
+		UpdateWithoutTimeout: resourcePresetUpdate,
+		DeleteWithoutTimeout: resourcePresetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"category": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"audio_description": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				AtLeastOneOf: []string{"audio_description", "settings", "video_description"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aac_settings": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bitrate": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntAtLeast(6000),
+									},
+									"codec_profile": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(mediaconvert.AacCodecProfile_Values(), false),
+									},
+									"coding_mode": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(mediaconvert.AacCodingMode_Values(), false),
+									},
+									"rate_control_mode": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(mediaconvert.AacRateControlMode_Values(), false),
+									},
+									"sample_rate": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntAtLeast(8000),
+									},
+								},
+							},
+						},
+						"audio_source_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"settings": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				AtLeastOneOf:     []string{"audio_description", "settings", "video_description"},
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"video_description": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				AtLeastOneOf: []string{"audio_description", "settings", "video_description"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"h264_settings": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"video_description.0.h264_settings", "video_description.0.h265_settings"},
+							Elem: &schema.Resource{
+								Schema: videoCodecSettingsSchema(
+									mediaconvert.H264CodecLevel_Values(),
+									mediaconvert.H264CodecProfile_Values(),
+									mediaconvert.H264FramerateControl_Values(),
+									mediaconvert.H264QualityTuningLevel_Values(),
+									mediaconvert.H264RateControlMode_Values(),
+								),
+							},
+						},
+						"h265_settings": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"video_description.0.h264_settings", "video_description.0.h265_settings"},
+							Elem: &schema.Resource{
+								Schema: videoCodecSettingsSchema(
+									mediaconvert.H265CodecLevel_Values(),
+									mediaconvert.H265CodecProfile_Values(),
+									mediaconvert.H265FramerateControl_Values(),
+									mediaconvert.H265QualityTuningLevel_Values(),
+									mediaconvert.H265RateControlMode_Values(),
+								),
+							},
+						},
+						"height": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(32),
+						},
+						"width": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(32),
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			resourcePresetCustomizeDiff,
+			verify.SetTagsDiff,
+		),
+	}
+}
+
+// videoCodecSettingsSchema returns the schema shared by the H.264 and H.265 codec settings blocks.
+func videoCodecSettingsSchema(codecLevels, codecProfiles, framerateControls, qualityTuningLevels, rateControlModes []string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"bitrate": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(1000),
+		},
+		"codec_level": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(codecLevels, false),
+		},
+		"codec_profile": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(codecProfiles, false),
+		},
+		"framerate_control": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(framerateControls, false),
+		},
+		"framerate_denominator": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"framerate_numerator": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"gop_size": {
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.FloatAtLeast(0),
+		},
+		"max_bitrate": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(1000),
+		},
+		"quality_tuning_level": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(qualityTuningLevels, false),
+		},
+		"qvbr_quality_level": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(1, 10),
+		},
+		"rate_control_mode": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(rateControlModes, false),
+		},
+	}
+}
+
+func resourcePresetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := GetAccountClient(meta.(*conns.AWSClient))
+	if err != nil {
+		return diag.Errorf("getting Media Convert Account Client: %s", err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	settings, err := expandPreset(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+	input := &mediaconvert.CreatePresetInput{
+		Name:     aws.String(name),
+		Settings: settings,
+		Tags:     Tags(tags.IgnoreAWS()),
+	}
+
+	if v, ok := d.GetOk("category"); ok {
+		input.Category = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreatePresetWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Media Convert Preset (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Preset.Name))
+
+	return resourcePresetRead(ctx, d, meta)
+}
+
+func resourcePresetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := GetAccountClient(meta.(*conns.AWSClient))
+	if err != nil {
+		return diag.Errorf("getting Media Convert Account Client: %s", err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	preset, err := FindPresetByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Media Convert Preset (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Media Convert Preset (%s): %s", d.Id(), err)
+	}
+
+	settings, err := flattenSettings(preset.Settings, d.Get("settings").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("arn", preset.Arn)
+	d.Set("category", preset.Category)
+	d.Set("description", preset.Description)
+	d.Set("name", preset.Name)
+	d.Set("settings", settings)
+	d.Set("type", preset.Type)
+
+	// The typed blocks are only populated when configured, as the same values are also present in settings.
+	if _, ok := d.GetOk("audio_description"); ok {
+		if err := d.Set("audio_description", flattenPresetAudioDescriptions(preset.Settings.AudioDescriptions)); err != nil {
+			return diag.Errorf("setting audio_description: %s", err)
+		}
+	}
+
+	if _, ok := d.GetOk("video_description"); ok {
+		if err := d.Set("video_description", flattenPresetVideoDescription(preset.Settings.VideoDescription)); err != nil {
+			return diag.Errorf("setting video_description: %s", err)
+		}
+	}
+
+	tags, err := ListTagsWithContext(ctx, conn, aws.StringValue(preset.Arn))
+
+	if err != nil {
+		return diag.Errorf("listing tags for Media Convert Preset (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourcePresetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := GetAccountClient(meta.(*conns.AWSClient))
+	if err != nil {
+		return diag.Errorf("getting Media Convert Account Client: %s", err)
+	}
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		settings, err := expandPreset(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input := &mediaconvert.UpdatePresetInput{
+			Category:    aws.String(d.Get("category").(string)),
+			Description: aws.String(d.Get("description").(string)),
+			Name:        aws.String(d.Id()),
+			Settings:    settings,
+		}
+
+		_, err = conn.UpdatePresetWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Media Convert Preset (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating Media Convert Preset (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourcePresetRead(ctx, d, meta)
+}
+
+func resourcePresetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := GetAccountClient(meta.(*conns.AWSClient))
+	if err != nil {
+		return diag.Errorf("getting Media Convert Account Client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Deleting Media Convert Preset: %s", d.Id())
+	_, err = conn.DeletePresetWithContext(ctx, &mediaconvert.DeletePresetInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, mediaconvert.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Media Convert Preset (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandPresetSettings(s string) (*mediaconvert.PresetSettings, error) {
+	apiObject := &mediaconvert.PresetSettings{}
+
+	if err := jsonutil.UnmarshalJSON(apiObject, strings.NewReader(s)); err != nil {
+		return nil, err
+	}
+
+	return apiObject, nil
+}
+
+// expandPreset returns the preset settings from the settings JSON,
+// with the audio and video descriptions replaced by any typed blocks.
+func expandPreset(d *schema.ResourceData) (*mediaconvert.PresetSettings, error) {
+	apiObject := &mediaconvert.PresetSettings{}
+
+	if v, ok := d.GetOk("settings"); ok {
+		var err error
+
+		apiObject, err = expandPresetSettings(v.(string))
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if v, ok := d.GetOk("audio_description"); ok && len(v.([]interface{})) > 0 {
+		apiObject.AudioDescriptions = expandPresetAudioDescriptions(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("video_description"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.VideoDescription = expandPresetVideoDescription(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	return apiObject, nil
+}
+
+func resourcePresetCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	settings := diff.GetRawConfig().GetAttr("settings")
+
+	if settings.IsNull() {
+		// settings holds the full remote settings, which change with the typed blocks.
+		if diff.Id() != "" && diff.HasChanges("audio_description", "video_description") {
+			return diff.SetNewComputed("settings")
+		}
+
+		return nil
+	}
+
+	if !settings.IsKnown() {
+		return nil
+	}
+
+	if v, ok := diff.GetOk("audio_description"); ok && len(v.([]interface{})) > 0 && settingsJSONHasKey(settings.AsString(), "audioDescriptions") {
+		return fmt.Errorf("audio_description cannot be set when settings contains AudioDescriptions")
+	}
+
+	if v, ok := diff.GetOk("video_description"); ok && len(v.([]interface{})) > 0 && settingsJSONHasKey(settings.AsString(), "videoDescription") {
+		return fmt.Errorf("video_description cannot be set when settings contains VideoDescription")
+	}
+
+	return nil
+}
@@ -0,0 +1,263 @@
+package mediaconvert_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediaconvert "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccMediaConvertPreset_basic(t *testing.T) {
+	var preset mediaconvert.Preset
+	resourceName := "aws_media_convert_preset.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediaconvert.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPresetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPresetConfig_basic(rName, 5000000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPresetExists(resourceName, &preset),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "mediaconvert", regexp.MustCompile(`presets/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "type", mediaconvert.TypeCustom),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"settings"},
+			},
+			{
+				Config: testAccPresetConfig_basic(rName, 6000000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPresetExists(resourceName, &preset),
+					resource.TestMatchResourceAttr(resourceName, "settings", regexp.MustCompile(`6000000`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConvertPreset_codecSettings(t *testing.T) {
+	var preset mediaconvert.Preset
+	resourceName := "aws_media_convert_preset.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediaconvert.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPresetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPresetConfig_h264(rName, 5000000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPresetExists(resourceName, &preset),
+					resource.TestCheckResourceAttr(resourceName, "audio_description.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "audio_description.0.aac_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "audio_description.0.aac_settings.0.bitrate", "96000"),
+					resource.TestCheckResourceAttr(resourceName, "audio_description.0.aac_settings.0.coding_mode", mediaconvert.AacCodingModeCodingMode20),
+					resource.TestCheckResourceAttr(resourceName, "audio_description.0.aac_settings.0.sample_rate", "48000"),
+					resource.TestCheckResourceAttr(resourceName, "video_description.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "video_description.0.h264_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "video_description.0.h264_settings.0.bitrate", "5000000"),
+					resource.TestCheckResourceAttr(resourceName, "video_description.0.h264_settings.0.rate_control_mode", mediaconvert.H264RateControlModeCbr),
+					resource.TestCheckResourceAttr(resourceName, "video_description.0.h265_settings.#", "0"),
+					resource.TestMatchResourceAttr(resourceName, "settings", regexp.MustCompile(`MP4`)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"audio_description", "settings", "video_description"},
+			},
+			{
+				Config: testAccPresetConfig_h265(rName, 3000000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPresetExists(resourceName, &preset),
+					resource.TestCheckResourceAttr(resourceName, "audio_description.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "video_description.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "video_description.0.h264_settings.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "video_description.0.h265_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "video_description.0.h265_settings.0.max_bitrate", "3000000"),
+					resource.TestCheckResourceAttr(resourceName, "video_description.0.h265_settings.0.qvbr_quality_level", "7"),
+					resource.TestCheckResourceAttr(resourceName, "video_description.0.h265_settings.0.rate_control_mode", mediaconvert.H265RateControlModeQvbr),
+					resource.TestCheckResourceAttr(resourceName, "video_description.0.height", "720"),
+					resource.TestCheckResourceAttr(resourceName, "video_description.0.width", "1280"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConvertPreset_disappears(t *testing.T) {
+	var preset mediaconvert.Preset
+	resourceName := "aws_media_convert_preset.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediaconvert.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPresetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPresetConfig_basic(rName, 5000000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPresetExists(resourceName, &preset),
+					acctest.CheckResourceDisappears(acctest.Provider, tfmediaconvert.ResourcePreset(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPresetDestroy(s *terraform.State) error {
+	conn, err := tfmediaconvert.GetAccountClient(acctest.Provider.Meta().(*conns.AWSClient))
+	if err != nil {
+		return fmt.Errorf("Error getting Media Convert Account Client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_media_convert_preset" {
+			continue
+		}
+
+		_, err := tfmediaconvert.FindPresetByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Media Convert Preset %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckPresetExists(n string, v *mediaconvert.Preset) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Media Convert Preset ID is set")
+		}
+
+		conn, err := tfmediaconvert.GetAccountClient(acctest.Provider.Meta().(*conns.AWSClient))
+		if err != nil {
+			return fmt.Errorf("Error getting Media Convert Account Client: %s", err)
+		}
+
+		output, err := tfmediaconvert.FindPresetByName(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPresetConfig_basic(rName string, bitrate int) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_preset" "test" {
+  name = %[1]q
+
+  settings = jsonencode({
+    ContainerSettings = {
+      Container = "MP4"
+    }
+    VideoDescription = {
+      CodecSettings = {
+        Codec = "H_264"
+        H264Settings = {
+          Bitrate         = %[2]d
+          RateControlMode = "CBR"
+        }
+      }
+    }
+  })
+}
+`, rName, bitrate)
+}
+
+func testAccPresetConfig_h264(rName string, bitrate int) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_preset" "test" {
+  name = %[1]q
+
+  settings = jsonencode({
+    ContainerSettings = {
+      Container = "MP4"
+    }
+  })
+
+  audio_description {
+    aac_settings {
+      bitrate     = 96000
+      coding_mode = "CODING_MODE_2_0"
+      sample_rate = 48000
+    }
+  }
+
+  video_description {
+    h264_settings {
+      bitrate           = %[2]d
+      rate_control_mode = "CBR"
+    }
+  }
+}
+`, rName, bitrate)
+}
+
+func testAccPresetConfig_h265(rName string, maxBitrate int) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_preset" "test" {
+  name = %[1]q
+
+  settings = jsonencode({
+    ContainerSettings = {
+      Container = "MP4"
+    }
+  })
+
+  video_description {
+    height = 720
+    width  = 1280
+
+    h265_settings {
+      max_bitrate        = %[2]d
+      qvbr_quality_level = 7
+      rate_control_mode  = "QVBR"
+    }
+  }
+}
+`, rName, maxBitrate)
+}
@@ -1,6 +1,7 @@
 package mediaconvert
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/mediaconvert"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -92,7 +94,17 @@ func ResourceQueue() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			// Reserved transcoding slots can be added to a commitment but not removed.
+			customdiff.ValidateChange("reservation_plan_settings.0.reserved_slots", func(_ context.Context, old, new, meta interface{}) error {
+				if o, n := old.(int), new.(int); o > 0 && n < o {
+					return fmt.Errorf("reservation_plan_settings.0.reserved_slots cannot be decreased from %d to %d", o, n)
+				}
+
+				return nil
+			}),
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -1,8 +1,14 @@
 package mediaconvert
 
 import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
 	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 )
 
 func expandReservationPlanSettings(config map[string]interface{}) *mediaconvert.ReservationPlanSettings {
@@ -36,3 +42,389 @@ func flattenReservationPlan(reservationPlan *mediaconvert.ReservationPlan) []int
 
 	return []interface{}{m}
 }
+
+// settingsJSONContains reports whether every value set in the configured settings JSON is also set,
+// with the same value, in the settings JSON returned by the API.
+// MediaConvert fills in defaults for any settings that are not specified.
+func settingsJSONContains(configured, remote string) bool {
+	var c, r interface{}
+
+	if err := json.Unmarshal([]byte(configured), &c); err != nil {
+		return false
+	}
+
+	if err := json.Unmarshal([]byte(remote), &r); err != nil {
+		return false
+	}
+
+	return jsonValueContains(c, r)
+}
+
+func jsonValueContains(configured, remote interface{}) bool {
+	switch c := configured.(type) {
+	case map[string]interface{}:
+		r, ok := remote.(map[string]interface{})
+		if !ok {
+			return false
+		}
+
+		for k, v := range c {
+			if !jsonValueContains(v, jsonObjectValue(r, k)) {
+				return false
+			}
+		}
+
+		return true
+	case []interface{}:
+		r, ok := remote.([]interface{})
+		if !ok || len(c) != len(r) {
+			return false
+		}
+
+		for i, v := range c {
+			if !jsonValueContains(v, r[i]) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return reflect.DeepEqual(configured, remote)
+	}
+}
+
+// jsonObjectValue returns the value for key in a JSON object.
+// Keys are matched case-insensitively if there is no exact match, as the API accepts
+// PascalCase member names in settings but returns camelCase ones.
+func jsonObjectValue(m map[string]interface{}, key string) interface{} {
+	if v, ok := m[key]; ok {
+		return v
+	}
+
+	for k, v := range m {
+		if strings.EqualFold(k, key) {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// settingsJSONHasKey reports whether the top-level settings JSON object contains key.
+func settingsJSONHasKey(s, key string) bool {
+	var m map[string]interface{}
+
+	if err := json.Unmarshal([]byte(s), &m); err != nil {
+		return false
+	}
+
+	return jsonObjectValue(m, key) != nil
+}
+
+// flattenSettings returns the settings JSON for the API object.
+// The configured JSON is returned if the API object contains every configured value.
+func flattenSettings(apiObject interface{}, configured string) (string, error) {
+	b, err := jsonutil.BuildJSON(apiObject)
+
+	if err != nil {
+		return "", err
+	}
+
+	remote := string(b)
+
+	if configured != "" && settingsJSONContains(configured, remote) {
+		return configured, nil
+	}
+
+	return structure.NormalizeJsonString(remote)
+}
+
+func expandPresetVideoDescription(tfMap map[string]interface{}) *mediaconvert.VideoDescription {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediaconvert.VideoDescription{}
+
+	if v, ok := tfMap["height"].(int); ok && v != 0 {
+		apiObject.Height = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["width"].(int); ok && v != 0 {
+		apiObject.Width = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["h264_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.CodecSettings = &mediaconvert.VideoCodecSettings{
+			Codec:        aws.String(mediaconvert.VideoCodecH264),
+			H264Settings: expandH264Settings(v[0].(map[string]interface{})),
+		}
+	}
+
+	if v, ok := tfMap["h265_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.CodecSettings = &mediaconvert.VideoCodecSettings{
+			Codec:        aws.String(mediaconvert.VideoCodecH265),
+			H265Settings: expandH265Settings(v[0].(map[string]interface{})),
+		}
+	}
+
+	return apiObject
+}
+
+func expandH264Settings(tfMap map[string]interface{}) *mediaconvert.H264Settings {
+	apiObject := &mediaconvert.H264Settings{}
+
+	if v, ok := tfMap["bitrate"].(int); ok && v != 0 {
+		apiObject.Bitrate = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["codec_level"].(string); ok && v != "" {
+		apiObject.CodecLevel = aws.String(v)
+	}
+
+	if v, ok := tfMap["codec_profile"].(string); ok && v != "" {
+		apiObject.CodecProfile = aws.String(v)
+	}
+
+	if v, ok := tfMap["framerate_control"].(string); ok && v != "" {
+		apiObject.FramerateControl = aws.String(v)
+	}
+
+	if v, ok := tfMap["framerate_denominator"].(int); ok && v != 0 {
+		apiObject.FramerateDenominator = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["framerate_numerator"].(int); ok && v != 0 {
+		apiObject.FramerateNumerator = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["gop_size"].(float64); ok && v != 0 {
+		apiObject.GopSize = aws.Float64(v)
+	}
+
+	if v, ok := tfMap["max_bitrate"].(int); ok && v != 0 {
+		apiObject.MaxBitrate = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["quality_tuning_level"].(string); ok && v != "" {
+		apiObject.QualityTuningLevel = aws.String(v)
+	}
+
+	if v, ok := tfMap["qvbr_quality_level"].(int); ok && v != 0 {
+		apiObject.QvbrSettings = &mediaconvert.H264QvbrSettings{
+			QvbrQualityLevel: aws.Int64(int64(v)),
+		}
+	}
+
+	if v, ok := tfMap["rate_control_mode"].(string); ok && v != "" {
+		apiObject.RateControlMode = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandH265Settings(tfMap map[string]interface{}) *mediaconvert.H265Settings {
+	apiObject := &mediaconvert.H265Settings{}
+
+	if v, ok := tfMap["bitrate"].(int); ok && v != 0 {
+		apiObject.Bitrate = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["codec_level"].(string); ok && v != "" {
+		apiObject.CodecLevel = aws.String(v)
+	}
+
+	if v, ok := tfMap["codec_profile"].(string); ok && v != "" {
+		apiObject.CodecProfile = aws.String(v)
+	}
+
+	if v, ok := tfMap["framerate_control"].(string); ok && v != "" {
+		apiObject.FramerateControl = aws.String(v)
+	}
+
+	if v, ok := tfMap["framerate_denominator"].(int); ok && v != 0 {
+		apiObject.FramerateDenominator = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["framerate_numerator"].(int); ok && v != 0 {
+		apiObject.FramerateNumerator = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["gop_size"].(float64); ok && v != 0 {
+		apiObject.GopSize = aws.Float64(v)
+	}
+
+	if v, ok := tfMap["max_bitrate"].(int); ok && v != 0 {
+		apiObject.MaxBitrate = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["quality_tuning_level"].(string); ok && v != "" {
+		apiObject.QualityTuningLevel = aws.String(v)
+	}
+
+	if v, ok := tfMap["qvbr_quality_level"].(int); ok && v != 0 {
+		apiObject.QvbrSettings = &mediaconvert.H265QvbrSettings{
+			QvbrQualityLevel: aws.Int64(int64(v)),
+		}
+	}
+
+	if v, ok := tfMap["rate_control_mode"].(string); ok && v != "" {
+		apiObject.RateControlMode = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandPresetAudioDescriptions(tfList []interface{}) []*mediaconvert.AudioDescription {
+	var apiObjects []*mediaconvert.AudioDescription
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &mediaconvert.AudioDescription{}
+
+		if v, ok := tfMap["audio_source_name"].(string); ok && v != "" {
+			apiObject.AudioSourceName = aws.String(v)
+		}
+
+		if v, ok := tfMap["aac_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.CodecSettings = &mediaconvert.AudioCodecSettings{
+				AacSettings: expandAACSettings(v[0].(map[string]interface{})),
+				Codec:       aws.String(mediaconvert.AudioCodecAac),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandAACSettings(tfMap map[string]interface{}) *mediaconvert.AacSettings {
+	apiObject := &mediaconvert.AacSettings{}
+
+	if v, ok := tfMap["bitrate"].(int); ok && v != 0 {
+		apiObject.Bitrate = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["codec_profile"].(string); ok && v != "" {
+		apiObject.CodecProfile = aws.String(v)
+	}
+
+	if v, ok := tfMap["coding_mode"].(string); ok && v != "" {
+		apiObject.CodingMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["rate_control_mode"].(string); ok && v != "" {
+		apiObject.RateControlMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["sample_rate"].(int); ok && v != 0 {
+		apiObject.SampleRate = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenPresetVideoDescription(apiObject *mediaconvert.VideoDescription) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"height": aws.Int64Value(apiObject.Height),
+		"width":  aws.Int64Value(apiObject.Width),
+	}
+
+	if v := apiObject.CodecSettings; v != nil {
+		if v.H264Settings != nil {
+			tfMap["h264_settings"] = flattenH264Settings(v.H264Settings)
+		}
+
+		if v.H265Settings != nil {
+			tfMap["h265_settings"] = flattenH265Settings(v.H265Settings)
+		}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenH264Settings(apiObject *mediaconvert.H264Settings) []interface{} {
+	tfMap := map[string]interface{}{
+		"bitrate":               aws.Int64Value(apiObject.Bitrate),
+		"codec_level":           aws.StringValue(apiObject.CodecLevel),
+		"codec_profile":         aws.StringValue(apiObject.CodecProfile),
+		"framerate_control":     aws.StringValue(apiObject.FramerateControl),
+		"framerate_denominator": aws.Int64Value(apiObject.FramerateDenominator),
+		"framerate_numerator":   aws.Int64Value(apiObject.FramerateNumerator),
+		"gop_size":              aws.Float64Value(apiObject.GopSize),
+		"max_bitrate":           aws.Int64Value(apiObject.MaxBitrate),
+		"quality_tuning_level":  aws.StringValue(apiObject.QualityTuningLevel),
+		"rate_control_mode":     aws.StringValue(apiObject.RateControlMode),
+	}
+
+	if v := apiObject.QvbrSettings; v != nil {
+		tfMap["qvbr_quality_level"] = aws.Int64Value(v.QvbrQualityLevel)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenH265Settings(apiObject *mediaconvert.H265Settings) []interface{} {
+	tfMap := map[string]interface{}{
+		"bitrate":               aws.Int64Value(apiObject.Bitrate),
+		"codec_level":           aws.StringValue(apiObject.CodecLevel),
+		"codec_profile":         aws.StringValue(apiObject.CodecProfile),
+		"framerate_control":     aws.StringValue(apiObject.FramerateControl),
+		"framerate_denominator": aws.Int64Value(apiObject.FramerateDenominator),
+		"framerate_numerator":   aws.Int64Value(apiObject.FramerateNumerator),
+		"gop_size":              aws.Float64Value(apiObject.GopSize),
+		"max_bitrate":           aws.Int64Value(apiObject.MaxBitrate),
+		"quality_tuning_level":  aws.StringValue(apiObject.QualityTuningLevel),
+		"rate_control_mode":     aws.StringValue(apiObject.RateControlMode),
+	}
+
+	if v := apiObject.QvbrSettings; v != nil {
+		tfMap["qvbr_quality_level"] = aws.Int64Value(v.QvbrQualityLevel)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenPresetAudioDescriptions(apiObjects []*mediaconvert.AudioDescription) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"audio_source_name": aws.StringValue(apiObject.AudioSourceName),
+		}
+
+		if v := apiObject.CodecSettings; v != nil && v.AacSettings != nil {
+			tfMap["aac_settings"] = flattenAACSettings(v.AacSettings)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenAACSettings(apiObject *mediaconvert.AacSettings) []interface{} {
+	tfMap := map[string]interface{}{
+		"bitrate":           aws.Int64Value(apiObject.Bitrate),
+		"codec_profile":     aws.StringValue(apiObject.CodecProfile),
+		"coding_mode":       aws.StringValue(apiObject.CodingMode),
+		"rate_control_mode": aws.StringValue(apiObject.RateControlMode),
+		"sample_rate":       aws.Int64Value(apiObject.SampleRate),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,547 @@
+package medialive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/medialive"
+	"github.com/aws/aws-sdk-go-v2/service/medialive/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceChannelScheduleAction() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceChannelScheduleActionCreate,
+		ReadWithoutTimeout:   resourceChannelScheduleActionRead,
+		DeleteWithoutTimeout: resourceChannelScheduleActionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		// Schedule actions cannot be modified, only created and deleted.
+		Schema: map[string]*schema.Schema{
+			"channel_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"settings": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"input_switch_settings": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: scheduleActionSettingsKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"input_attachment_name_reference": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"url_path": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"pause_state_settings": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: scheduleActionSettingsKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"pipelines": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"pipeline_id": {
+													Type:             schema.TypeString,
+													Required:         true,
+													ForceNew:         true,
+													ValidateDiagFunc: enum.Validate[types.PipelineId](),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"scte35_return_to_network_settings": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: scheduleActionSettingsKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"splice_event_id": {
+										Type:     schema.TypeInt,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"scte35_splice_insert_settings": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: scheduleActionSettingsKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"duration": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+									"splice_event_id": {
+										Type:     schema.TypeInt,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"start_settings": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"fixed_mode_start_settings": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: scheduleActionStartSettingsKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"time": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ForceNew:         true,
+										ValidateFunc:     verify.ValidUTCTimestamp,
+										DiffSuppressFunc: verify.SuppressEquivalentRoundedTime(time.RFC3339, time.Second),
+									},
+								},
+							},
+						},
+						"follow_mode_start_settings": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: scheduleActionStartSettingsKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"follow_point": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.FollowPoint](),
+									},
+									"reference_action_name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"immediate_mode_start_settings": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: scheduleActionStartSettingsKeys,
+							Elem: &schema.Resource{
+								// No options currently; just existence of "immediate_mode_start_settings".
+								Schema: map[string]*schema.Schema{},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+var (
+	scheduleActionSettingsKeys = []string{
+		"settings.0.input_switch_settings",
+		"settings.0.pause_state_settings",
+		"settings.0.scte35_return_to_network_settings",
+		"settings.0.scte35_splice_insert_settings",
+	}
+	scheduleActionStartSettingsKeys = []string{
+		"start_settings.0.fixed_mode_start_settings",
+		"start_settings.0.follow_mode_start_settings",
+		"start_settings.0.immediate_mode_start_settings",
+	}
+)
+
+const (
+	ResNameChannelScheduleAction = "Channel Schedule Action"
+)
+
+func resourceChannelScheduleActionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaLiveClient
+
+	channelID := d.Get("channel_id").(string)
+	name := d.Get("name").(string)
+	id := ChannelScheduleActionCreateResourceID(channelID, name)
+
+	in := &medialive.BatchUpdateScheduleInput{
+		ChannelId: aws.String(channelID),
+		Creates: &types.BatchScheduleActionCreateRequest{
+			ScheduleActions: []types.ScheduleAction{
+				{
+					ActionName:                  aws.String(name),
+					ScheduleActionSettings:      expandScheduleActionSettings(d.Get("settings").([]interface{})),
+					ScheduleActionStartSettings: expandScheduleActionStartSettings(d.Get("start_settings").([]interface{})),
+				},
+			},
+		},
+	}
+
+	_, err := conn.BatchUpdateSchedule(ctx, in)
+
+	if err != nil {
+		return create.DiagError(names.MediaLive, create.ErrActionCreating, ResNameChannelScheduleAction, id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceChannelScheduleActionRead(ctx, d, meta)
+}
+
+func resourceChannelScheduleActionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaLiveClient
+
+	channelID, name, err := ChannelScheduleActionParseResourceID(d.Id())
+
+	if err != nil {
+		return create.DiagError(names.MediaLive, create.ErrActionReading, ResNameChannelScheduleAction, d.Id(), err)
+	}
+
+	action, err := FindChannelScheduleActionByTwoPartKey(ctx, conn, channelID, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaLive Channel Schedule Action (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.MediaLive, create.ErrActionReading, ResNameChannelScheduleAction, d.Id(), err)
+	}
+
+	d.Set("channel_id", channelID)
+	d.Set("name", action.ActionName)
+	if err := d.Set("settings", flattenScheduleActionSettings(action.ScheduleActionSettings)); err != nil {
+		return create.DiagError(names.MediaLive, create.ErrActionSetting, ResNameChannelScheduleAction, d.Id(), err)
+	}
+	if err := d.Set("start_settings", flattenScheduleActionStartSettings(action.ScheduleActionStartSettings)); err != nil {
+		return create.DiagError(names.MediaLive, create.ErrActionSetting, ResNameChannelScheduleAction, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceChannelScheduleActionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaLiveClient
+
+	channelID, name, err := ChannelScheduleActionParseResourceID(d.Id())
+
+	if err != nil {
+		return create.DiagError(names.MediaLive, create.ErrActionDeleting, ResNameChannelScheduleAction, d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting MediaLive Channel Schedule Action %s", d.Id())
+
+	_, err = conn.BatchUpdateSchedule(ctx, &medialive.BatchUpdateScheduleInput{
+		ChannelId: aws.String(channelID),
+		Deletes: &types.BatchScheduleActionDeleteRequest{
+			ActionNames: []string{name},
+		},
+	})
+
+	if err != nil {
+		var nfe *types.NotFoundException
+		if errors.As(err, &nfe) {
+			return nil
+		}
+
+		// Actions that have already been executed are removed from the schedule by MediaLive.
+		var bre *types.BadRequestException
+		if errors.As(err, &bre) && strings.Contains(bre.ErrorMessage(), "not found") {
+			return nil
+		}
+
+		return create.DiagError(names.MediaLive, create.ErrActionDeleting, ResNameChannelScheduleAction, d.Id(), err)
+	}
+
+	return nil
+}
+
+const channelScheduleActionResourceIDSeparator = "/"
+
+func ChannelScheduleActionCreateResourceID(channelID, name string) string {
+	return strings.Join([]string{channelID, name}, channelScheduleActionResourceIDSeparator)
+}
+
+func ChannelScheduleActionParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, channelScheduleActionResourceIDSeparator, 2)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected CHANNEL-ID%[2]sACTION-NAME", id, channelScheduleActionResourceIDSeparator)
+}
+
+func FindChannelScheduleActionByTwoPartKey(ctx context.Context, conn *medialive.Client, channelID, name string) (*types.ScheduleAction, error) {
+	in := &medialive.DescribeScheduleInput{
+		ChannelId: aws.String(channelID),
+	}
+
+	pages := medialive.NewDescribeSchedulePaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			var nfe *types.NotFoundException
+			if errors.As(err, &nfe) {
+				return nil, &resource.NotFoundError{
+					LastError:   err,
+					LastRequest: in,
+				}
+			}
+
+			return nil, err
+		}
+
+		for _, v := range page.ScheduleActions {
+			if aws.ToString(v.ActionName) == name {
+				v := v
+
+				return &v, nil
+			}
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(in)
+}
+
+func expandScheduleActionSettings(tfList []interface{}) *types.ScheduleActionSettings {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	m := tfList[0].(map[string]interface{})
+	apiObject := &types.ScheduleActionSettings{}
+
+	if v, ok := m["input_switch_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		settings := &types.InputSwitchScheduleActionSettings{
+			InputAttachmentNameReference: aws.String(tfMap["input_attachment_name_reference"].(string)),
+		}
+
+		if v, ok := tfMap["url_path"].([]interface{}); ok && len(v) > 0 {
+			settings.UrlPath = flex.ExpandStringValueList(v)
+		}
+
+		apiObject.InputSwitchSettings = settings
+	}
+
+	if v, ok := m["pause_state_settings"].([]interface{}); ok && len(v) > 0 {
+		settings := &types.PauseStateScheduleActionSettings{}
+
+		if v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			for _, v := range tfMap["pipelines"].([]interface{}) {
+				if v == nil {
+					continue
+				}
+
+				settings.Pipelines = append(settings.Pipelines, types.PipelinePauseStateSettings{
+					PipelineId: types.PipelineId(v.(map[string]interface{})["pipeline_id"].(string)),
+				})
+			}
+		}
+
+		apiObject.PauseStateSettings = settings
+	}
+
+	if v, ok := m["scte35_return_to_network_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Scte35ReturnToNetworkSettings = &types.Scte35ReturnToNetworkScheduleActionSettings{
+			SpliceEventId: int64(tfMap["splice_event_id"].(int)),
+		}
+	}
+
+	if v, ok := m["scte35_splice_insert_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Scte35SpliceInsertSettings = &types.Scte35SpliceInsertScheduleActionSettings{
+			Duration:      int64(tfMap["duration"].(int)),
+			SpliceEventId: int64(tfMap["splice_event_id"].(int)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandScheduleActionStartSettings(tfList []interface{}) *types.ScheduleActionStartSettings {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	m := tfList[0].(map[string]interface{})
+	apiObject := &types.ScheduleActionStartSettings{}
+
+	if v, ok := m["fixed_mode_start_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.FixedModeScheduleActionStartSettings = &types.FixedModeScheduleActionStartSettings{
+			Time: aws.String(v[0].(map[string]interface{})["time"].(string)),
+		}
+	}
+
+	if v, ok := m["follow_mode_start_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.FollowModeScheduleActionStartSettings = &types.FollowModeScheduleActionStartSettings{
+			FollowPoint:         types.FollowPoint(tfMap["follow_point"].(string)),
+			ReferenceActionName: aws.String(tfMap["reference_action_name"].(string)),
+		}
+	}
+
+	if v, ok := m["immediate_mode_start_settings"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ImmediateModeScheduleActionStartSettings = &types.ImmediateModeScheduleActionStartSettings{}
+	}
+
+	return apiObject
+}
+
+func flattenScheduleActionSettings(apiObject *types.ScheduleActionSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{}
+
+	if v := apiObject.InputSwitchSettings; v != nil {
+		m["input_switch_settings"] = []interface{}{
+			map[string]interface{}{
+				"input_attachment_name_reference": aws.ToString(v.InputAttachmentNameReference),
+				"url_path":                        v.UrlPath,
+			},
+		}
+	}
+
+	if v := apiObject.PauseStateSettings; v != nil {
+		var pipelines []interface{}
+
+		for _, v := range v.Pipelines {
+			pipelines = append(pipelines, map[string]interface{}{
+				"pipeline_id": string(v.PipelineId),
+			})
+		}
+
+		m["pause_state_settings"] = []interface{}{
+			map[string]interface{}{
+				"pipelines": pipelines,
+			},
+		}
+	}
+
+	if v := apiObject.Scte35ReturnToNetworkSettings; v != nil {
+		m["scte35_return_to_network_settings"] = []interface{}{
+			map[string]interface{}{
+				"splice_event_id": int(v.SpliceEventId),
+			},
+		}
+	}
+
+	if v := apiObject.Scte35SpliceInsertSettings; v != nil {
+		m["scte35_splice_insert_settings"] = []interface{}{
+			map[string]interface{}{
+				"duration":        int(v.Duration),
+				"splice_event_id": int(v.SpliceEventId),
+			},
+		}
+	}
+
+	return []interface{}{m}
+}
+
+func flattenScheduleActionStartSettings(apiObject *types.ScheduleActionStartSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{}
+
+	if v := apiObject.FixedModeScheduleActionStartSettings; v != nil {
+		m["fixed_mode_start_settings"] = []interface{}{
+			map[string]interface{}{
+				"time": aws.ToString(v.Time),
+			},
+		}
+	}
+
+	if v := apiObject.FollowModeScheduleActionStartSettings; v != nil {
+		m["follow_mode_start_settings"] = []interface{}{
+			map[string]interface{}{
+				"follow_point":          string(v.FollowPoint),
+				"reference_action_name": aws.ToString(v.ReferenceActionName),
+			},
+		}
+	}
+
+	if apiObject.ImmediateModeScheduleActionStartSettings != nil {
+		m["immediate_mode_start_settings"] = []interface{}{map[string]interface{}{}}
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,181 @@
+package medialive_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/medialive/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfmedialive "github.com/hashicorp/terraform-provider-aws/internal/service/medialive"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaLiveChannelScheduleAction_basic(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var action types.ScheduleAction
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_medialive_channel_schedule_action.test"
+	startTime := time.Now().UTC().Add(24 * time.Hour).Truncate(time.Second).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.MediaLiveEndpointID, t)
+			testAccChannelsPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelScheduleActionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelScheduleActionConfig_basic(rName, startTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelScheduleActionExists(resourceName, &action),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_id", "aws_medialive_channel.test", "channel_id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.scte35_splice_insert_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.scte35_splice_insert_settings.0.duration", "1350000"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.scte35_splice_insert_settings.0.splice_event_id", "1"),
+					resource.TestCheckResourceAttr(resourceName, "start_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "start_settings.0.fixed_mode_start_settings.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaLiveChannelScheduleAction_disappears(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var action types.ScheduleAction
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_medialive_channel_schedule_action.test"
+	startTime := time.Now().UTC().Add(24 * time.Hour).Truncate(time.Second).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.MediaLiveEndpointID, t)
+			testAccChannelsPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelScheduleActionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelScheduleActionConfig_basic(rName, startTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelScheduleActionExists(resourceName, &action),
+					acctest.CheckResourceDisappears(acctest.Provider, tfmedialive.ResourceChannelScheduleAction(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckChannelScheduleActionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).MediaLiveClient
+	ctx := context.Background()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_medialive_channel_schedule_action" {
+			continue
+		}
+
+		channelID, name, err := tfmedialive.ChannelScheduleActionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfmedialive.FindChannelScheduleActionByTwoPartKey(ctx, conn, channelID, name)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return create.Error(names.MediaLive, create.ErrActionCheckingDestroyed, tfmedialive.ResNameChannelScheduleAction, rs.Primary.ID, err)
+		}
+
+		return create.Error(names.MediaLive, create.ErrActionCheckingDestroyed, tfmedialive.ResNameChannelScheduleAction, rs.Primary.ID, errors.New("not destroyed"))
+	}
+
+	return nil
+}
+
+func testAccCheckChannelScheduleActionExists(name string, action *types.ScheduleAction) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.MediaLive, create.ErrActionCheckingExistence, tfmedialive.ResNameChannelScheduleAction, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.MediaLive, create.ErrActionCheckingExistence, tfmedialive.ResNameChannelScheduleAction, name, errors.New("not set"))
+		}
+
+		channelID, actionName, err := tfmedialive.ChannelScheduleActionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaLiveClient
+		ctx := context.Background()
+		resp, err := tfmedialive.FindChannelScheduleActionByTwoPartKey(ctx, conn, channelID, actionName)
+
+		if err != nil {
+			return create.Error(names.MediaLive, create.ErrActionCheckingExistence, tfmedialive.ResNameChannelScheduleAction, rs.Primary.ID, err)
+		}
+
+		*action = *resp
+
+		return nil
+	}
+}
+
+func testAccChannelScheduleActionConfig_basic(rName, startTime string) string {
+	return acctest.ConfigCompose(
+		testAccChannelConfig_basic(rName),
+		fmt.Sprintf(`
+resource "aws_medialive_channel_schedule_action" "test" {
+  channel_id = aws_medialive_channel.test.channel_id
+  name       = %[1]q
+
+  settings {
+    scte35_splice_insert_settings {
+      duration        = 1350000
+      splice_event_id = 1
+    }
+  }
+
+  start_settings {
+    fixed_mode_start_settings {
+      time = %[2]q
+    }
+  }
+}
+`, rName, startTime))
+}
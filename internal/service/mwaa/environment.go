@@ -2,6 +2,7 @@ package mwaa
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/mwaa"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -252,7 +254,10 @@ func ResourceEnvironment() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			customizeDiffWorkers,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -542,6 +547,20 @@ func resourceEnvironmentDelete(ctx context.Context, d *schema.ResourceData, meta
 	return nil
 }
 
+func customizeDiffWorkers(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("max_workers") || !diff.NewValueKnown("min_workers") {
+		return nil
+	}
+
+	maxWorkers, minWorkers := diff.Get("max_workers").(int), diff.Get("min_workers").(int)
+
+	if maxWorkers > 0 && minWorkers > maxWorkers {
+		return fmt.Errorf("min_workers (%d) must be less than or equal to max_workers (%d)", minWorkers, maxWorkers)
+	}
+
+	return nil
+}
+
 func environmentModuleLoggingConfigurationSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -641,6 +660,15 @@ func waitEnvironmentUpdated(ctx context.Context, conn *mwaa.MWAA, name string, t
 			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(v.LastUpdate.Error.ErrorCode), aws.StringValue(v.LastUpdate.Error.ErrorMessage)))
 		}
 
+		// A failed update is rolled back and the environment returns to AVAILABLE.
+		if err == nil && v.LastUpdate != nil && aws.StringValue(v.LastUpdate.Status) == mwaa.UpdateStatusFailed {
+			err = errors.New("update failed and was rolled back")
+
+			if v.LastUpdate.Error != nil {
+				err = fmt.Errorf("update failed and was rolled back: %s: %s", aws.StringValue(v.LastUpdate.Error.ErrorCode), aws.StringValue(v.LastUpdate.Error.ErrorMessage))
+			}
+		}
+
 		return v, err
 	}
 
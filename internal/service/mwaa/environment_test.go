@@ -3,6 +3,7 @@ package mwaa_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/mwaa"
@@ -343,6 +344,42 @@ func TestAccMWAAEnvironment_pluginsS3ObjectVersion(t *testing.T) {
 	})
 }
 
+func TestAccMWAAEnvironment_workers(t *testing.T) {
+	var environment mwaa.Environment
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mwaa_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mwaa.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEnvironmentConfig_workers(rName, 3, 2),
+				ExpectError: regexp.MustCompile(`min_workers \(3\) must be less than or equal to max_workers \(2\)`),
+			},
+			{
+				Config: testAccEnvironmentConfig_workers(rName, 1, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(resourceName, &environment),
+					resource.TestCheckResourceAttr(resourceName, "max_workers", "2"),
+					resource.TestCheckResourceAttr(resourceName, "min_workers", "1"),
+				),
+			},
+			{
+				Config: testAccEnvironmentConfig_workers(rName, 2, 4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(resourceName, &environment),
+					resource.TestCheckResourceAttr(resourceName, "max_workers", "4"),
+					resource.TestCheckResourceAttr(resourceName, "min_workers", "2"),
+					resource.TestCheckResourceAttr(resourceName, "last_updated.0.status", "SUCCESS"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckEnvironmentExists(n string, v *mwaa.Environment) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -614,6 +651,25 @@ resource "aws_mwaa_environment" "test" {
 `, rName))
 }
 
+func testAccEnvironmentConfig_workers(rName string, minWorkers, maxWorkers int) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig_base(rName), fmt.Sprintf(`
+resource "aws_mwaa_environment" "test" {
+  dag_s3_path        = aws_s3_object.dags.key
+  execution_role_arn = aws_iam_role.test.arn
+  max_workers        = %[3]d
+  min_workers        = %[2]d
+  name               = %[1]q
+
+  network_configuration {
+    security_group_ids = [aws_security_group.test.id]
+    subnet_ids         = aws_subnet.private[*].id
+  }
+
+  source_bucket_arn = aws_s3_bucket.test.arn
+}
+`, rName, minWorkers, maxWorkers))
+}
+
 func testAccEnvironmentConfig_airflowOptions(rName, retries, parallelism string) string {
 	return acctest.ConfigCompose(testAccEnvironmentConfig_base(rName), fmt.Sprintf(`
 resource "aws_mwaa_environment" "test" {
@@ -2,7 +2,11 @@ package networkmanager
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -11,14 +15,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
-// This resource is explicitly NOT exported from the provider until design is finalized.
-// Its Delete handler is used by sweepers.
 func ResourceCoreNetwork() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceCoreNetworkCreate,
@@ -33,9 +37,9 @@ func ResourceCoreNetwork() *schema.Resource {
 		CustomizeDiff: verify.SetTagsDiff,
 
 		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(10 * time.Minute),
-			Update: schema.DefaultTimeout(10 * time.Minute),
-			Delete: schema.DefaultTimeout(10 * time.Minute),
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -43,11 +47,115 @@ func ResourceCoreNetwork() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"edges": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"asn": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"edge_location": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"inside_cidr_blocks": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"execute_policy_change_set": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 			"global_network_id": {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
+			"latest_policy_version_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"live_policy_version_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"pending_policy_changes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"identifier_path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"policy_document": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"segments": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"edge_locations": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"shared_segments": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
@@ -55,6 +163,43 @@ func ResourceCoreNetwork() *schema.Resource {
 }
 
 func resourceCoreNetworkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &networkmanager.CreateCoreNetworkInput{
+		ClientToken:     aws.String(resource.UniqueId()),
+		GlobalNetworkId: aws.String(d.Get("global_network_id").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Network Manager Core Network: %s", input)
+	output, err := conn.CreateCoreNetworkWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Network Manager Core Network: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.CoreNetwork.CoreNetworkId))
+
+	if _, err := waitCoreNetworkCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Network Manager Core Network (%s) create: %s", d.Id(), err)
+	}
+
+	// The policy is attached as a separate version so that it can be left unexecuted.
+	if v, ok := d.GetOk("policy_document"); ok {
+		if err := putCoreNetworkPolicy(ctx, conn, d.Id(), v.(string), d.Get("execute_policy_change_set").(bool), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("creating Network Manager Core Network (%s) policy: %s", d.Id(), err)
+		}
+	}
+
 	return resourceCoreNetworkRead(ctx, d, meta)
 }
 
@@ -76,7 +221,68 @@ func resourceCoreNetworkRead(ctx context.Context, d *schema.ResourceData, meta i
 	}
 
 	d.Set("arn", coreNetwork.CoreNetworkArn)
+	if coreNetwork.CreatedAt != nil {
+		d.Set("created_at", aws.TimeValue(coreNetwork.CreatedAt).Format(time.RFC3339))
+	} else {
+		d.Set("created_at", nil)
+	}
+	d.Set("description", coreNetwork.Description)
+	if err := d.Set("edges", flattenCoreNetworkEdges(coreNetwork.Edges)); err != nil {
+		return diag.Errorf("setting edges: %s", err)
+	}
 	d.Set("global_network_id", coreNetwork.GlobalNetworkId)
+	if err := d.Set("segments", flattenCoreNetworkSegments(coreNetwork.Segments)); err != nil {
+		return diag.Errorf("setting segments: %s", err)
+	}
+	d.Set("state", coreNetwork.State)
+
+	latestPolicy, err := FindCoreNetworkPolicyByAlias(ctx, conn, d.Id(), networkmanager.CoreNetworkPolicyAliasLatest)
+
+	switch {
+	case tfresource.NotFound(err):
+		d.Set("latest_policy_version_id", nil)
+		d.Set("live_policy_version_id", nil)
+		d.Set("pending_policy_changes", nil)
+		d.Set("policy_document", nil)
+	case err != nil:
+		return diag.Errorf("reading Network Manager Core Network (%s) latest policy: %s", d.Id(), err)
+	default:
+		policyDocument, err := json.Marshal(latestPolicy.PolicyDocument)
+
+		if err != nil {
+			return diag.Errorf("marshaling Network Manager Core Network (%s) policy document: %s", d.Id(), err)
+		}
+
+		d.Set("latest_policy_version_id", latestPolicy.PolicyVersionId)
+		d.Set("policy_document", string(policyDocument))
+
+		var liveVersionID int64
+		livePolicy, err := FindCoreNetworkPolicyByAlias(ctx, conn, d.Id(), networkmanager.CoreNetworkPolicyAliasLive)
+
+		switch {
+		case tfresource.NotFound(err):
+		case err != nil:
+			return diag.Errorf("reading Network Manager Core Network (%s) live policy: %s", d.Id(), err)
+		default:
+			liveVersionID = aws.Int64Value(livePolicy.PolicyVersionId)
+		}
+
+		d.Set("live_policy_version_id", liveVersionID)
+
+		var pendingChanges []*networkmanager.CoreNetworkChange
+
+		if latestVersionID := aws.Int64Value(latestPolicy.PolicyVersionId); latestVersionID != liveVersionID {
+			pendingChanges, err = FindCoreNetworkChangeSet(ctx, conn, d.Id(), latestVersionID)
+
+			if err != nil {
+				return diag.Errorf("reading Network Manager Core Network (%s) policy version (%d) change set: %s", d.Id(), latestVersionID, err)
+			}
+		}
+
+		if err := d.Set("pending_policy_changes", flattenCoreNetworkChanges(pendingChanges)); err != nil {
+			return diag.Errorf("setting pending_policy_changes: %s", err)
+		}
+	}
 
 	tags := KeyValueTags(coreNetwork.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
@@ -95,6 +301,37 @@ func resourceCoreNetworkRead(ctx context.Context, d *schema.ResourceData, meta i
 func resourceCoreNetworkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).NetworkManagerConn
 
+	if d.HasChange("description") {
+		input := &networkmanager.UpdateCoreNetworkInput{
+			CoreNetworkId: aws.String(d.Id()),
+			Description:   aws.String(d.Get("description").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Network Manager Core Network: %s", input)
+		_, err := conn.UpdateCoreNetworkWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Network Manager Core Network (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitCoreNetworkUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for Network Manager Core Network (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("policy_document") {
+		if err := putCoreNetworkPolicy(ctx, conn, d.Id(), d.Get("policy_document").(string), d.Get("execute_policy_change_set").(bool), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("updating Network Manager Core Network (%s) policy: %s", d.Id(), err)
+		}
+	} else if d.HasChange("execute_policy_change_set") && d.Get("execute_policy_change_set").(bool) {
+		// Apply a previously created-only policy version.
+		if latestVersionID, liveVersionID := int64(d.Get("latest_policy_version_id").(int)), int64(d.Get("live_policy_version_id").(int)); latestVersionID != 0 && latestVersionID != liveVersionID {
+			if err := executeCoreNetworkChangeSet(ctx, conn, d.Id(), latestVersionID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.Errorf("updating Network Manager Core Network (%s) policy: %s", d.Id(), err)
+			}
+		}
+	}
+
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
@@ -129,6 +366,57 @@ func resourceCoreNetworkDelete(ctx context.Context, d *schema.ResourceData, meta
 	return nil
 }
 
+// putCoreNetworkPolicy creates a new policy version and, if requested, executes its change set.
+func putCoreNetworkPolicy(ctx context.Context, conn *networkmanager.NetworkManager, coreNetworkID, policyDocument string, execute bool, timeout time.Duration) error {
+	var document aws.JSONValue
+
+	if err := json.Unmarshal([]byte(policyDocument), &document); err != nil {
+		return fmt.Errorf("decoding policy document: %w", err)
+	}
+
+	input := &networkmanager.PutCoreNetworkPolicyInput{
+		ClientToken:    aws.String(resource.UniqueId()),
+		CoreNetworkId:  aws.String(coreNetworkID),
+		PolicyDocument: document,
+	}
+
+	log.Printf("[DEBUG] Putting Network Manager Core Network policy: %s", input)
+	output, err := conn.PutCoreNetworkPolicyWithContext(ctx, input)
+
+	if err != nil {
+		return err
+	}
+
+	policyVersionID := aws.Int64Value(output.CoreNetworkPolicy.PolicyVersionId)
+
+	if _, err := waitCoreNetworkPolicyCreated(ctx, conn, coreNetworkID, policyVersionID, timeout); err != nil {
+		return fmt.Errorf("waiting for policy version (%d) create: %w", policyVersionID, err)
+	}
+
+	if !execute {
+		return nil
+	}
+
+	return executeCoreNetworkChangeSet(ctx, conn, coreNetworkID, policyVersionID, timeout)
+}
+
+func executeCoreNetworkChangeSet(ctx context.Context, conn *networkmanager.NetworkManager, coreNetworkID string, policyVersionID int64, timeout time.Duration) error {
+	_, err := conn.ExecuteCoreNetworkChangeSetWithContext(ctx, &networkmanager.ExecuteCoreNetworkChangeSetInput{
+		CoreNetworkId:   aws.String(coreNetworkID),
+		PolicyVersionId: aws.Int64(policyVersionID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("executing policy version (%d) change set: %w", policyVersionID, err)
+	}
+
+	if _, err := waitCoreNetworkChangeSetExecuted(ctx, conn, coreNetworkID, policyVersionID, timeout); err != nil {
+		return fmt.Errorf("waiting for policy version (%d) change set execution: %w", policyVersionID, err)
+	}
+
+	return nil
+}
+
 func FindCoreNetworkByID(ctx context.Context, conn *networkmanager.NetworkManager, id string) (*networkmanager.CoreNetwork, error) {
 	input := &networkmanager.GetCoreNetworkInput{
 		CoreNetworkId: aws.String(id),
@@ -154,6 +442,82 @@ func FindCoreNetworkByID(ctx context.Context, conn *networkmanager.NetworkManage
 	return output.CoreNetwork, nil
 }
 
+func findCoreNetworkPolicy(ctx context.Context, conn *networkmanager.NetworkManager, input *networkmanager.GetCoreNetworkPolicyInput) (*networkmanager.CoreNetworkPolicy, error) {
+	output, err := conn.GetCoreNetworkPolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.CoreNetworkPolicy == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.CoreNetworkPolicy, nil
+}
+
+func FindCoreNetworkPolicyByAlias(ctx context.Context, conn *networkmanager.NetworkManager, coreNetworkID, alias string) (*networkmanager.CoreNetworkPolicy, error) {
+	input := &networkmanager.GetCoreNetworkPolicyInput{
+		Alias:         aws.String(alias),
+		CoreNetworkId: aws.String(coreNetworkID),
+	}
+
+	return findCoreNetworkPolicy(ctx, conn, input)
+}
+
+func FindCoreNetworkPolicyByTwoPartKey(ctx context.Context, conn *networkmanager.NetworkManager, coreNetworkID string, policyVersionID int64) (*networkmanager.CoreNetworkPolicy, error) {
+	input := &networkmanager.GetCoreNetworkPolicyInput{
+		CoreNetworkId:   aws.String(coreNetworkID),
+		PolicyVersionId: aws.Int64(policyVersionID),
+	}
+
+	return findCoreNetworkPolicy(ctx, conn, input)
+}
+
+func FindCoreNetworkChangeSet(ctx context.Context, conn *networkmanager.NetworkManager, coreNetworkID string, policyVersionID int64) ([]*networkmanager.CoreNetworkChange, error) {
+	input := &networkmanager.GetCoreNetworkChangeSetInput{
+		CoreNetworkId:   aws.String(coreNetworkID),
+		PolicyVersionId: aws.Int64(policyVersionID),
+	}
+	var output []*networkmanager.CoreNetworkChange
+
+	err := conn.GetCoreNetworkChangeSetPagesWithContext(ctx, input, func(page *networkmanager.GetCoreNetworkChangeSetOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CoreNetworkChanges {
+			if v == nil {
+				continue
+			}
+
+			output = append(output, v)
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 func StatusCoreNetworkState(ctx context.Context, conn *networkmanager.NetworkManager, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindCoreNetworkByID(ctx, conn, id)
@@ -170,6 +534,56 @@ func StatusCoreNetworkState(ctx context.Context, conn *networkmanager.NetworkMan
 	}
 }
 
+func statusCoreNetworkPolicyChangeSetState(ctx context.Context, conn *networkmanager.NetworkManager, coreNetworkID string, policyVersionID int64) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindCoreNetworkPolicyByTwoPartKey(ctx, conn, coreNetworkID, policyVersionID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ChangeSetState), nil
+	}
+}
+
+func waitCoreNetworkCreated(ctx context.Context, conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.CoreNetwork, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.CoreNetworkStateCreating},
+		Target:  []string{networkmanager.CoreNetworkStateAvailable},
+		Timeout: timeout,
+		Refresh: StatusCoreNetworkState(ctx, conn, id),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.CoreNetwork); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCoreNetworkUpdated(ctx context.Context, conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.CoreNetwork, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.CoreNetworkStateUpdating},
+		Target:  []string{networkmanager.CoreNetworkStateAvailable},
+		Timeout: timeout,
+		Refresh: StatusCoreNetworkState(ctx, conn, id),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.CoreNetwork); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitCoreNetworkDeleted(ctx context.Context, conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.CoreNetwork, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{networkmanager.CoreNetworkStateDeleting},
@@ -186,3 +600,126 @@ func waitCoreNetworkDeleted(ctx context.Context, conn *networkmanager.NetworkMan
 
 	return nil, err
 }
+
+func waitCoreNetworkPolicyCreated(ctx context.Context, conn *networkmanager.NetworkManager, coreNetworkID string, policyVersionID int64, timeout time.Duration) (*networkmanager.CoreNetworkPolicy, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.ChangeSetStatePendingGeneration},
+		Target:  []string{networkmanager.ChangeSetStateReadyToExecute},
+		Timeout: timeout,
+		Refresh: statusCoreNetworkPolicyChangeSetState(ctx, conn, coreNetworkID, policyVersionID),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.CoreNetworkPolicy); ok {
+		tfresource.SetLastError(err, coreNetworkPolicyErrorsError(output.PolicyErrors))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCoreNetworkChangeSetExecuted(ctx context.Context, conn *networkmanager.NetworkManager, coreNetworkID string, policyVersionID int64, timeout time.Duration) (*networkmanager.CoreNetworkPolicy, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.ChangeSetStateReadyToExecute, networkmanager.ChangeSetStateExecuting},
+		Target:  []string{networkmanager.ChangeSetStateExecutionSucceeded},
+		Timeout: timeout,
+		Refresh: statusCoreNetworkPolicyChangeSetState(ctx, conn, coreNetworkID, policyVersionID),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.CoreNetworkPolicy); ok {
+		tfresource.SetLastError(err, coreNetworkPolicyErrorsError(output.PolicyErrors))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func coreNetworkPolicyErrorsError(apiObjects []*networkmanager.CoreNetworkPolicyError) error {
+	var errs []string
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		errs = append(errs, fmt.Sprintf("%s: %s (%s)", aws.StringValue(apiObject.ErrorCode), aws.StringValue(apiObject.Message), aws.StringValue(apiObject.Path)))
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(errs, "; "))
+}
+
+func flattenCoreNetworkEdges(apiObjects []*networkmanager.CoreNetworkEdge) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"asn":                aws.Int64Value(apiObject.Asn),
+			"edge_location":      aws.StringValue(apiObject.EdgeLocation),
+			"inside_cidr_blocks": aws.StringValueSlice(apiObject.InsideCidrBlocks),
+		})
+	}
+
+	return tfList
+}
+
+func flattenCoreNetworkSegments(apiObjects []*networkmanager.CoreNetworkSegment) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"edge_locations":  aws.StringValueSlice(apiObject.EdgeLocations),
+			"name":            aws.StringValue(apiObject.Name),
+			"shared_segments": aws.StringValueSlice(apiObject.SharedSegments),
+		})
+	}
+
+	return tfList
+}
+
+func flattenCoreNetworkChanges(apiObjects []*networkmanager.CoreNetworkChange) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"action":          aws.StringValue(apiObject.Action),
+			"identifier":      aws.StringValue(apiObject.Identifier),
+			"identifier_path": aws.StringValue(apiObject.IdentifierPath),
+			"type":            aws.StringValue(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
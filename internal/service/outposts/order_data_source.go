@@ -0,0 +1,163 @@
+package outposts
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/outposts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceOrder() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceOrderRead,
+
+		Schema: map[string]*schema.Schema{
+			"line_item": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"asset_information": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"asset_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"mac_address_list": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"catalog_item_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"line_item_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"quantity": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"order_fulfilled_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"order_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"order_submission_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"outpost_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"payment_option": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceOrderRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).OutpostsConn
+
+	orderID := d.Get("order_id").(string)
+	output, err := conn.GetOrder(&outposts.GetOrderInput{
+		OrderId: aws.String(orderID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error getting Outposts Order (%s): %w", orderID, err)
+	}
+
+	if output == nil || output.Order == nil {
+		return fmt.Errorf("error getting Outposts Order (%s): empty result", orderID)
+	}
+
+	order := output.Order
+
+	d.SetId(aws.StringValue(order.OrderId))
+
+	if err := d.Set("line_item", flattenLineItems(order.LineItems)); err != nil {
+		return fmt.Errorf("error setting line_item: %w", err)
+	}
+
+	if order.OrderFulfilledDate != nil {
+		d.Set("order_fulfilled_date", aws.TimeValue(order.OrderFulfilledDate).Format(time.RFC3339))
+	} else {
+		d.Set("order_fulfilled_date", nil)
+	}
+	d.Set("order_id", order.OrderId)
+	if order.OrderSubmissionDate != nil {
+		d.Set("order_submission_date", aws.TimeValue(order.OrderSubmissionDate).Format(time.RFC3339))
+	} else {
+		d.Set("order_submission_date", nil)
+	}
+	d.Set("outpost_id", order.OutpostId)
+	d.Set("payment_option", order.PaymentOption)
+	d.Set("status", order.Status)
+
+	return nil
+}
+
+func flattenLineItems(apiObjects []*outposts.LineItem) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"catalog_item_id": aws.StringValue(apiObject.CatalogItemId),
+			"line_item_id":    aws.StringValue(apiObject.LineItemId),
+			"quantity":        aws.Int64Value(apiObject.Quantity),
+			"status":          aws.StringValue(apiObject.Status),
+		}
+
+		var assetInformation []interface{}
+
+		for _, apiObject := range apiObject.AssetInformationList {
+			if apiObject == nil {
+				continue
+			}
+
+			assetInformation = append(assetInformation, map[string]interface{}{
+				"asset_id":         aws.StringValue(apiObject.AssetId),
+				"mac_address_list": aws.StringValueSlice(apiObject.MacAddressList),
+			})
+		}
+
+		tfMap["asset_information"] = assetInformation
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
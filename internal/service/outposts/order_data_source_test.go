@@ -0,0 +1,51 @@
+package outposts_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/outposts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccOutpostsOrderDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_outposts_order.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckOutpostsOutposts(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, outposts.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrderDataSourceConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "id", regexp.MustCompile(`^oo-.+$`)),
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "line_item.#", "0"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "line_item.0.catalog_item_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "line_item.0.quantity"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "line_item.0.status"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "order_submission_date"),
+					resource.TestMatchResourceAttr(dataSourceName, "outpost_id", regexp.MustCompile(`^op-.+$`)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "payment_option"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrderDataSourceConfig_basic() string {
+	return `
+data "aws_outposts_outposts" "test" {}
+
+data "aws_outposts_orders" "test" {
+  outpost_identifier = tolist(data.aws_outposts_outposts.test.arns)[0]
+}
+
+data "aws_outposts_order" "test" {
+  order_id = tolist(data.aws_outposts_orders.test.ids)[0]
+}
+`
+}
@@ -0,0 +1,76 @@
+package outposts
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/outposts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceOrders() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceOrdersRead,
+
+		Schema: map[string]*schema.Schema{
+			"ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"outpost_identifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceOrdersRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).OutpostsConn
+
+	input := &outposts.ListOrdersInput{}
+
+	if v, ok := d.GetOk("outpost_identifier"); ok {
+		input.OutpostIdentifierFilter = aws.String(v.(string))
+	}
+
+	var ids []string
+
+	err := conn.ListOrdersPages(input, func(page *outposts.ListOrdersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, order := range page.Orders {
+			if order == nil {
+				continue
+			}
+
+			if v, ok := d.GetOk("status"); ok && v.(string) != aws.StringValue(order.Status) {
+				continue
+			}
+
+			ids = append(ids, aws.StringValue(order.OrderId))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing Outposts Orders: %w", err)
+	}
+
+	if err := d.Set("ids", ids); err != nil {
+		return fmt.Errorf("error setting ids: %w", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	return nil
+}
@@ -18,6 +18,10 @@ func DataSourceSite() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -34,6 +38,66 @@ func DataSourceSite() *schema.Resource {
 				Computed:     true,
 				ExactlyOneOf: []string{"id", "name"},
 			},
+			"notes": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operating_address_city": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operating_address_country_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operating_address_state_or_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rack_physical_properties": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"fiber_optic_cable_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"maximum_supported_weight_lbs": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"optical_standard": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"power_connector": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"power_draw_kva": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"power_feed_drop": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"power_phase": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"uplink_count": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"uplink_gbps": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -85,8 +149,37 @@ func dataSourceSiteRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(aws.StringValue(site.SiteId))
 	d.Set("account_id", site.AccountId)
+	d.Set("arn", site.SiteArn)
 	d.Set("description", site.Description)
 	d.Set("name", site.Name)
+	d.Set("notes", site.Notes)
+	d.Set("operating_address_city", site.OperatingAddressCity)
+	d.Set("operating_address_country_code", site.OperatingAddressCountryCode)
+	d.Set("operating_address_state_or_region", site.OperatingAddressStateOrRegion)
+
+	if err := d.Set("rack_physical_properties", flattenRackPhysicalProperties(site.RackPhysicalProperties)); err != nil {
+		return fmt.Errorf("error setting rack_physical_properties: %w", err)
+	}
 
 	return nil
 }
+
+func flattenRackPhysicalProperties(apiObject *outposts.RackPhysicalProperties) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"fiber_optic_cable_type":       aws.StringValue(apiObject.FiberOpticCableType),
+		"maximum_supported_weight_lbs": aws.StringValue(apiObject.MaximumSupportedWeightLbs),
+		"optical_standard":             aws.StringValue(apiObject.OpticalStandard),
+		"power_connector":              aws.StringValue(apiObject.PowerConnector),
+		"power_draw_kva":               aws.StringValue(apiObject.PowerDrawKva),
+		"power_feed_drop":              aws.StringValue(apiObject.PowerFeedDrop),
+		"power_phase":                  aws.StringValue(apiObject.PowerPhase),
+		"uplink_count":                 aws.StringValue(apiObject.UplinkCount),
+		"uplink_gbps":                  aws.StringValue(apiObject.UplinkGbps),
+	}
+
+	return []interface{}{tfMap}
+}
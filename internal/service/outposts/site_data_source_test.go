@@ -22,6 +22,7 @@ func TestAccOutpostsSiteDataSource_id(t *testing.T) {
 				Config: testAccSiteDataSourceConfig_id(),
 				Check: resource.ComposeTestCheckFunc(
 					acctest.CheckResourceAttrAccountID(dataSourceName, "account_id"),
+					acctest.MatchResourceAttrRegionalARN(dataSourceName, "arn", "outposts", regexp.MustCompile(`site/os-.+$`)),
 					resource.TestCheckResourceAttrSet(dataSourceName, "description"),
 					resource.TestMatchResourceAttr(dataSourceName, "id", regexp.MustCompile(`^os-.+$`)),
 					resource.TestMatchResourceAttr(dataSourceName, "name", regexp.MustCompile(`^.+$`)),
@@ -45,9 +46,11 @@ func TestAccOutpostsSiteDataSource_name(t *testing.T) {
 				Config: testAccSiteDataSourceConfig_name(),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrPair(dataSourceName, "account_id", sourceDataSourceName, "account_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", sourceDataSourceName, "arn"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "description", sourceDataSourceName, "description"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "id", sourceDataSourceName, "id"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "name", sourceDataSourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rack_physical_properties.#", sourceDataSourceName, "rack_physical_properties.#"),
 				),
 			},
 		},
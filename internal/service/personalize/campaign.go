@@ -0,0 +1,372 @@
+package personalize
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/personalize"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceCampaign() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCampaignCreate,
+		ReadWithoutTimeout:   resourceCampaignRead,
+		UpdateWithoutTimeout: resourceCampaignUpdate,
+		DeleteWithoutTimeout: resourceCampaignDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"campaign_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"item_exploration_config": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"min_provisioned_tps": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"solution_version_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceCampaignCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PersonalizeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &personalize.CreateCampaignInput{
+		MinProvisionedTPS:  aws.Int64(int64(d.Get("min_provisioned_tps").(int))),
+		Name:               aws.String(name),
+		SolutionVersionArn: aws.String(d.Get("solution_version_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("campaign_config"); ok {
+		input.CampaignConfig = expandCampaignConfig(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateCampaignWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Personalize Campaign (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.CampaignArn))
+
+	if _, err := waitCampaignCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Personalize Campaign (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceCampaignRead(ctx, d, meta)
+}
+
+func resourceCampaignRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PersonalizeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	campaign, err := FindCampaignByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Personalize Campaign (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Personalize Campaign (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", campaign.CampaignArn)
+	if err := d.Set("campaign_config", flattenCampaignConfig(campaign.CampaignConfig)); err != nil {
+		return diag.Errorf("setting campaign_config: %s", err)
+	}
+	d.Set("min_provisioned_tps", campaign.MinProvisionedTPS)
+	d.Set("name", campaign.Name)
+	d.Set("solution_version_arn", campaign.SolutionVersionArn)
+	d.Set("status", campaign.Status)
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("listing tags for Personalize Campaign (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCampaignUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PersonalizeConn
+
+	if d.HasChanges("campaign_config", "min_provisioned_tps", "solution_version_arn") {
+		input := &personalize.UpdateCampaignInput{
+			CampaignArn:        aws.String(d.Id()),
+			MinProvisionedTPS:  aws.Int64(int64(d.Get("min_provisioned_tps").(int))),
+			SolutionVersionArn: aws.String(d.Get("solution_version_arn").(string)),
+		}
+
+		if v, ok := d.GetOk("campaign_config"); ok {
+			input.CampaignConfig = expandCampaignConfig(v.([]interface{}))
+		}
+
+		_, err := conn.UpdateCampaignWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Personalize Campaign (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitCampaignUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for Personalize Campaign (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating Personalize Campaign (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceCampaignRead(ctx, d, meta)
+}
+
+func resourceCampaignDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PersonalizeConn
+
+	log.Printf("[DEBUG] Deleting Personalize Campaign: %s", d.Id())
+	_, err := conn.DeleteCampaignWithContext(ctx, &personalize.DeleteCampaignInput{
+		CampaignArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, personalize.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Personalize Campaign (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitCampaignDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Personalize Campaign (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindCampaignByARN(ctx context.Context, conn *personalize.Personalize, arn string) (*personalize.Campaign, error) {
+	input := &personalize.DescribeCampaignInput{
+		CampaignArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeCampaignWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, personalize.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Campaign == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Campaign, nil
+}
+
+func statusCampaign(ctx context.Context, conn *personalize.Personalize, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindCampaignByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+// statusCampaignLatestUpdate returns the status of the campaign's most recent update.
+func statusCampaignLatestUpdate(ctx context.Context, conn *personalize.Personalize, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindCampaignByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.LatestCampaignUpdate == nil {
+			return output, aws.StringValue(output.Status), nil
+		}
+
+		return output, aws.StringValue(output.LatestCampaignUpdate.Status), nil
+	}
+}
+
+func waitCampaignCreated(ctx context.Context, conn *personalize.Personalize, arn string, timeout time.Duration) (*personalize.Campaign, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{statusCreatePending, statusCreateInProgress},
+		Target:  []string{statusActive},
+		Refresh: statusCampaign(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*personalize.Campaign); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCampaignUpdated(ctx context.Context, conn *personalize.Personalize, arn string, timeout time.Duration) (*personalize.Campaign, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{statusUpdatePending, statusUpdateInProgress, statusCreatePending, statusCreateInProgress},
+		Target:  []string{statusActive},
+		Refresh: statusCampaignLatestUpdate(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*personalize.Campaign); ok {
+		if v := output.LatestCampaignUpdate; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.FailureReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCampaignDeleted(ctx context.Context, conn *personalize.Personalize, arn string, timeout time.Duration) (*personalize.Campaign, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{statusActive, statusDeletePending, statusDeleteInProgress},
+		Target:  []string{},
+		Refresh: statusCampaign(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*personalize.Campaign); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandCampaignConfig(tfList []interface{}) *personalize.CampaignConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &personalize.CampaignConfig{}
+
+	if v, ok := tfMap["item_exploration_config"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.ItemExplorationConfig = flex.ExpandStringMap(v)
+	}
+
+	return apiObject
+}
+
+func flattenCampaignConfig(apiObject *personalize.CampaignConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"item_exploration_config": aws.StringValueMap(apiObject.ItemExplorationConfig),
+	}}
+}
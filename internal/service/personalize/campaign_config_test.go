@@ -0,0 +1,107 @@
+package personalize
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/personalize"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExpandCampaignConfig(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    []interface{}
+		expected *personalize.CampaignConfig
+	}{
+		"empty": {
+			input:    []interface{}{},
+			expected: nil,
+		},
+		"nil block": {
+			input:    []interface{}{nil},
+			expected: nil,
+		},
+		"no item exploration config": {
+			input: []interface{}{map[string]interface{}{
+				"item_exploration_config": map[string]interface{}{},
+			}},
+			expected: &personalize.CampaignConfig{},
+		},
+		"item exploration config": {
+			input: []interface{}{map[string]interface{}{
+				"item_exploration_config": map[string]interface{}{
+					"exploration_item_age_cut_off": "30",
+					"exploration_weight":           "0.3",
+				},
+			}},
+			expected: &personalize.CampaignConfig{
+				ItemExplorationConfig: aws.StringMap(map[string]string{
+					"exploration_item_age_cut_off": "30",
+					"exploration_weight":           "0.3",
+				}),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := expandCampaignConfig(testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenCampaignConfig(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    *personalize.CampaignConfig
+		expected []interface{}
+	}{
+		"nil": {
+			input:    nil,
+			expected: nil,
+		},
+		"no item exploration config": {
+			input: &personalize.CampaignConfig{},
+			expected: []interface{}{map[string]interface{}{
+				"item_exploration_config": map[string]string{},
+			}},
+		},
+		"item exploration config": {
+			input: &personalize.CampaignConfig{
+				ItemExplorationConfig: aws.StringMap(map[string]string{
+					"exploration_weight": "0.3",
+				}),
+			},
+			expected: []interface{}{map[string]interface{}{
+				"item_exploration_config": map[string]string{
+					"exploration_weight": "0.3",
+				},
+			}},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := flattenCampaignConfig(testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
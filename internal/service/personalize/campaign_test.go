@@ -0,0 +1,142 @@
+package personalize_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/personalize"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	tfpersonalize "github.com/hashicorp/terraform-provider-aws/internal/service/personalize"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccPersonalizeCampaign_basic(t *testing.T) {
+	datasetGroupARN := envvar.SkipIfEmpty(t, envVarDatasetGroupARN, envVarDatasetGroupARNMessageError)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_personalize_campaign.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(personalize.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, personalize.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCampaignDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCampaignConfig_basic(rName, datasetGroupARN, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCampaignExists(resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "personalize", fmt.Sprintf("campaign/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "campaign_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "campaign_config.0.item_exploration_config.exploration_weight", "0.3"),
+					resource.TestCheckResourceAttr(resourceName, "min_provisioned_tps", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "solution_version_arn", "aws_personalize_solution_version.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCampaignConfig_basic(rName, datasetGroupARN, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCampaignExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "min_provisioned_tps", "2"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPersonalizeCampaign_disappears(t *testing.T) {
+	datasetGroupARN := envvar.SkipIfEmpty(t, envVarDatasetGroupARN, envVarDatasetGroupARNMessageError)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_personalize_campaign.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(personalize.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, personalize.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCampaignDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCampaignConfig_basic(rName, datasetGroupARN, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCampaignExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfpersonalize.ResourceCampaign(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCampaignDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).PersonalizeConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_personalize_campaign" {
+			continue
+		}
+
+		_, err := tfpersonalize.FindCampaignByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Personalize Campaign %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckCampaignExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Personalize Campaign ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PersonalizeConn
+
+		_, err := tfpersonalize.FindCampaignByARN(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCampaignConfig_basic(rName, datasetGroupARN string, minProvisionedTPS int) string {
+	return acctest.ConfigCompose(testAccSolutionVersionConfig_basic(rName, datasetGroupARN), fmt.Sprintf(`
+resource "aws_personalize_campaign" "test" {
+  name                 = %[1]q
+  solution_version_arn = aws_personalize_solution_version.test.arn
+  min_provisioned_tps  = %[2]d
+
+  campaign_config {
+    item_exploration_config = {
+      exploration_weight = "0.3"
+    }
+  }
+}
+`, rName, minProvisionedTPS))
+}
@@ -0,0 +1,37 @@
+package personalize
+
+import (
+	"time"
+)
+
+const (
+	propagationTimeout = 2 * time.Minute
+)
+
+// Personalize resource statuses are not modeled as enums in the API.
+const (
+	statusActive           = "ACTIVE"
+	statusCreateFailed     = "CREATE FAILED"
+	statusCreateInProgress = "CREATE IN_PROGRESS"
+	statusCreatePending    = "CREATE PENDING"
+	statusCreateStopped    = "CREATE STOPPED"
+	statusCreateStopping   = "CREATE STOPPING"
+	statusDeleteInProgress = "DELETE IN_PROGRESS"
+	statusDeletePending    = "DELETE PENDING"
+	statusUpdateInProgress = "UPDATE IN_PROGRESS"
+	statusUpdatePending    = "UPDATE PENDING"
+)
+
+const (
+	datasetTypeInteractions = "Interactions"
+	datasetTypeItems        = "Items"
+	datasetTypeUsers        = "Users"
+)
+
+func datasetType_Values() []string {
+	return []string{
+		datasetTypeInteractions,
+		datasetTypeItems,
+		datasetTypeUsers,
+	}
+}
@@ -0,0 +1,158 @@
+package personalize
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/personalize"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceSchema() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSchemaCreate,
+		ReadWithoutTimeout:   resourceSchemaRead,
+		DeleteWithoutTimeout: resourceSchemaDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(personalize.Domain_Values(), false),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"schema": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.All(validation.StringLenBetween(1, 10000), validation.StringIsJSON),
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+		},
+	}
+}
+
+func resourceSchemaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PersonalizeConn
+
+	name := d.Get("name").(string)
+	input := &personalize.CreateSchemaInput{
+		Name:   aws.String(name),
+		Schema: aws.String(d.Get("schema").(string)),
+	}
+
+	if v, ok := d.GetOk("domain"); ok {
+		input.Domain = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateSchemaWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Personalize Schema (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.SchemaArn))
+
+	return resourceSchemaRead(ctx, d, meta)
+}
+
+func resourceSchemaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PersonalizeConn
+
+	datasetSchema, err := FindSchemaByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Personalize Schema (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Personalize Schema (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", datasetSchema.SchemaArn)
+	d.Set("domain", datasetSchema.Domain)
+	d.Set("name", datasetSchema.Name)
+
+	schemaToSet, err := verify.SecondJSONUnlessEquivalent(d.Get("schema").(string), aws.StringValue(datasetSchema.Schema))
+
+	if err != nil {
+		return diag.Errorf("while setting schema (%s), encountered: %s", d.Id(), err)
+	}
+
+	d.Set("schema", schemaToSet)
+
+	return nil
+}
+
+func resourceSchemaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PersonalizeConn
+
+	log.Printf("[DEBUG] Deleting Personalize Schema: %s", d.Id())
+	_, err := conn.DeleteSchemaWithContext(ctx, &personalize.DeleteSchemaInput{
+		SchemaArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, personalize.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Personalize Schema (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindSchemaByARN(ctx context.Context, conn *personalize.Personalize, arn string) (*personalize.DatasetSchema, error) {
+	input := &personalize.DescribeSchemaInput{
+		SchemaArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeSchemaWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, personalize.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Schema == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Schema, nil
+}
@@ -0,0 +1,787 @@
+package personalize
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/personalize"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceSolution() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSolutionCreate,
+		ReadWithoutTimeout:   resourceSolutionRead,
+		UpdateWithoutTimeout: resourceSolutionUpdate,
+		DeleteWithoutTimeout: resourceSolutionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dataset_group_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"event_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"perform_auto_ml": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"perform_hpo": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"recipe_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"solution_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"algorithm_hyper_parameters": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"auto_ml_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"metric_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"recipe_list": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidARN,
+										},
+									},
+								},
+							},
+						},
+						"event_value_threshold": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"feature_transformation_parameters": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"hpo_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"algorithm_hyper_parameter_ranges": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"categorical_hyper_parameter_range": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													MaxItems: 100,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"name": {
+																Type:     schema.TypeString,
+																Optional: true,
+																ForceNew: true,
+															},
+															"values": {
+																Type:     schema.TypeList,
+																Optional: true,
+																ForceNew: true,
+																MaxItems: 100,
+																Elem:     &schema.Schema{Type: schema.TypeString},
+															},
+														},
+													},
+												},
+												"continuous_hyper_parameter_range": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													MaxItems: 100,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"max_value": {
+																Type:     schema.TypeFloat,
+																Optional: true,
+																ForceNew: true,
+															},
+															"min_value": {
+																Type:     schema.TypeFloat,
+																Optional: true,
+																ForceNew: true,
+															},
+															"name": {
+																Type:     schema.TypeString,
+																Optional: true,
+																ForceNew: true,
+															},
+														},
+													},
+												},
+												"integer_hyper_parameter_range": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													MaxItems: 100,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"max_value": {
+																Type:     schema.TypeInt,
+																Optional: true,
+																ForceNew: true,
+															},
+															"min_value": {
+																Type:     schema.TypeInt,
+																Optional: true,
+																ForceNew: true,
+															},
+															"name": {
+																Type:     schema.TypeString,
+																Optional: true,
+																ForceNew: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"hpo_objective": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"metric_name": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"metric_regex": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"type": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringInSlice([]string{"Maximize", "Minimize"}, false),
+												},
+											},
+										},
+									},
+									"hpo_resource_config": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"max_number_of_training_jobs": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"max_parallel_training_jobs": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"optimization_objective": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"item_attribute": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"objective_sensitivity": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(personalize.ObjectiveSensitivity_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceSolutionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PersonalizeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &personalize.CreateSolutionInput{
+		DatasetGroupArn: aws.String(d.Get("dataset_group_arn").(string)),
+		Name:            aws.String(name),
+	}
+
+	if v, ok := d.GetOk("event_type"); ok {
+		input.EventType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("perform_auto_ml"); ok {
+		input.PerformAutoML = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("perform_hpo"); ok {
+		input.PerformHPO = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("recipe_arn"); ok {
+		input.RecipeArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("solution_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SolutionConfig = expandSolutionConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateSolutionWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Personalize Solution (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.SolutionArn))
+
+	if _, err := waitSolutionCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Personalize Solution (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceSolutionRead(ctx, d, meta)
+}
+
+func resourceSolutionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PersonalizeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	solution, err := FindSolutionByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Personalize Solution (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Personalize Solution (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", solution.SolutionArn)
+	d.Set("dataset_group_arn", solution.DatasetGroupArn)
+	d.Set("event_type", solution.EventType)
+	d.Set("name", solution.Name)
+	d.Set("perform_auto_ml", solution.PerformAutoML)
+	d.Set("perform_hpo", solution.PerformHPO)
+	d.Set("recipe_arn", solution.RecipeArn)
+	if solution.SolutionConfig != nil {
+		if err := d.Set("solution_config", []interface{}{flattenSolutionConfig(solution.SolutionConfig)}); err != nil {
+			return diag.Errorf("setting solution_config: %s", err)
+		}
+	} else {
+		d.Set("solution_config", nil)
+	}
+	d.Set("status", solution.Status)
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("listing tags for Personalize Solution (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceSolutionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PersonalizeConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating Personalize Solution (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceSolutionRead(ctx, d, meta)
+}
+
+func resourceSolutionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PersonalizeConn
+
+	log.Printf("[DEBUG] Deleting Personalize Solution: %s", d.Id())
+	_, err := conn.DeleteSolutionWithContext(ctx, &personalize.DeleteSolutionInput{
+		SolutionArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, personalize.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Personalize Solution (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitSolutionDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Personalize Solution (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindSolutionByARN(ctx context.Context, conn *personalize.Personalize, arn string) (*personalize.Solution, error) {
+	input := &personalize.DescribeSolutionInput{
+		SolutionArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeSolutionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, personalize.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Solution == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Solution, nil
+}
+
+func statusSolution(ctx context.Context, conn *personalize.Personalize, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindSolutionByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitSolutionCreated(ctx context.Context, conn *personalize.Personalize, arn string, timeout time.Duration) (*personalize.Solution, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{statusCreatePending, statusCreateInProgress},
+		Target:  []string{statusActive},
+		Refresh: statusSolution(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*personalize.Solution); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSolutionDeleted(ctx context.Context, conn *personalize.Personalize, arn string, timeout time.Duration) (*personalize.Solution, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{statusActive, statusDeletePending, statusDeleteInProgress},
+		Target:  []string{},
+		Refresh: statusSolution(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*personalize.Solution); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandSolutionConfig(tfMap map[string]interface{}) *personalize.SolutionConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &personalize.SolutionConfig{}
+
+	if v, ok := tfMap["algorithm_hyper_parameters"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.AlgorithmHyperParameters = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["auto_ml_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		autoMLConfig := &personalize.AutoMLConfig{}
+
+		if v, ok := tfMap["metric_name"].(string); ok && v != "" {
+			autoMLConfig.MetricName = aws.String(v)
+		}
+
+		if v, ok := tfMap["recipe_list"].([]interface{}); ok && len(v) > 0 {
+			autoMLConfig.RecipeList = flex.ExpandStringList(v)
+		}
+
+		apiObject.AutoMLConfig = autoMLConfig
+	}
+
+	if v, ok := tfMap["event_value_threshold"].(string); ok && v != "" {
+		apiObject.EventValueThreshold = aws.String(v)
+	}
+
+	if v, ok := tfMap["feature_transformation_parameters"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.FeatureTransformationParameters = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["hpo_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.HpoConfig = expandHPOConfig(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["optimization_objective"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		optimizationObjective := &personalize.OptimizationObjective{}
+
+		if v, ok := tfMap["item_attribute"].(string); ok && v != "" {
+			optimizationObjective.ItemAttribute = aws.String(v)
+		}
+
+		if v, ok := tfMap["objective_sensitivity"].(string); ok && v != "" {
+			optimizationObjective.ObjectiveSensitivity = aws.String(v)
+		}
+
+		apiObject.OptimizationObjective = optimizationObjective
+	}
+
+	return apiObject
+}
+
+func expandHPOConfig(tfMap map[string]interface{}) *personalize.HPOConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &personalize.HPOConfig{}
+
+	if v, ok := tfMap["algorithm_hyper_parameter_ranges"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AlgorithmHyperParameterRanges = expandHyperParameterRanges(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["hpo_objective"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		hpoObjective := &personalize.HPOObjective{}
+
+		if v, ok := tfMap["metric_name"].(string); ok && v != "" {
+			hpoObjective.MetricName = aws.String(v)
+		}
+
+		if v, ok := tfMap["metric_regex"].(string); ok && v != "" {
+			hpoObjective.MetricRegex = aws.String(v)
+		}
+
+		if v, ok := tfMap["type"].(string); ok && v != "" {
+			hpoObjective.Type = aws.String(v)
+		}
+
+		apiObject.HpoObjective = hpoObjective
+	}
+
+	if v, ok := tfMap["hpo_resource_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		hpoResourceConfig := &personalize.HPOResourceConfig{}
+
+		if v, ok := tfMap["max_number_of_training_jobs"].(string); ok && v != "" {
+			hpoResourceConfig.MaxNumberOfTrainingJobs = aws.String(v)
+		}
+
+		if v, ok := tfMap["max_parallel_training_jobs"].(string); ok && v != "" {
+			hpoResourceConfig.MaxParallelTrainingJobs = aws.String(v)
+		}
+
+		apiObject.HpoResourceConfig = hpoResourceConfig
+	}
+
+	return apiObject
+}
+
+func expandHyperParameterRanges(tfMap map[string]interface{}) *personalize.HyperParameterRanges {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &personalize.HyperParameterRanges{}
+
+	if v, ok := tfMap["categorical_hyper_parameter_range"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.CategoricalHyperParameterRanges = append(apiObject.CategoricalHyperParameterRanges, &personalize.CategoricalHyperParameterRange{
+				Name:   aws.String(tfMap["name"].(string)),
+				Values: flex.ExpandStringList(tfMap["values"].([]interface{})),
+			})
+		}
+	}
+
+	if v, ok := tfMap["continuous_hyper_parameter_range"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.ContinuousHyperParameterRanges = append(apiObject.ContinuousHyperParameterRanges, &personalize.ContinuousHyperParameterRange{
+				MaxValue: aws.Float64(tfMap["max_value"].(float64)),
+				MinValue: aws.Float64(tfMap["min_value"].(float64)),
+				Name:     aws.String(tfMap["name"].(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["integer_hyper_parameter_range"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.IntegerHyperParameterRanges = append(apiObject.IntegerHyperParameterRanges, &personalize.IntegerHyperParameterRange{
+				MaxValue: aws.Int64(int64(tfMap["max_value"].(int))),
+				MinValue: aws.Int64(int64(tfMap["min_value"].(int))),
+				Name:     aws.String(tfMap["name"].(string)),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func flattenSolutionConfig(apiObject *personalize.SolutionConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"algorithm_hyper_parameters":        aws.StringValueMap(apiObject.AlgorithmHyperParameters),
+		"event_value_threshold":             aws.StringValue(apiObject.EventValueThreshold),
+		"feature_transformation_parameters": aws.StringValueMap(apiObject.FeatureTransformationParameters),
+	}
+
+	if v := apiObject.AutoMLConfig; v != nil {
+		tfMap["auto_ml_config"] = []interface{}{map[string]interface{}{
+			"metric_name": aws.StringValue(v.MetricName),
+			"recipe_list": aws.StringValueSlice(v.RecipeList),
+		}}
+	}
+
+	if v := apiObject.HpoConfig; v != nil {
+		tfMap["hpo_config"] = []interface{}{flattenHPOConfig(v)}
+	}
+
+	if v := apiObject.OptimizationObjective; v != nil {
+		tfMap["optimization_objective"] = []interface{}{map[string]interface{}{
+			"item_attribute":        aws.StringValue(v.ItemAttribute),
+			"objective_sensitivity": aws.StringValue(v.ObjectiveSensitivity),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenHPOConfig(apiObject *personalize.HPOConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AlgorithmHyperParameterRanges; v != nil {
+		tfMap["algorithm_hyper_parameter_ranges"] = []interface{}{flattenHyperParameterRanges(v)}
+	}
+
+	if v := apiObject.HpoObjective; v != nil {
+		tfMap["hpo_objective"] = []interface{}{map[string]interface{}{
+			"metric_name":  aws.StringValue(v.MetricName),
+			"metric_regex": aws.StringValue(v.MetricRegex),
+			"type":         aws.StringValue(v.Type),
+		}}
+	}
+
+	if v := apiObject.HpoResourceConfig; v != nil {
+		tfMap["hpo_resource_config"] = []interface{}{map[string]interface{}{
+			"max_number_of_training_jobs": aws.StringValue(v.MaxNumberOfTrainingJobs),
+			"max_parallel_training_jobs":  aws.StringValue(v.MaxParallelTrainingJobs),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenHyperParameterRanges(apiObject *personalize.HyperParameterRanges) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var categoricalRanges []interface{}
+	for _, v := range apiObject.CategoricalHyperParameterRanges {
+		if v == nil {
+			continue
+		}
+
+		categoricalRanges = append(categoricalRanges, map[string]interface{}{
+			"name":   aws.StringValue(v.Name),
+			"values": aws.StringValueSlice(v.Values),
+		})
+	}
+
+	var continuousRanges []interface{}
+	for _, v := range apiObject.ContinuousHyperParameterRanges {
+		if v == nil {
+			continue
+		}
+
+		continuousRanges = append(continuousRanges, map[string]interface{}{
+			"max_value": aws.Float64Value(v.MaxValue),
+			"min_value": aws.Float64Value(v.MinValue),
+			"name":      aws.StringValue(v.Name),
+		})
+	}
+
+	var integerRanges []interface{}
+	for _, v := range apiObject.IntegerHyperParameterRanges {
+		if v == nil {
+			continue
+		}
+
+		integerRanges = append(integerRanges, map[string]interface{}{
+			"max_value": aws.Int64Value(v.MaxValue),
+			"min_value": aws.Int64Value(v.MinValue),
+			"name":      aws.StringValue(v.Name),
+		})
+	}
+
+	return map[string]interface{}{
+		"categorical_hyper_parameter_range": categoricalRanges,
+		"continuous_hyper_parameter_range":  continuousRanges,
+		"integer_hyper_parameter_range":     integerRanges,
+	}
+}
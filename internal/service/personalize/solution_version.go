@@ -0,0 +1,277 @@
+package personalize
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/personalize"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceSolutionVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSolutionVersionCreate,
+		ReadWithoutTimeout:   resourceSolutionVersionRead,
+		UpdateWithoutTimeout: resourceSolutionVersionUpdate,
+		DeleteWithoutTimeout: resourceSolutionVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(6 * time.Hour),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"solution_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"training_hours": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"training_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      personalize.TrainingModeFull,
+				ValidateFunc: validation.StringInSlice(personalize.TrainingMode_Values(), false),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceSolutionVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PersonalizeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	solutionARN := d.Get("solution_arn").(string)
+	input := &personalize.CreateSolutionVersionInput{
+		SolutionArn:  aws.String(solutionARN),
+		TrainingMode: aws.String(d.Get("training_mode").(string)),
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		input.Name = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateSolutionVersionWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Personalize Solution Version (%s): %s", solutionARN, err)
+	}
+
+	d.SetId(aws.StringValue(output.SolutionVersionArn))
+
+	if _, err := waitSolutionVersionTrained(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Personalize Solution Version (%s) training: %s", d.Id(), err)
+	}
+
+	return resourceSolutionVersionRead(ctx, d, meta)
+}
+
+func resourceSolutionVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PersonalizeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	solutionVersion, err := FindSolutionVersionByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Personalize Solution Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Personalize Solution Version (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", solutionVersion.SolutionVersionArn)
+	d.Set("name", solutionVersion.Name)
+	d.Set("solution_arn", solutionVersion.SolutionArn)
+	d.Set("status", solutionVersion.Status)
+	d.Set("training_hours", solutionVersion.TrainingHours)
+	d.Set("training_mode", solutionVersion.TrainingMode)
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("listing tags for Personalize Solution Version (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceSolutionVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PersonalizeConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating Personalize Solution Version (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceSolutionVersionRead(ctx, d, meta)
+}
+
+func resourceSolutionVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PersonalizeConn
+
+	// Solution versions cannot be deleted; they are removed along with their solution.
+	// Training that is still in progress is stopped.
+	switch d.Get("status").(string) {
+	case statusCreatePending, statusCreateInProgress:
+		log.Printf("[DEBUG] Stopping Personalize Solution Version: %s", d.Id())
+		_, err := conn.StopSolutionVersionCreationWithContext(ctx, &personalize.StopSolutionVersionCreationInput{
+			SolutionVersionArn: aws.String(d.Id()),
+		})
+
+		if tfawserr.ErrCodeEquals(err, personalize.ErrCodeResourceNotFoundException) {
+			return nil
+		}
+
+		if err != nil {
+			return diag.Errorf("stopping Personalize Solution Version (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitSolutionVersionStopped(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return diag.Errorf("waiting for Personalize Solution Version (%s) stop: %s", d.Id(), err)
+		}
+	default:
+		log.Printf("[DEBUG] Personalize Solution Version (%s) cannot be deleted, removing from state", d.Id())
+	}
+
+	return nil
+}
+
+func FindSolutionVersionByARN(ctx context.Context, conn *personalize.Personalize, arn string) (*personalize.SolutionVersion, error) {
+	input := &personalize.DescribeSolutionVersionInput{
+		SolutionVersionArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeSolutionVersionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, personalize.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.SolutionVersion == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.SolutionVersion, nil
+}
+
+func statusSolutionVersion(ctx context.Context, conn *personalize.Personalize, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindSolutionVersionByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitSolutionVersionTrained(ctx context.Context, conn *personalize.Personalize, arn string, timeout time.Duration) (*personalize.SolutionVersion, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{statusCreatePending, statusCreateInProgress},
+		Target:       []string{statusActive},
+		Refresh:      statusSolutionVersion(ctx, conn, arn),
+		Timeout:      timeout,
+		PollInterval: 1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*personalize.SolutionVersion); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSolutionVersionStopped(ctx context.Context, conn *personalize.Personalize, arn string, timeout time.Duration) (*personalize.SolutionVersion, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{statusCreatePending, statusCreateInProgress, statusCreateStopping},
+		Target:  []string{statusCreateStopped, statusCreateFailed, statusActive},
+		Refresh: statusSolutionVersion(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*personalize.SolutionVersion); ok {
+		return output, err
+	}
+
+	return nil, err
+}
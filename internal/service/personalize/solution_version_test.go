@@ -0,0 +1,96 @@
+package personalize_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/personalize"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	tfpersonalize "github.com/hashicorp/terraform-provider-aws/internal/service/personalize"
+)
+
+const (
+	envVarDatasetGroupARN             = "AWS_PERSONALIZE_DATASET_GROUP_ARN"
+	envVarDatasetGroupARNMessageError = "Environment variable AWS_PERSONALIZE_DATASET_GROUP_ARN is not set. " +
+		"To train a model, the ARN of a Personalize dataset group with imported interactions data must be provided."
+)
+
+func TestAccPersonalizeSolutionVersion_basic(t *testing.T) {
+	datasetGroupARN := envvar.SkipIfEmpty(t, envVarDatasetGroupARN, envVarDatasetGroupARNMessageError)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_personalize_solution_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(personalize.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, personalize.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		// Solution versions can't be deleted and are removed along with their solution.
+		CheckDestroy: testAccCheckSolutionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSolutionVersionConfig_basic(rName, datasetGroupARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSolutionVersionExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "personalize", regexp.MustCompile(fmt.Sprintf(`solution/%s/.+`, rName))),
+					resource.TestCheckResourceAttrPair(resourceName, "solution_arn", "aws_personalize_solution.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "training_hours"),
+					resource.TestCheckResourceAttr(resourceName, "training_mode", personalize.TrainingModeFull),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSolutionVersionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Personalize Solution Version ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PersonalizeConn
+
+		_, err := tfpersonalize.FindSolutionVersionByARN(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccSolutionVersionConfig_base(rName, datasetGroupARN string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_personalize_solution" "test" {
+  dataset_group_arn = %[2]q
+  name              = %[1]q
+  recipe_arn        = "arn:${data.aws_partition.current.partition}:personalize:::recipe/aws-user-personalization"
+}
+`, rName, datasetGroupARN)
+}
+
+func testAccSolutionVersionConfig_basic(rName, datasetGroupARN string) string {
+	return acctest.ConfigCompose(testAccSolutionVersionConfig_base(rName, datasetGroupARN), `
+resource "aws_personalize_solution_version" "test" {
+  solution_arn = aws_personalize_solution.test.arn
+}
+`)
+}
@@ -0,0 +1,12 @@
+package personalize
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var validName = validation.All(
+	validation.StringLenBetween(1, 63),
+	validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9\-_]*$`), "must begin with an alphanumeric character and contain only alphanumeric characters, hyphens (-) and underscores (_)"),
+)
@@ -0,0 +1,323 @@
+package proton
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/proton"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceEnvironment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEnvironmentCreate,
+		ReadWithoutTimeout:   resourceEnvironmentRead,
+		UpdateWithoutTimeout: resourceEnvironmentUpdate,
+		DeleteWithoutTimeout: resourceEnvironmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"codebuild_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"component_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"deployment_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deployment_status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 500),
+			},
+			"environment_account_connection_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"environment_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validTemplateName,
+			},
+			"proton_service_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"provisioning": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"spec": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     verify.ValidStringIsJSONOrYAML,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONOrYAMLDiffs,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"template_major_version": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"template_minor_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"template_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validTemplateName,
+			},
+		},
+	}
+}
+
+const (
+	ResNameEnvironment = "Environment"
+)
+
+func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ProtonConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &proton.CreateEnvironmentInput{
+		Name:                 aws.String(name),
+		Spec:                 aws.String(d.Get("spec").(string)),
+		TemplateMajorVersion: aws.String(d.Get("template_major_version").(string)),
+		TemplateName:         aws.String(d.Get("template_name").(string)),
+	}
+
+	if v, ok := d.GetOk("codebuild_role_arn"); ok {
+		input.CodebuildRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("component_role_arn"); ok {
+		input.ComponentRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("environment_account_connection_id"); ok {
+		input.EnvironmentAccountConnectionId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("proton_service_role_arn"); ok {
+		input.ProtonServiceRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("template_minor_version"); ok {
+		input.TemplateMinorVersion = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	_, err := conn.CreateEnvironmentWithContext(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.Proton, create.ErrActionCreating, ResNameEnvironment, name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitEnvironmentDeployed(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.Proton, create.ErrActionWaitingForCreation, ResNameEnvironment, d.Id(), err)
+	}
+
+	return resourceEnvironmentRead(ctx, d, meta)
+}
+
+func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ProtonConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	environment, err := FindEnvironmentByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Proton Environment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Proton, create.ErrActionReading, ResNameEnvironment, d.Id(), err)
+	}
+
+	arn := aws.StringValue(environment.Arn)
+	d.Set("arn", arn)
+	d.Set("codebuild_role_arn", environment.CodebuildRoleArn)
+	d.Set("component_role_arn", environment.ComponentRoleArn)
+	d.Set("deployment_status", environment.DeploymentStatus)
+	d.Set("deployment_status_message", environment.DeploymentStatusMessage)
+	d.Set("description", environment.Description)
+	d.Set("environment_account_connection_id", environment.EnvironmentAccountConnectionId)
+	d.Set("environment_account_id", environment.EnvironmentAccountId)
+	d.Set("name", environment.Name)
+	d.Set("proton_service_role_arn", environment.ProtonServiceRoleArn)
+	d.Set("provisioning", environment.Provisioning)
+	d.Set("spec", environment.Spec)
+	d.Set("template_major_version", environment.TemplateMajorVersion)
+	d.Set("template_minor_version", environment.TemplateMinorVersion)
+	d.Set("template_name", environment.TemplateName)
+
+	tags, err := ListTagsWithContext(ctx, conn, arn)
+
+	if err != nil {
+		return create.DiagError(names.Proton, create.ErrActionReading, ResNameEnvironment, d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.Proton, create.ErrActionSetting, ResNameEnvironment, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.Proton, create.ErrActionSetting, ResNameEnvironment, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ProtonConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &proton.UpdateEnvironmentInput{
+			Description: aws.String(d.Get("description").(string)),
+			Name:        aws.String(d.Id()),
+		}
+
+		// The deployment type determines which of the template version and spec are redeployed.
+		switch {
+		case d.HasChange("template_major_version"):
+			input.DeploymentType = aws.String(proton.DeploymentUpdateTypeMajorVersion)
+			input.Spec = aws.String(d.Get("spec").(string))
+			input.TemplateMajorVersion = aws.String(d.Get("template_major_version").(string))
+
+			if v, ok := d.GetOk("template_minor_version"); ok && d.HasChange("template_minor_version") {
+				input.TemplateMinorVersion = aws.String(v.(string))
+			}
+		case d.HasChange("template_minor_version"):
+			input.DeploymentType = aws.String(proton.DeploymentUpdateTypeMinorVersion)
+			input.Spec = aws.String(d.Get("spec").(string))
+			input.TemplateMajorVersion = aws.String(d.Get("template_major_version").(string))
+			input.TemplateMinorVersion = aws.String(d.Get("template_minor_version").(string))
+		case d.HasChange("spec"):
+			input.DeploymentType = aws.String(proton.DeploymentUpdateTypeCurrentVersion)
+			input.Spec = aws.String(d.Get("spec").(string))
+		default:
+			input.DeploymentType = aws.String(proton.DeploymentUpdateTypeNone)
+		}
+
+		if v, ok := d.GetOk("codebuild_role_arn"); ok {
+			input.CodebuildRoleArn = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("component_role_arn"); ok {
+			input.ComponentRoleArn = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("environment_account_connection_id"); ok {
+			input.EnvironmentAccountConnectionId = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("proton_service_role_arn"); ok {
+			input.ProtonServiceRoleArn = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateEnvironmentWithContext(ctx, input)
+
+		if err != nil {
+			return create.DiagError(names.Proton, create.ErrActionUpdating, ResNameEnvironment, d.Id(), err)
+		}
+
+		if _, err := waitEnvironmentDeployed(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return create.DiagError(names.Proton, create.ErrActionWaitingForUpdate, ResNameEnvironment, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return create.DiagError(names.Proton, create.ErrActionUpdating, ResNameEnvironment, d.Id(), err)
+		}
+	}
+
+	return resourceEnvironmentRead(ctx, d, meta)
+}
+
+func resourceEnvironmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ProtonConn
+
+	log.Printf("[INFO] Deleting Proton Environment: %s", d.Id())
+	_, err := conn.DeleteEnvironmentWithContext(ctx, &proton.DeleteEnvironmentInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, proton.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Proton, create.ErrActionDeleting, ResNameEnvironment, d.Id(), err)
+	}
+
+	if _, err := waitEnvironmentDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.DiagError(names.Proton, create.ErrActionWaitingForDeletion, ResNameEnvironment, d.Id(), err)
+	}
+
+	return nil
+}
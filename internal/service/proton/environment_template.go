@@ -0,0 +1,222 @@
+package proton
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/proton"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceEnvironmentTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEnvironmentTemplateCreate,
+		ReadWithoutTimeout:   resourceEnvironmentTemplateRead,
+		UpdateWithoutTimeout: resourceEnvironmentTemplateUpdate,
+		DeleteWithoutTimeout: resourceEnvironmentTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 500),
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"encryption_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validTemplateName,
+			},
+			"provisioning": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(proton.Provisioning_Values(), false),
+			},
+			"recommended_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+const (
+	ResNameEnvironmentTemplate = "Environment Template"
+)
+
+var validTemplateName = validation.All(
+	validation.StringLenBetween(1, 100),
+	validation.StringMatch(regexp.MustCompile(`^[0-9A-Za-z]+[0-9A-Za-z_\-]*$`), "must begin with an alphanumeric character and contain only alphanumeric characters, underscores and hyphens"),
+)
+
+func resourceEnvironmentTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ProtonConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &proton.CreateEnvironmentTemplateInput{
+		Name: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		input.DisplayName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("encryption_key"); ok {
+		input.EncryptionKey = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("provisioning"); ok {
+		input.Provisioning = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	_, err := conn.CreateEnvironmentTemplateWithContext(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.Proton, create.ErrActionCreating, ResNameEnvironmentTemplate, name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceEnvironmentTemplateRead(ctx, d, meta)
+}
+
+func resourceEnvironmentTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ProtonConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	template, err := FindEnvironmentTemplateByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Proton Environment Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Proton, create.ErrActionReading, ResNameEnvironmentTemplate, d.Id(), err)
+	}
+
+	arn := aws.StringValue(template.Arn)
+	d.Set("arn", arn)
+	d.Set("description", template.Description)
+	d.Set("display_name", template.DisplayName)
+	d.Set("encryption_key", template.EncryptionKey)
+	d.Set("name", template.Name)
+	d.Set("provisioning", template.Provisioning)
+	d.Set("recommended_version", template.RecommendedVersion)
+
+	tags, err := ListTagsWithContext(ctx, conn, arn)
+
+	if err != nil {
+		return create.DiagError(names.Proton, create.ErrActionReading, ResNameEnvironmentTemplate, d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.Proton, create.ErrActionSetting, ResNameEnvironmentTemplate, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.Proton, create.ErrActionSetting, ResNameEnvironmentTemplate, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceEnvironmentTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ProtonConn
+
+	if d.HasChanges("description", "display_name") {
+		input := &proton.UpdateEnvironmentTemplateInput{
+			Description: aws.String(d.Get("description").(string)),
+			Name:        aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("display_name"); ok {
+			input.DisplayName = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateEnvironmentTemplateWithContext(ctx, input)
+
+		if err != nil {
+			return create.DiagError(names.Proton, create.ErrActionUpdating, ResNameEnvironmentTemplate, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return create.DiagError(names.Proton, create.ErrActionUpdating, ResNameEnvironmentTemplate, d.Id(), err)
+		}
+	}
+
+	return resourceEnvironmentTemplateRead(ctx, d, meta)
+}
+
+func resourceEnvironmentTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ProtonConn
+
+	log.Printf("[INFO] Deleting Proton Environment Template: %s", d.Id())
+	_, err := conn.DeleteEnvironmentTemplateWithContext(ctx, &proton.DeleteEnvironmentTemplateInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, proton.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Proton, create.ErrActionDeleting, ResNameEnvironmentTemplate, d.Id(), err)
+	}
+
+	return nil
+}
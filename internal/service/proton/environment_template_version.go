@@ -0,0 +1,344 @@
+package proton
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/proton"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceEnvironmentTemplateVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEnvironmentTemplateVersionCreate,
+		ReadWithoutTimeout:   resourceEnvironmentTemplateVersionRead,
+		UpdateWithoutTimeout: resourceEnvironmentTemplateVersionUpdate,
+		DeleteWithoutTimeout: resourceEnvironmentTemplateVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 500),
+			},
+			"major_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"minor_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"recommended_minor_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"schema": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source": templateVersionSourceSchema(),
+			"status": templateVersionStatusSchema(),
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"template_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validTemplateName,
+			},
+		},
+	}
+}
+
+const (
+	ResNameEnvironmentTemplateVersion = "Environment Template Version"
+)
+
+func templateVersionSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"s3": {
+					Type:     schema.TypeList,
+					Required: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"bucket": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringLenBetween(3, 63),
+							},
+							"key": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringLenBetween(1, 1024),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func templateVersionStatusSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.StringInSlice([]string{proton.TemplateVersionStatusDraft, proton.TemplateVersionStatusPublished}, false),
+	}
+}
+
+func resourceEnvironmentTemplateVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ProtonConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	templateName := d.Get("template_name").(string)
+	input := &proton.CreateEnvironmentTemplateVersionInput{
+		Source:       expandTemplateVersionSourceInput(d.Get("source").([]interface{})),
+		TemplateName: aws.String(templateName),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("major_version"); ok {
+		input.MajorVersion = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateEnvironmentTemplateVersionWithContext(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.Proton, create.ErrActionCreating, ResNameEnvironmentTemplateVersion, templateName, err)
+	}
+
+	majorVersion := aws.StringValue(output.EnvironmentTemplateVersion.MajorVersion)
+	minorVersion := aws.StringValue(output.EnvironmentTemplateVersion.MinorVersion)
+	d.SetId(TemplateVersionCreateResourceID(templateName, majorVersion, minorVersion))
+
+	if _, err := waitEnvironmentTemplateVersionRegistered(ctx, conn, templateName, majorVersion, minorVersion, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.Proton, create.ErrActionWaitingForCreation, ResNameEnvironmentTemplateVersion, d.Id(), err)
+	}
+
+	if v, ok := d.GetOk("status"); ok && v.(string) == proton.TemplateVersionStatusPublished {
+		_, err := conn.UpdateEnvironmentTemplateVersionWithContext(ctx, &proton.UpdateEnvironmentTemplateVersionInput{
+			MajorVersion: aws.String(majorVersion),
+			MinorVersion: aws.String(minorVersion),
+			Status:       aws.String(proton.TemplateVersionStatusPublished),
+			TemplateName: aws.String(templateName),
+		})
+
+		if err != nil {
+			return create.DiagError(names.Proton, create.ErrActionCreating, ResNameEnvironmentTemplateVersion, d.Id(), fmt.Errorf("publishing: %w", err))
+		}
+	}
+
+	return resourceEnvironmentTemplateVersionRead(ctx, d, meta)
+}
+
+func resourceEnvironmentTemplateVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ProtonConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	templateName, majorVersion, minorVersion, err := TemplateVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return create.DiagError(names.Proton, create.ErrActionReading, ResNameEnvironmentTemplateVersion, d.Id(), err)
+	}
+
+	version, err := FindEnvironmentTemplateVersionByThreePartKey(ctx, conn, templateName, majorVersion, minorVersion)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Proton Environment Template Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Proton, create.ErrActionReading, ResNameEnvironmentTemplateVersion, d.Id(), err)
+	}
+
+	arn := aws.StringValue(version.Arn)
+	d.Set("arn", arn)
+	d.Set("description", version.Description)
+	d.Set("major_version", version.MajorVersion)
+	d.Set("minor_version", version.MinorVersion)
+	d.Set("recommended_minor_version", version.RecommendedMinorVersion)
+	d.Set("schema", version.Schema)
+	d.Set("status", version.Status)
+	d.Set("status_message", version.StatusMessage)
+	d.Set("template_name", version.TemplateName)
+
+	tags, err := ListTagsWithContext(ctx, conn, arn)
+
+	if err != nil {
+		return create.DiagError(names.Proton, create.ErrActionReading, ResNameEnvironmentTemplateVersion, d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.Proton, create.ErrActionSetting, ResNameEnvironmentTemplateVersion, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.Proton, create.ErrActionSetting, ResNameEnvironmentTemplateVersion, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceEnvironmentTemplateVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ProtonConn
+
+	if d.HasChanges("description", "status") {
+		templateName, majorVersion, minorVersion, err := TemplateVersionParseResourceID(d.Id())
+
+		if err != nil {
+			return create.DiagError(names.Proton, create.ErrActionUpdating, ResNameEnvironmentTemplateVersion, d.Id(), err)
+		}
+
+		input := &proton.UpdateEnvironmentTemplateVersionInput{
+			Description:  aws.String(d.Get("description").(string)),
+			MajorVersion: aws.String(majorVersion),
+			MinorVersion: aws.String(minorVersion),
+			TemplateName: aws.String(templateName),
+		}
+
+		if d.HasChange("status") {
+			input.Status = aws.String(d.Get("status").(string))
+		}
+
+		_, err = conn.UpdateEnvironmentTemplateVersionWithContext(ctx, input)
+
+		if err != nil {
+			return create.DiagError(names.Proton, create.ErrActionUpdating, ResNameEnvironmentTemplateVersion, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return create.DiagError(names.Proton, create.ErrActionUpdating, ResNameEnvironmentTemplateVersion, d.Id(), err)
+		}
+	}
+
+	return resourceEnvironmentTemplateVersionRead(ctx, d, meta)
+}
+
+func resourceEnvironmentTemplateVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ProtonConn
+
+	templateName, majorVersion, minorVersion, err := TemplateVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return create.DiagError(names.Proton, create.ErrActionDeleting, ResNameEnvironmentTemplateVersion, d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting Proton Environment Template Version: %s", d.Id())
+	_, err = conn.DeleteEnvironmentTemplateVersionWithContext(ctx, &proton.DeleteEnvironmentTemplateVersionInput{
+		MajorVersion: aws.String(majorVersion),
+		MinorVersion: aws.String(minorVersion),
+		TemplateName: aws.String(templateName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, proton.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Proton, create.ErrActionDeleting, ResNameEnvironmentTemplateVersion, d.Id(), err)
+	}
+
+	return nil
+}
+
+const templateVersionIDSeparator = ":"
+
+func TemplateVersionCreateResourceID(templateName, majorVersion, minorVersion string) string {
+	parts := []string{templateName, majorVersion, minorVersion}
+	id := strings.Join(parts, templateVersionIDSeparator)
+
+	return id
+}
+
+func TemplateVersionParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, templateVersionIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected TEMPLATE-NAME%[2]sMAJOR-VERSION%[2]sMINOR-VERSION", id, templateVersionIDSeparator)
+}
+
+func expandTemplateVersionSourceInput(tfList []interface{}) *proton.TemplateVersionSourceInput {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &proton.TemplateVersionSourceInput{}
+
+	if v, ok := tfMap["s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		s3Map := v[0].(map[string]interface{})
+		apiObject.S3 = &proton.S3ObjectSource{
+			Bucket: aws.String(s3Map["bucket"].(string)),
+			Key:    aws.String(s3Map["key"].(string)),
+		}
+	}
+
+	return apiObject
+}
@@ -0,0 +1,229 @@
+package proton_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/proton"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfproton "github.com/hashicorp/terraform-provider-aws/internal/service/proton"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccProtonEnvironmentTemplateVersion_basic(t *testing.T) {
+	var v proton.EnvironmentTemplateVersion
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_proton_environment_template_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, proton.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentTemplateVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentTemplateVersionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentTemplateVersionExists(resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "proton", fmt.Sprintf("environment-template/%s:1.0", rName)),
+					resource.TestCheckResourceAttr(resourceName, "major_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "minor_version", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "schema"),
+					resource.TestCheckResourceAttr(resourceName, "source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.s3.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "source.0.s3.0.bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttrPair(resourceName, "source.0.s3.0.key", "aws_s3_object.test", "key"),
+					resource.TestCheckResourceAttr(resourceName, "status", "DRAFT"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "template_name", "aws_proton_environment_template.test", "name"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source"},
+			},
+		},
+	})
+}
+
+func TestAccProtonEnvironmentTemplateVersion_disappears(t *testing.T) {
+	var v proton.EnvironmentTemplateVersion
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_proton_environment_template_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, proton.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentTemplateVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentTemplateVersionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentTemplateVersionExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfproton.ResourceEnvironmentTemplateVersion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccProtonEnvironmentTemplateVersion_publish(t *testing.T) {
+	var v proton.EnvironmentTemplateVersion
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_proton_environment_template_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, proton.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentTemplateVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentTemplateVersionConfig_status(rName, "DRAFT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentTemplateVersionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "status", "DRAFT"),
+				),
+			},
+			{
+				Config: testAccEnvironmentTemplateVersionConfig_status(rName, "PUBLISHED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentTemplateVersionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "status", "PUBLISHED"),
+					resource.TestCheckResourceAttr(resourceName, "recommended_minor_version", "0"),
+					resource.TestCheckResourceAttr("aws_proton_environment_template.test", "recommended_version", "1.0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source"},
+			},
+		},
+	})
+}
+
+func testAccCheckEnvironmentTemplateVersionExists(n string, v *proton.EnvironmentTemplateVersion) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Proton Environment Template Version ID is set")
+		}
+
+		templateName, majorVersion, minorVersion, err := tfproton.TemplateVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ProtonConn
+
+		output, err := tfproton.FindEnvironmentTemplateVersionByThreePartKey(context.Background(), conn, templateName, majorVersion, minorVersion)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckEnvironmentTemplateVersionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ProtonConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_proton_environment_template_version" {
+			continue
+		}
+
+		templateName, majorVersion, minorVersion, err := tfproton.TemplateVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfproton.FindEnvironmentTemplateVersionByThreePartKey(context.Background(), conn, templateName, majorVersion, minorVersion)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Proton Environment Template Version %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccTemplateBundleConfig(rName, fixture string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = "template.tar.gz"
+  source = "test-fixtures/%[2]s"
+}
+`, rName, fixture)
+}
+
+func testAccEnvironmentTemplateVersionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccTemplateBundleConfig(rName, "environment-template.tar.gz"), fmt.Sprintf(`
+resource "aws_proton_environment_template" "test" {
+  name = %[1]q
+}
+
+resource "aws_proton_environment_template_version" "test" {
+  template_name = aws_proton_environment_template.test.name
+
+  source {
+    s3 {
+      bucket = aws_s3_object.test.bucket
+      key    = aws_s3_object.test.key
+    }
+  }
+}
+`, rName))
+}
+
+func testAccEnvironmentTemplateVersionConfig_status(rName, status string) string {
+	return acctest.ConfigCompose(testAccTemplateBundleConfig(rName, "environment-template.tar.gz"), fmt.Sprintf(`
+resource "aws_proton_environment_template" "test" {
+  name = %[1]q
+}
+
+resource "aws_proton_environment_template_version" "test" {
+  template_name = aws_proton_environment_template.test.name
+  status        = %[2]q
+
+  source {
+    s3 {
+      bucket = aws_s3_object.test.bucket
+      key    = aws_s3_object.test.key
+    }
+  }
+}
+`, rName, status))
+}
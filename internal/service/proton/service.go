@@ -0,0 +1,350 @@
+package proton
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/proton"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceService() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceServiceCreate,
+		ReadWithoutTimeout:   resourceServiceRead,
+		UpdateWithoutTimeout: resourceServiceUpdate,
+		DeleteWithoutTimeout: resourceServiceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"branch_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 200),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 500),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validTemplateName,
+			},
+			"pipeline": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"deployment_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"deployment_status_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"template_major_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"template_minor_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"template_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"repository_connection_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+				RequiredWith: []string{"branch_name", "repository_id"},
+			},
+			"repository_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 200),
+			},
+			"spec": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     verify.ValidStringIsJSONOrYAML,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONOrYAMLDiffs,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			// The service's template version is not returned by GetService,
+			// so changes to it can only be made by replacing the service.
+			"template_major_version": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"template_minor_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"template_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validTemplateName,
+			},
+		},
+	}
+}
+
+const (
+	ResNameService = "Service"
+)
+
+func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ProtonConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &proton.CreateServiceInput{
+		Name:                 aws.String(name),
+		Spec:                 aws.String(d.Get("spec").(string)),
+		TemplateMajorVersion: aws.String(d.Get("template_major_version").(string)),
+		TemplateName:         aws.String(d.Get("template_name").(string)),
+	}
+
+	if v, ok := d.GetOk("branch_name"); ok {
+		input.BranchName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("repository_connection_arn"); ok {
+		input.RepositoryConnectionArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("repository_id"); ok {
+		input.RepositoryId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("template_minor_version"); ok {
+		input.TemplateMinorVersion = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	_, err := conn.CreateServiceWithContext(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.Proton, create.ErrActionCreating, ResNameService, name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitServiceCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.Proton, create.ErrActionWaitingForCreation, ResNameService, d.Id(), err)
+	}
+
+	return resourceServiceRead(ctx, d, meta)
+}
+
+func resourceServiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ProtonConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	service, err := FindServiceByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Proton Service (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Proton, create.ErrActionReading, ResNameService, d.Id(), err)
+	}
+
+	arn := aws.StringValue(service.Arn)
+	d.Set("arn", arn)
+	d.Set("branch_name", service.BranchName)
+	d.Set("description", service.Description)
+	d.Set("name", service.Name)
+
+	if service.Pipeline != nil {
+		if err := d.Set("pipeline", []interface{}{flattenServicePipeline(service.Pipeline)}); err != nil {
+			return create.DiagError(names.Proton, create.ErrActionSetting, ResNameService, d.Id(), err)
+		}
+	} else {
+		d.Set("pipeline", nil)
+	}
+
+	d.Set("repository_connection_arn", service.RepositoryConnectionArn)
+	d.Set("repository_id", service.RepositoryId)
+	d.Set("spec", service.Spec)
+	d.Set("status", service.Status)
+	d.Set("status_message", service.StatusMessage)
+	d.Set("template_name", service.TemplateName)
+
+	tags, err := ListTagsWithContext(ctx, conn, arn)
+
+	if err != nil {
+		return create.DiagError(names.Proton, create.ErrActionReading, ResNameService, d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.Proton, create.ErrActionSetting, ResNameService, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.Proton, create.ErrActionSetting, ResNameService, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ProtonConn
+
+	if d.HasChanges("description", "spec") {
+		input := &proton.UpdateServiceInput{
+			Description: aws.String(d.Get("description").(string)),
+			Name:        aws.String(d.Id()),
+		}
+
+		if d.HasChange("spec") {
+			input.Spec = aws.String(d.Get("spec").(string))
+		}
+
+		_, err := conn.UpdateServiceWithContext(ctx, input)
+
+		if err != nil {
+			return create.DiagError(names.Proton, create.ErrActionUpdating, ResNameService, d.Id(), err)
+		}
+
+		if _, err := waitServiceUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return create.DiagError(names.Proton, create.ErrActionWaitingForUpdate, ResNameService, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return create.DiagError(names.Proton, create.ErrActionUpdating, ResNameService, d.Id(), err)
+		}
+	}
+
+	return resourceServiceRead(ctx, d, meta)
+}
+
+func resourceServiceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ProtonConn
+
+	log.Printf("[INFO] Deleting Proton Service: %s", d.Id())
+	_, err := conn.DeleteServiceWithContext(ctx, &proton.DeleteServiceInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, proton.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Proton, create.ErrActionDeleting, ResNameService, d.Id(), err)
+	}
+
+	if _, err := waitServiceDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.DiagError(names.Proton, create.ErrActionWaitingForDeletion, ResNameService, d.Id(), err)
+	}
+
+	return nil
+}
+
+func flattenServicePipeline(apiObject *proton.ServicePipeline) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Arn; v != nil {
+		tfMap["arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.DeploymentStatus; v != nil {
+		tfMap["deployment_status"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.DeploymentStatusMessage; v != nil {
+		tfMap["deployment_status_message"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TemplateMajorVersion; v != nil {
+		tfMap["template_major_version"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TemplateMinorVersion; v != nil {
+		tfMap["template_minor_version"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TemplateName; v != nil {
+		tfMap["template_name"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
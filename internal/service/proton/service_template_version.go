@@ -0,0 +1,349 @@
+package proton
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/proton"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceServiceTemplateVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceServiceTemplateVersionCreate,
+		ReadWithoutTimeout:   resourceServiceTemplateVersionRead,
+		UpdateWithoutTimeout: resourceServiceTemplateVersionUpdate,
+		DeleteWithoutTimeout: resourceServiceTemplateVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"compatible_environment_template": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"major_version": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"template_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validTemplateName,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 500),
+			},
+			"major_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"minor_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"recommended_minor_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"schema": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source": templateVersionSourceSchema(),
+			"status": templateVersionStatusSchema(),
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"supported_component_sources": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(proton.ServiceTemplateSupportedComponentSourceType_Values(), false),
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"template_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validTemplateName,
+			},
+		},
+	}
+}
+
+const (
+	ResNameServiceTemplateVersion = "Service Template Version"
+)
+
+func resourceServiceTemplateVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ProtonConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	templateName := d.Get("template_name").(string)
+	input := &proton.CreateServiceTemplateVersionInput{
+		CompatibleEnvironmentTemplates: expandCompatibleEnvironmentTemplateInputs(d.Get("compatible_environment_template").(*schema.Set).List()),
+		Source:                         expandTemplateVersionSourceInput(d.Get("source").([]interface{})),
+		TemplateName:                   aws.String(templateName),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("major_version"); ok {
+		input.MajorVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("supported_component_sources"); ok && v.(*schema.Set).Len() > 0 {
+		input.SupportedComponentSources = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateServiceTemplateVersionWithContext(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.Proton, create.ErrActionCreating, ResNameServiceTemplateVersion, templateName, err)
+	}
+
+	majorVersion := aws.StringValue(output.ServiceTemplateVersion.MajorVersion)
+	minorVersion := aws.StringValue(output.ServiceTemplateVersion.MinorVersion)
+	d.SetId(TemplateVersionCreateResourceID(templateName, majorVersion, minorVersion))
+
+	if _, err := waitServiceTemplateVersionRegistered(ctx, conn, templateName, majorVersion, minorVersion, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.Proton, create.ErrActionWaitingForCreation, ResNameServiceTemplateVersion, d.Id(), err)
+	}
+
+	if v, ok := d.GetOk("status"); ok && v.(string) == proton.TemplateVersionStatusPublished {
+		_, err := conn.UpdateServiceTemplateVersionWithContext(ctx, &proton.UpdateServiceTemplateVersionInput{
+			MajorVersion: aws.String(majorVersion),
+			MinorVersion: aws.String(minorVersion),
+			Status:       aws.String(proton.TemplateVersionStatusPublished),
+			TemplateName: aws.String(templateName),
+		})
+
+		if err != nil {
+			return create.DiagError(names.Proton, create.ErrActionCreating, ResNameServiceTemplateVersion, d.Id(), fmt.Errorf("publishing: %w", err))
+		}
+	}
+
+	return resourceServiceTemplateVersionRead(ctx, d, meta)
+}
+
+func resourceServiceTemplateVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ProtonConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	templateName, majorVersion, minorVersion, err := TemplateVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return create.DiagError(names.Proton, create.ErrActionReading, ResNameServiceTemplateVersion, d.Id(), err)
+	}
+
+	version, err := FindServiceTemplateVersionByThreePartKey(ctx, conn, templateName, majorVersion, minorVersion)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Proton Service Template Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Proton, create.ErrActionReading, ResNameServiceTemplateVersion, d.Id(), err)
+	}
+
+	arn := aws.StringValue(version.Arn)
+	d.Set("arn", arn)
+	if err := d.Set("compatible_environment_template", flattenCompatibleEnvironmentTemplates(version.CompatibleEnvironmentTemplates)); err != nil {
+		return create.DiagError(names.Proton, create.ErrActionSetting, ResNameServiceTemplateVersion, d.Id(), err)
+	}
+	d.Set("description", version.Description)
+	d.Set("major_version", version.MajorVersion)
+	d.Set("minor_version", version.MinorVersion)
+	d.Set("recommended_minor_version", version.RecommendedMinorVersion)
+	d.Set("schema", version.Schema)
+	d.Set("status", version.Status)
+	d.Set("status_message", version.StatusMessage)
+	d.Set("supported_component_sources", aws.StringValueSlice(version.SupportedComponentSources))
+	d.Set("template_name", version.TemplateName)
+
+	tags, err := ListTagsWithContext(ctx, conn, arn)
+
+	if err != nil {
+		return create.DiagError(names.Proton, create.ErrActionReading, ResNameServiceTemplateVersion, d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.Proton, create.ErrActionSetting, ResNameServiceTemplateVersion, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.Proton, create.ErrActionSetting, ResNameServiceTemplateVersion, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceServiceTemplateVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ProtonConn
+
+	if d.HasChanges("compatible_environment_template", "description", "status", "supported_component_sources") {
+		templateName, majorVersion, minorVersion, err := TemplateVersionParseResourceID(d.Id())
+
+		if err != nil {
+			return create.DiagError(names.Proton, create.ErrActionUpdating, ResNameServiceTemplateVersion, d.Id(), err)
+		}
+
+		input := &proton.UpdateServiceTemplateVersionInput{
+			Description:  aws.String(d.Get("description").(string)),
+			MajorVersion: aws.String(majorVersion),
+			MinorVersion: aws.String(minorVersion),
+			TemplateName: aws.String(templateName),
+		}
+
+		if d.HasChange("compatible_environment_template") {
+			input.CompatibleEnvironmentTemplates = expandCompatibleEnvironmentTemplateInputs(d.Get("compatible_environment_template").(*schema.Set).List())
+		}
+
+		if d.HasChange("status") {
+			input.Status = aws.String(d.Get("status").(string))
+		}
+
+		if d.HasChange("supported_component_sources") {
+			input.SupportedComponentSources = flex.ExpandStringSet(d.Get("supported_component_sources").(*schema.Set))
+		}
+
+		_, err = conn.UpdateServiceTemplateVersionWithContext(ctx, input)
+
+		if err != nil {
+			return create.DiagError(names.Proton, create.ErrActionUpdating, ResNameServiceTemplateVersion, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return create.DiagError(names.Proton, create.ErrActionUpdating, ResNameServiceTemplateVersion, d.Id(), err)
+		}
+	}
+
+	return resourceServiceTemplateVersionRead(ctx, d, meta)
+}
+
+func resourceServiceTemplateVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ProtonConn
+
+	templateName, majorVersion, minorVersion, err := TemplateVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return create.DiagError(names.Proton, create.ErrActionDeleting, ResNameServiceTemplateVersion, d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting Proton Service Template Version: %s", d.Id())
+	_, err = conn.DeleteServiceTemplateVersionWithContext(ctx, &proton.DeleteServiceTemplateVersionInput{
+		MajorVersion: aws.String(majorVersion),
+		MinorVersion: aws.String(minorVersion),
+		TemplateName: aws.String(templateName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, proton.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Proton, create.ErrActionDeleting, ResNameServiceTemplateVersion, d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCompatibleEnvironmentTemplateInputs(tfList []interface{}) []*proton.CompatibleEnvironmentTemplateInput {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*proton.CompatibleEnvironmentTemplateInput
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &proton.CompatibleEnvironmentTemplateInput{
+			MajorVersion: aws.String(tfMap["major_version"].(string)),
+			TemplateName: aws.String(tfMap["template_name"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenCompatibleEnvironmentTemplates(apiObjects []*proton.CompatibleEnvironmentTemplate) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"major_version": aws.StringValue(apiObject.MajorVersion),
+			"template_name": aws.StringValue(apiObject.TemplateName),
+		})
+	}
+
+	return tfList
+}
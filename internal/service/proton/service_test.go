@@ -0,0 +1,180 @@
+package proton_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/proton"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfproton "github.com/hashicorp/terraform-provider-aws/internal/service/proton"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccProtonService_basic(t *testing.T) {
+	var v proton.Service
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_proton_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, proton.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConfig_basic(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "proton", fmt.Sprintf("service/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "pipeline.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "template_major_version", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "template_name", "aws_proton_service_template.test", "name"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template_major_version", "template_minor_version"},
+			},
+			{
+				Config: testAccServiceConfig_basic(rName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProtonService_disappears(t *testing.T) {
+	var v proton.Service
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_proton_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, proton.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConfig_basic(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfproton.ResourceService(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckServiceExists(n string, v *proton.Service) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Proton Service ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ProtonConn
+
+		output, err := tfproton.FindServiceByName(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckServiceDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ProtonConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_proton_service" {
+			continue
+		}
+
+		_, err := tfproton.FindServiceByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Proton Service %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccServiceConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig_basic(rName, "10.0.0.0/16"), fmt.Sprintf(`
+resource "aws_s3_object" "service" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = "service-template.tar.gz"
+  source = "test-fixtures/service-template.tar.gz"
+}
+
+# The service template bundle has no pipeline definition.
+resource "aws_proton_service_template" "test" {
+  name                  = %[1]q
+  pipeline_provisioning = "CUSTOMER_MANAGED"
+}
+
+resource "aws_proton_service_template_version" "test" {
+  template_name = aws_proton_service_template.test.name
+  status        = "PUBLISHED"
+
+  compatible_environment_template {
+    template_name = aws_proton_environment_template.test.name
+    major_version = aws_proton_environment_template_version.test.major_version
+  }
+
+  source {
+    s3 {
+      bucket = aws_s3_object.service.bucket
+      key    = aws_s3_object.service.key
+    }
+  }
+}
+
+resource "aws_proton_service" "test" {
+  name                   = %[1]q
+  description            = %[2]q
+  template_name          = aws_proton_service_template_version.test.template_name
+  template_major_version = aws_proton_service_template_version.test.major_version
+
+  spec = <<-EOT
+    proton: ServiceSpec
+    instances:
+      - name: instance1
+        environment: ${aws_proton_environment.test.name}
+        spec:
+          queue_name_suffix: queue
+  EOT
+}
+`, rName, description))
+}
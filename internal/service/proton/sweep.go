@@ -0,0 +1,357 @@
+//go:build sweep
+// +build sweep
+
+package proton
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/proton"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_proton_environment", &resource.Sweeper{
+		Name: "aws_proton_environment",
+		F:    sweepEnvironments,
+		Dependencies: []string{
+			"aws_proton_service",
+		},
+	})
+
+	resource.AddTestSweepers("aws_proton_environment_template", &resource.Sweeper{
+		Name: "aws_proton_environment_template",
+		F:    sweepEnvironmentTemplates,
+		Dependencies: []string{
+			"aws_proton_environment_template_version",
+			"aws_proton_service_template_version",
+		},
+	})
+
+	resource.AddTestSweepers("aws_proton_environment_template_version", &resource.Sweeper{
+		Name: "aws_proton_environment_template_version",
+		F:    sweepEnvironmentTemplateVersions,
+		Dependencies: []string{
+			"aws_proton_environment",
+			"aws_proton_service_template_version",
+		},
+	})
+
+	resource.AddTestSweepers("aws_proton_service", &resource.Sweeper{
+		Name: "aws_proton_service",
+		F:    sweepServices,
+	})
+
+	resource.AddTestSweepers("aws_proton_service_template", &resource.Sweeper{
+		Name: "aws_proton_service_template",
+		F:    sweepServiceTemplates,
+		Dependencies: []string{
+			"aws_proton_service_template_version",
+		},
+	})
+
+	resource.AddTestSweepers("aws_proton_service_template_version", &resource.Sweeper{
+		Name: "aws_proton_service_template_version",
+		F:    sweepServiceTemplateVersions,
+		Dependencies: []string{
+			"aws_proton_service",
+		},
+	})
+}
+
+func sweepEnvironments(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).ProtonConn
+	input := &proton.ListEnvironmentsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListEnvironmentsPages(input, func(page *proton.ListEnvironmentsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Environments {
+			r := ResourceEnvironment()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Proton Environment sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Proton Environments (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Proton Environments (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepEnvironmentTemplates(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).ProtonConn
+	input := &proton.ListEnvironmentTemplatesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListEnvironmentTemplatesPages(input, func(page *proton.ListEnvironmentTemplatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Templates {
+			r := ResourceEnvironmentTemplate()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Proton Environment Template sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Proton Environment Templates (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Proton Environment Templates (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepEnvironmentTemplateVersions(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).ProtonConn
+	input := &proton.ListEnvironmentTemplatesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	err = conn.ListEnvironmentTemplatesPages(input, func(page *proton.ListEnvironmentTemplatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Templates {
+			input := &proton.ListEnvironmentTemplateVersionsInput{
+				TemplateName: v.Name,
+			}
+
+			err := conn.ListEnvironmentTemplateVersionsPages(input, func(page *proton.ListEnvironmentTemplateVersionsOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, v := range page.TemplateVersions {
+					r := ResourceEnvironmentTemplateVersion()
+					d := r.Data(nil)
+					d.SetId(TemplateVersionCreateResourceID(aws.StringValue(v.TemplateName), aws.StringValue(v.MajorVersion), aws.StringValue(v.MinorVersion)))
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error listing Proton Environment Template (%s) Versions (%s): %w", aws.StringValue(v.Name), region, err))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Proton Environment Template Version sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Proton Environment Templates (%s): %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Proton Environment Template Versions (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepServices(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).ProtonConn
+	input := &proton.ListServicesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListServicesPages(input, func(page *proton.ListServicesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Services {
+			r := ResourceService()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Proton Service sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Proton Services (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Proton Services (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepServiceTemplates(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).ProtonConn
+	input := &proton.ListServiceTemplatesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListServiceTemplatesPages(input, func(page *proton.ListServiceTemplatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Templates {
+			r := ResourceServiceTemplate()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Proton Service Template sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Proton Service Templates (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Proton Service Templates (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepServiceTemplateVersions(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).ProtonConn
+	input := &proton.ListServiceTemplatesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	err = conn.ListServiceTemplatesPages(input, func(page *proton.ListServiceTemplatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Templates {
+			input := &proton.ListServiceTemplateVersionsInput{
+				TemplateName: v.Name,
+			}
+
+			err := conn.ListServiceTemplateVersionsPages(input, func(page *proton.ListServiceTemplateVersionsOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, v := range page.TemplateVersions {
+					r := ResourceServiceTemplateVersion()
+					d := r.Data(nil)
+					d.SetId(TemplateVersionCreateResourceID(aws.StringValue(v.TemplateName), aws.StringValue(v.MajorVersion), aws.StringValue(v.MinorVersion)))
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error listing Proton Service Template (%s) Versions (%s): %w", aws.StringValue(v.Name), region, err))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Proton Service Template Version sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Proton Service Templates (%s): %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Proton Service Template Versions (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
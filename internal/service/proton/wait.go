@@ -0,0 +1,160 @@
+package proton
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/proton"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitEnvironmentTemplateVersionRegistered(ctx context.Context, conn *proton.Proton, templateName, majorVersion, minorVersion string, timeout time.Duration) (*proton.EnvironmentTemplateVersion, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{proton.TemplateVersionStatusRegistrationInProgress},
+		Target:  []string{proton.TemplateVersionStatusDraft, proton.TemplateVersionStatusPublished},
+		Refresh: statusEnvironmentTemplateVersion(ctx, conn, templateName, majorVersion, minorVersion),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*proton.EnvironmentTemplateVersion); ok {
+		if status := aws.StringValue(output.Status); status == proton.TemplateVersionStatusRegistrationFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitServiceTemplateVersionRegistered(ctx context.Context, conn *proton.Proton, templateName, majorVersion, minorVersion string, timeout time.Duration) (*proton.ServiceTemplateVersion, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{proton.TemplateVersionStatusRegistrationInProgress},
+		Target:  []string{proton.TemplateVersionStatusDraft, proton.TemplateVersionStatusPublished},
+		Refresh: statusServiceTemplateVersion(ctx, conn, templateName, majorVersion, minorVersion),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*proton.ServiceTemplateVersion); ok {
+		if status := aws.StringValue(output.Status); status == proton.TemplateVersionStatusRegistrationFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEnvironmentDeployed(ctx context.Context, conn *proton.Proton, name string, timeout time.Duration) (*proton.Environment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{proton.DeploymentStatusInProgress},
+		Target:  []string{proton.DeploymentStatusSucceeded},
+		Refresh: statusEnvironmentDeployment(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*proton.Environment); ok {
+		if status := aws.StringValue(output.DeploymentStatus); status == proton.DeploymentStatusFailed || status == proton.DeploymentStatusDeleteFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.DeploymentStatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEnvironmentDeleted(ctx context.Context, conn *proton.Proton, name string, timeout time.Duration) (*proton.Environment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{proton.DeploymentStatusDeleteInProgress, proton.DeploymentStatusSucceeded},
+		Target:  []string{},
+		Refresh: statusEnvironmentDeployment(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*proton.Environment); ok {
+		if status := aws.StringValue(output.DeploymentStatus); status == proton.DeploymentStatusFailed || status == proton.DeploymentStatusDeleteFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.DeploymentStatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitServiceCreated(ctx context.Context, conn *proton.Proton, name string, timeout time.Duration) (*proton.Service, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{proton.ServiceStatusCreateInProgress},
+		Target:  []string{proton.ServiceStatusActive},
+		Refresh: statusService(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*proton.Service); ok {
+		if status := aws.StringValue(output.Status); strings.Contains(status, "FAILED") {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitServiceUpdated(ctx context.Context, conn *proton.Proton, name string, timeout time.Duration) (*proton.Service, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{proton.ServiceStatusUpdateInProgress},
+		Target:  []string{proton.ServiceStatusActive},
+		Refresh: statusService(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*proton.Service); ok {
+		if status := aws.StringValue(output.Status); strings.Contains(status, "FAILED") {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitServiceDeleted(ctx context.Context, conn *proton.Proton, name string, timeout time.Duration) (*proton.Service, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{proton.ServiceStatusDeleteInProgress, proton.ServiceStatusActive},
+		Target:  []string{},
+		Refresh: statusService(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*proton.Service); ok {
+		if status := aws.StringValue(output.Status); strings.Contains(status, "FAILED") {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
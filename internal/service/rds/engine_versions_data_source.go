@@ -0,0 +1,194 @@
+package rds
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	gversion "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/namevaluesfilters"
+)
+
+func DataSourceEngineVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceEngineVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"default_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"engine": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"filter": namevaluesfilters.Schema(),
+			"include_all": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"latest_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"parameter_group_family": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"parameter_group_families": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"supports_global_databases": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"supports_parallel_query": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"supports_read_replica": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"version_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceEngineVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	engine := d.Get("engine").(string)
+	input := &rds.DescribeDBEngineVersionsInput{
+		Engine: aws.String(engine),
+	}
+
+	if v, ok := d.GetOk("default_only"); ok {
+		input.DefaultOnly = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("filter"); ok {
+		input.Filters = namevaluesfilters.New(v.(*schema.Set)).RDSFilters()
+	}
+
+	if v, ok := d.GetOk("include_all"); ok {
+		input.IncludeAll = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("parameter_group_family"); ok {
+		input.DBParameterGroupFamily = aws.String(v.(string))
+	}
+
+	// Boolean filters are only applied when explicitly configured.
+	var boolFilters []func(*rds.DBEngineVersion) bool
+	rawConfig := d.GetRawConfig()
+	if v := rawConfig.GetAttr("supports_global_databases"); !v.IsNull() {
+		want := v.True()
+		boolFilters = append(boolFilters, func(ev *rds.DBEngineVersion) bool {
+			return aws.BoolValue(ev.SupportsGlobalDatabases) == want
+		})
+	}
+	if v := rawConfig.GetAttr("supports_parallel_query"); !v.IsNull() {
+		want := v.True()
+		boolFilters = append(boolFilters, func(ev *rds.DBEngineVersion) bool {
+			return aws.BoolValue(ev.SupportsParallelQuery) == want
+		})
+	}
+	if v := rawConfig.GetAttr("supports_read_replica"); !v.IsNull() {
+		want := v.True()
+		boolFilters = append(boolFilters, func(ev *rds.DBEngineVersion) bool {
+			return aws.BoolValue(ev.SupportsReadReplica) == want
+		})
+	}
+
+	versionPrefix := d.Get("version_prefix").(string)
+	var engineVersions []*rds.DBEngineVersion
+
+	err := conn.DescribeDBEngineVersionsPagesWithContext(ctx, input, func(page *rds.DescribeDBEngineVersionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+	outer:
+		for _, v := range page.DBEngineVersions {
+			if v == nil {
+				continue
+			}
+
+			if versionPrefix != "" && !strings.HasPrefix(aws.StringValue(v.EngineVersion), versionPrefix) {
+				continue
+			}
+
+			for _, f := range boolFilters {
+				if !f(v) {
+					continue outer
+				}
+			}
+
+			engineVersions = append(engineVersions, v)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return diag.Errorf("reading RDS engine versions: %s", err)
+	}
+
+	sort.SliceStable(engineVersions, func(i, j int) bool {
+		return compareEngineVersions(aws.StringValue(engineVersions[i].EngineVersion), aws.StringValue(engineVersions[j].EngineVersion)) < 0
+	})
+
+	var versions, families []string
+	seenFamilies := make(map[string]struct{})
+	for _, v := range engineVersions {
+		versions = append(versions, aws.StringValue(v.EngineVersion))
+
+		family := aws.StringValue(v.DBParameterGroupFamily)
+		if _, ok := seenFamilies[family]; !ok && family != "" {
+			seenFamilies[family] = struct{}{}
+			families = append(families, family)
+		}
+	}
+
+	d.SetId(engine)
+	if n := len(versions); n > 0 {
+		d.Set("latest_version", versions[n-1])
+	} else {
+		d.Set("latest_version", nil)
+	}
+	d.Set("parameter_group_families", families)
+	d.Set("versions", versions)
+
+	return nil
+}
+
+// compareEngineVersions orders engine versions numerically where possible (e.g. 5.7.9 < 5.7.10),
+// falling back to lexical ordering for versions that aren't dotted numbers (e.g. Oracle RU versions).
+func compareEngineVersions(a, b string) int {
+	va, errA := gversion.NewVersion(a)
+	vb, errB := gversion.NewVersion(b)
+
+	if errA == nil && errB == nil {
+		if c := va.Compare(vb); c != 0 {
+			return c
+		}
+	}
+
+	return strings.Compare(a, b)
+}
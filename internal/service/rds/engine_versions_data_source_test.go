@@ -0,0 +1,77 @@
+package rds_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccRDSEngineVersionsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_rds_engine_versions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccEngineVersionPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEngineVersionsDataSourceConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "engine", "mysql"),
+					resource.TestMatchResourceAttr(dataSourceName, "latest_version", regexp.MustCompile(`^8\.0\.`)),
+					resource.TestMatchResourceAttr(dataSourceName, "parameter_group_families.#", regexp.MustCompile(`^[1-9][0-9]*`)),
+					resource.TestCheckResourceAttr(dataSourceName, "parameter_group_families.0", "mysql8.0"),
+					resource.TestMatchResourceAttr(dataSourceName, "versions.#", regexp.MustCompile(`^[1-9][0-9]*`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSEngineVersionsDataSource_supportsGlobalDatabases(t *testing.T) {
+	dataSourceName := "data.aws_rds_engine_versions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccEngineVersionPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEngineVersionsDataSourceConfig_supportsGlobalDatabases(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "engine", "aurora-postgresql"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "latest_version"),
+					resource.TestMatchResourceAttr(dataSourceName, "versions.#", regexp.MustCompile(`^[1-9][0-9]*`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccEngineVersionsDataSourceConfig_basic() string {
+	return `
+data "aws_rds_engine_versions" "test" {
+  engine         = "mysql"
+  version_prefix = "8.0."
+}
+`
+}
+
+func testAccEngineVersionsDataSourceConfig_supportsGlobalDatabases() string {
+	return `
+data "aws_rds_engine_versions" "test" {
+  engine                    = "aurora-postgresql"
+  supports_global_databases = true
+
+  filter {
+    name   = "engine-mode"
+    values = ["provisioned"]
+  }
+}
+`
+}
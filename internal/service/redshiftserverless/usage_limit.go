@@ -56,6 +56,7 @@ func ResourceUsageLimit() *schema.Resource {
 			"usage_type": {
 				Type:         schema.TypeString,
 				Required:     true,
+				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice(redshiftserverless.UsageLimitUsageType_Values(), false),
 			},
 		},
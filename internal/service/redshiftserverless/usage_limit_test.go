@@ -74,6 +74,49 @@ func TestAccRedshiftServerlessUsageLimit_disappears(t *testing.T) {
 	})
 }
 
+func TestAccRedshiftServerlessUsageLimit_breachAction(t *testing.T) {
+	resourceName := "aws_redshiftserverless_usage_limit.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, redshiftserverless.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUsageLimitDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsageLimitConfig_breachAction(rName, "daily", "emit-metric"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsageLimitExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "breach_action", "emit-metric"),
+					resource.TestCheckResourceAttr(resourceName, "period", "daily"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccUsageLimitConfig_breachAction(rName, "daily", "deactivate"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsageLimitExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "breach_action", "deactivate"),
+					resource.TestCheckResourceAttr(resourceName, "period", "daily"),
+				),
+			},
+			{
+				Config: testAccUsageLimitConfig_breachAction(rName, "weekly", "deactivate"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsageLimitExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "breach_action", "deactivate"),
+					resource.TestCheckResourceAttr(resourceName, "period", "weekly"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckUsageLimitDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessConn
 
@@ -134,3 +177,24 @@ resource "aws_redshiftserverless_usage_limit" "test" {
 }
 `, rName, amount)
 }
+
+func testAccUsageLimitConfig_breachAction(rName, period, breachAction string) string {
+	return fmt.Sprintf(`
+resource "aws_redshiftserverless_namespace" "test" {
+  namespace_name = %[1]q
+}
+
+resource "aws_redshiftserverless_workgroup" "test" {
+  namespace_name = aws_redshiftserverless_namespace.test.namespace_name
+  workgroup_name = %[1]q
+}
+
+resource "aws_redshiftserverless_usage_limit" "test" {
+  resource_arn  = aws_redshiftserverless_workgroup.test.arn
+  usage_type    = "serverless-compute"
+  amount        = 60
+  period        = %[2]q
+  breach_action = %[3]q
+}
+`, rName, period, breachAction)
+}
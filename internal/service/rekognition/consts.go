@@ -0,0 +1,9 @@
+package rekognition
+
+import (
+	"time"
+)
+
+const (
+	propagationTimeout = 2 * time.Minute
+)
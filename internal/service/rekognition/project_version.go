@@ -0,0 +1,684 @@
+package rekognition
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceProjectVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceProjectVersionCreate,
+		ReadWithoutTimeout:   resourceProjectVersionRead,
+		UpdateWithoutTimeout: resourceProjectVersionUpdate,
+		DeleteWithoutTimeout: resourceProjectVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(180 * time.Minute),
+			Delete: schema.DefaultTimeout(180 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"billable_training_time_in_seconds": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"f1_score": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"output_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_bucket": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"s3_key_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"project_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"testing_data": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"asset": assetSchema(),
+						"auto_create": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"training_data": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"asset": assetSchema(),
+					},
+				},
+			},
+			"version_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func assetSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"ground_truth_manifest": {
+					Type:     schema.TypeList,
+					Required: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"s3_object": {
+								Type:     schema.TypeList,
+								Required: true,
+								ForceNew: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"bucket": {
+											Type:     schema.TypeString,
+											Required: true,
+											ForceNew: true,
+										},
+										"name": {
+											Type:     schema.TypeString,
+											Required: true,
+											ForceNew: true,
+										},
+										"version": {
+											Type:     schema.TypeString,
+											Optional: true,
+											ForceNew: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceProjectVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RekognitionConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	projectName := d.Get("project_name").(string)
+	versionName := d.Get("version_name").(string)
+	id := ProjectVersionCreateResourceID(projectName, versionName)
+
+	project, err := FindProjectByName(ctx, conn, projectName)
+
+	if err != nil {
+		return diag.Errorf("creating Rekognition Project Version (%s): reading Project (%s): %s", id, projectName, err)
+	}
+
+	input := &rekognition.CreateProjectVersionInput{
+		OutputConfig: expandOutputConfig(d.Get("output_config").([]interface{})),
+		ProjectArn:   project.ProjectArn,
+		VersionName:  aws.String(versionName),
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("testing_data"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.TestingData = expandTestingData(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("training_data"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.TrainingData = expandTrainingData(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	_, err = conn.CreateProjectVersionWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Rekognition Project Version (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitProjectVersionTrainingCompleted(ctx, conn, projectName, versionName, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Rekognition Project Version (%s) training: %s", d.Id(), err)
+	}
+
+	return resourceProjectVersionRead(ctx, d, meta)
+}
+
+func resourceProjectVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RekognitionConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	projectName, versionName, err := ProjectVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectVersion, err := FindProjectVersionByTwoPartKey(ctx, conn, projectName, versionName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Rekognition Project Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Rekognition Project Version (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(projectVersion.ProjectVersionArn)
+	d.Set("arn", arn)
+	d.Set("billable_training_time_in_seconds", projectVersion.BillableTrainingTimeInSeconds)
+	if projectVersion.EvaluationResult != nil {
+		d.Set("f1_score", projectVersion.EvaluationResult.F1Score)
+	} else {
+		d.Set("f1_score", nil)
+	}
+	d.Set("kms_key_id", projectVersion.KmsKeyId)
+	if err := d.Set("output_config", flattenOutputConfig(projectVersion.OutputConfig)); err != nil {
+		return diag.Errorf("setting output_config: %s", err)
+	}
+	d.Set("project_name", projectName)
+	d.Set("status", projectVersion.Status)
+	d.Set("status_message", projectVersion.StatusMessage)
+	if projectVersion.TestingDataResult != nil {
+		if err := d.Set("testing_data", flattenTestingData(projectVersion.TestingDataResult.Input)); err != nil {
+			return diag.Errorf("setting testing_data: %s", err)
+		}
+	} else {
+		d.Set("testing_data", nil)
+	}
+	if projectVersion.TrainingDataResult != nil {
+		if err := d.Set("training_data", flattenTrainingData(projectVersion.TrainingDataResult.Input)); err != nil {
+			return diag.Errorf("setting training_data: %s", err)
+		}
+	} else {
+		d.Set("training_data", nil)
+	}
+	d.Set("version_name", versionName)
+
+	tags, err := ListTagsWithContext(ctx, conn, arn)
+
+	if err != nil {
+		return diag.Errorf("listing tags for Rekognition Project Version (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceProjectVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RekognitionConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating Rekognition Project Version (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceProjectVersionRead(ctx, d, meta)
+}
+
+func resourceProjectVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RekognitionConn
+
+	projectName, versionName, err := ProjectVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectVersion, err := FindProjectVersionByTwoPartKey(ctx, conn, projectName, versionName)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Rekognition Project Version (%s): %s", d.Id(), err)
+	}
+
+	// A model can't be deleted while it's training or running.
+	switch aws.StringValue(projectVersion.Status) {
+	case rekognition.ProjectVersionStatusTrainingInProgress:
+		if _, err := waitProjectVersionTrainingCompleted(ctx, conn, projectName, versionName, d.Timeout(schema.TimeoutDelete)); err != nil && !errors.As(err, new(*resource.UnexpectedStateError)) {
+			return diag.Errorf("waiting for Rekognition Project Version (%s) training: %s", d.Id(), err)
+		}
+	case rekognition.ProjectVersionStatusStarting, rekognition.ProjectVersionStatusRunning:
+		log.Printf("[DEBUG] Stopping Rekognition Project Version: %s", d.Id())
+		_, err := conn.StopProjectVersionWithContext(ctx, &rekognition.StopProjectVersionInput{
+			ProjectVersionArn: projectVersion.ProjectVersionArn,
+		})
+
+		if err != nil && !tfawserr.ErrCodeEquals(err, rekognition.ErrCodeResourceInUseException) {
+			return diag.Errorf("stopping Rekognition Project Version (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitProjectVersionStopped(ctx, conn, projectName, versionName, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return diag.Errorf("waiting for Rekognition Project Version (%s) stop: %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Rekognition Project Version: %s", d.Id())
+	_, err = conn.DeleteProjectVersionWithContext(ctx, &rekognition.DeleteProjectVersionInput{
+		ProjectVersionArn: projectVersion.ProjectVersionArn,
+	})
+
+	if tfawserr.ErrCodeEquals(err, rekognition.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Rekognition Project Version (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitProjectVersionDeleted(ctx, conn, projectName, versionName, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Rekognition Project Version (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const projectVersionResourceIDSeparator = "/"
+
+func ProjectVersionCreateResourceID(projectName, versionName string) string {
+	parts := []string{projectName, versionName}
+	id := strings.Join(parts, projectVersionResourceIDSeparator)
+
+	return id
+}
+
+func ProjectVersionParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, projectVersionResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected PROJECTNAME%[2]sVERSIONNAME", id, projectVersionResourceIDSeparator)
+}
+
+func FindProjectVersionByTwoPartKey(ctx context.Context, conn *rekognition.Rekognition, projectName, versionName string) (*rekognition.ProjectVersionDescription, error) {
+	project, err := FindProjectByName(ctx, conn, projectName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	input := &rekognition.DescribeProjectVersionsInput{
+		ProjectArn:   project.ProjectArn,
+		VersionNames: aws.StringSlice([]string{versionName}),
+	}
+	var output []*rekognition.ProjectVersionDescription
+
+	err = conn.DescribeProjectVersionsPagesWithContext(ctx, input, func(page *rekognition.DescribeProjectVersionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ProjectVersionDescriptions {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, rekognition.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func statusProjectVersion(ctx context.Context, conn *rekognition.Rekognition, projectName, versionName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindProjectVersionByTwoPartKey(ctx, conn, projectName, versionName)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitProjectVersionTrainingCompleted(ctx context.Context, conn *rekognition.Rekognition, projectName, versionName string, timeout time.Duration) (*rekognition.ProjectVersionDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{rekognition.ProjectVersionStatusTrainingInProgress},
+		Target:       []string{rekognition.ProjectVersionStatusTrainingCompleted},
+		Refresh:      statusProjectVersion(ctx, conn, projectName, versionName),
+		Timeout:      timeout,
+		PollInterval: 1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rekognition.ProjectVersionDescription); ok {
+		if aws.StringValue(output.Status) == rekognition.ProjectVersionStatusTrainingFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitProjectVersionStopped(ctx context.Context, conn *rekognition.Rekognition, projectName, versionName string, timeout time.Duration) (*rekognition.ProjectVersionDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{rekognition.ProjectVersionStatusStarting, rekognition.ProjectVersionStatusRunning, rekognition.ProjectVersionStatusStopping},
+		Target:  []string{rekognition.ProjectVersionStatusStopped},
+		Refresh: statusProjectVersion(ctx, conn, projectName, versionName),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rekognition.ProjectVersionDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitProjectVersionDeleted(ctx context.Context, conn *rekognition.Rekognition, projectName, versionName string, timeout time.Duration) (*rekognition.ProjectVersionDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			rekognition.ProjectVersionStatusDeleting,
+			rekognition.ProjectVersionStatusFailed,
+			rekognition.ProjectVersionStatusStopped,
+			rekognition.ProjectVersionStatusTrainingCompleted,
+			rekognition.ProjectVersionStatusTrainingFailed,
+		},
+		Target:  []string{},
+		Refresh: statusProjectVersion(ctx, conn, projectName, versionName),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rekognition.ProjectVersionDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandOutputConfig(tfList []interface{}) *rekognition.OutputConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &rekognition.OutputConfig{}
+
+	if v, ok := tfMap["s3_bucket"].(string); ok && v != "" {
+		apiObject.S3Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["s3_key_prefix"].(string); ok && v != "" {
+		apiObject.S3KeyPrefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandTrainingData(tfMap map[string]interface{}) *rekognition.TrainingData {
+	apiObject := &rekognition.TrainingData{}
+
+	if v, ok := tfMap["asset"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Assets = expandAssets(v)
+	}
+
+	return apiObject
+}
+
+func expandTestingData(tfMap map[string]interface{}) *rekognition.TestingData {
+	apiObject := &rekognition.TestingData{}
+
+	if v, ok := tfMap["asset"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Assets = expandAssets(v)
+	}
+
+	if v, ok := tfMap["auto_create"].(bool); ok && v {
+		apiObject.AutoCreate = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandAssets(tfList []interface{}) []*rekognition.Asset {
+	var apiObjects []*rekognition.Asset
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &rekognition.Asset{}
+
+		if v, ok := tfMap["ground_truth_manifest"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.GroundTruthManifest = expandGroundTruthManifest(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandGroundTruthManifest(tfMap map[string]interface{}) *rekognition.GroundTruthManifest {
+	apiObject := &rekognition.GroundTruthManifest{}
+
+	if v, ok := tfMap["s3_object"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		s3Object := &rekognition.S3Object{}
+
+		if v, ok := tfMap["bucket"].(string); ok && v != "" {
+			s3Object.Bucket = aws.String(v)
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			s3Object.Name = aws.String(v)
+		}
+
+		if v, ok := tfMap["version"].(string); ok && v != "" {
+			s3Object.Version = aws.String(v)
+		}
+
+		apiObject.S3Object = s3Object
+	}
+
+	return apiObject
+}
+
+func flattenOutputConfig(apiObject *rekognition.OutputConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"s3_bucket":     aws.StringValue(apiObject.S3Bucket),
+		"s3_key_prefix": aws.StringValue(apiObject.S3KeyPrefix),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenTrainingData(apiObject *rekognition.TrainingData) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"asset": flattenAssets(apiObject.Assets),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenTestingData(apiObject *rekognition.TestingData) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"asset":       flattenAssets(apiObject.Assets),
+		"auto_create": aws.BoolValue(apiObject.AutoCreate),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAssets(apiObjects []*rekognition.Asset) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil || apiObject.GroundTruthManifest == nil || apiObject.GroundTruthManifest.S3Object == nil {
+			continue
+		}
+
+		s3Object := apiObject.GroundTruthManifest.S3Object
+
+		tfList = append(tfList, map[string]interface{}{
+			"ground_truth_manifest": []interface{}{
+				map[string]interface{}{
+					"s3_object": []interface{}{
+						map[string]interface{}{
+							"bucket":  aws.StringValue(s3Object.Bucket),
+							"name":    aws.StringValue(s3Object.Name),
+							"version": aws.StringValue(s3Object.Version),
+						},
+					},
+				},
+			},
+		})
+	}
+
+	return tfList
+}
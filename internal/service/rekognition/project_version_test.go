@@ -0,0 +1,172 @@
+package rekognition_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	tfrekognition "github.com/hashicorp/terraform-provider-aws/internal/service/rekognition"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	envVarDatasetBucket             = "AWS_REKOGNITION_DATASET_BUCKET"
+	envVarDatasetBucketMessageError = "Environment variable AWS_REKOGNITION_DATASET_BUCKET is not set. " +
+		"To train a model, an S3 bucket containing labeled images and a SageMaker Ground Truth manifest at training/output.manifest must be provided."
+)
+
+func TestAccRekognitionProjectVersion_basic(t *testing.T) {
+	bucket := envvar.SkipIfEmpty(t, envVarDatasetBucket, envVarDatasetBucketMessageError)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rekognition_project_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(rekognition.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rekognition.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProjectVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectVersionConfig_basic(rName, bucket),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectVersionExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "rekognition", regexp.MustCompile(fmt.Sprintf(`project/%[1]s/version/%[1]s/.+`, rName))),
+					resource.TestCheckResourceAttrSet(resourceName, "billable_training_time_in_seconds"),
+					resource.TestCheckResourceAttr(resourceName, "output_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "output_config.0.s3_bucket", bucket),
+					resource.TestCheckResourceAttr(resourceName, "output_config.0.s3_key_prefix", rName),
+					resource.TestCheckResourceAttr(resourceName, "project_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", rekognition.ProjectVersionStatusTrainingCompleted),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "training_data.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "version_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRekognitionProjectVersion_disappears(t *testing.T) {
+	bucket := envvar.SkipIfEmpty(t, envVarDatasetBucket, envVarDatasetBucketMessageError)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rekognition_project_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(rekognition.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rekognition.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProjectVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectVersionConfig_basic(rName, bucket),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectVersionExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfrekognition.ResourceProjectVersion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckProjectVersionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RekognitionConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_rekognition_project_version" {
+			continue
+		}
+
+		projectName, versionName, err := tfrekognition.ProjectVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfrekognition.FindProjectVersionByTwoPartKey(context.Background(), conn, projectName, versionName)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Rekognition Project Version %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckProjectVersionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Rekognition Project Version ID is set")
+		}
+
+		projectName, versionName, err := tfrekognition.ProjectVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RekognitionConn
+
+		_, err = tfrekognition.FindProjectVersionByTwoPartKey(context.Background(), conn, projectName, versionName)
+
+		return err
+	}
+}
+
+func testAccProjectVersionConfig_basic(rName, bucket string) string {
+	return fmt.Sprintf(`
+resource "aws_rekognition_project" "test" {
+  name = %[1]q
+}
+
+resource "aws_rekognition_project_version" "test" {
+  project_name = aws_rekognition_project.test.name
+  version_name = %[1]q
+
+  output_config {
+    s3_bucket     = %[2]q
+    s3_key_prefix = %[1]q
+  }
+
+  training_data {
+    asset {
+      ground_truth_manifest {
+        s3_object {
+          bucket = %[2]q
+          name   = "training/output.manifest"
+        }
+      }
+    }
+  }
+
+  testing_data {
+    auto_create = true
+  }
+}
+`, rName, bucket)
+}
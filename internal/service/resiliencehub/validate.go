@@ -0,0 +1,9 @@
+package resiliencehub
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var validName = validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_\-]{1,59}$`), "must be 2 to 60 alphanumeric characters, hyphens or underscores, starting with an alphanumeric character")
@@ -0,0 +1,233 @@
+package route53
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceRecordsExclusive() *schema.Resource {
+	return &schema.Resource{
+		// Create and Update both delete every record set in the zone whose name, type and set identifier
+		// do not match a configured record, except the zone apex NS and SOA records Route 53 requires.
+		Create: resourceRecordsExclusivePut,
+		Update: resourceRecordsExclusivePut,
+
+		Read:   resourceRecordsExclusiveRead,
+		Delete: resourceRecordsExclusiveDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"record": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"set_identifier": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(route53.RRType_Values(), false),
+						},
+					},
+				},
+			},
+			"zone_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceRecordsExclusivePut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	zoneID := CleanZoneID(d.Get("zone_id").(string))
+
+	output, err := FindHostedZoneByID(conn, zoneID)
+
+	if err != nil {
+		return fmt.Errorf("reading Route53 Hosted Zone (%s): %w", zoneID, err)
+	}
+
+	zoneName := aws.StringValue(output.HostedZone.Name)
+
+	if err := syncResourceRecordSets(conn, zoneID, zoneName, d.Get("record").(*schema.Set).List()); err != nil {
+		return fmt.Errorf("synchronizing Route53 Hosted Zone (%s) records: %w", zoneID, err)
+	}
+
+	d.SetId(zoneID)
+
+	return resourceRecordsExclusiveRead(d, meta)
+}
+
+func resourceRecordsExclusiveRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	output, err := FindHostedZoneByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route53 Hosted Zone (%s) not found, removing records exclusive management from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading Route53 Hosted Zone (%s): %w", d.Id(), err)
+	}
+
+	zoneName := aws.StringValue(output.HostedZone.Name)
+
+	recordSets, err := FindResourceRecordSetsByHostedZoneID(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("reading Route53 Hosted Zone (%s) records: %w", d.Id(), err)
+	}
+
+	// Keep the configured spelling of record names that match, e.g. names
+	// relative to the zone, so that they do not show a perpetual difference.
+	configured := make(map[string]interface{})
+	for _, tfMapRaw := range d.Get("record").(*schema.Set).List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+		configured[recordsExclusiveKey(ExpandRecordName(tfMap["name"].(string), zoneName), tfMap["type"].(string), tfMap["set_identifier"].(string))] = tfMap
+	}
+
+	var tfList []interface{}
+	for _, v := range recordSets {
+		if isZoneApexNSOrSOA(v, zoneName) {
+			continue
+		}
+
+		name := strings.ToLower(strings.TrimSuffix(CleanRecordName(aws.StringValue(v.Name)), "."))
+		key := recordsExclusiveKey(name, aws.StringValue(v.Type), aws.StringValue(v.SetIdentifier))
+
+		if tfMap, ok := configured[key]; ok {
+			tfList = append(tfList, tfMap)
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":           name,
+			"set_identifier": aws.StringValue(v.SetIdentifier),
+			"type":           aws.StringValue(v.Type),
+		})
+	}
+
+	if err := d.Set("record", tfList); err != nil {
+		return fmt.Errorf("setting record: %w", err)
+	}
+	d.Set("zone_id", d.Id())
+
+	return nil
+}
+
+func resourceRecordsExclusiveDelete(d *schema.ResourceData, meta interface{}) error {
+	// The configured record sets are owned by their aws_route53_record resources, so none are deleted;
+	// record sets added to the zone afterwards are simply no longer removed.
+	log.Printf("[DEBUG] Removing Route53 Hosted Zone (%s) records exclusive management from state", d.Id())
+
+	return nil
+}
+
+// syncResourceRecordSets deletes any record in the hosted zone that is not in
+// the wanted set, other than the zone apex NS and SOA records. Records in the
+// set are expected to be created elsewhere, e.g. with aws_route53_record.
+func syncResourceRecordSets(conn *route53.Route53, zoneID, zoneName string, want []interface{}) error {
+	have, err := FindResourceRecordSetsByHostedZoneID(conn, zoneID)
+
+	if err != nil {
+		return err
+	}
+
+	wanted := make(map[string]struct{}, len(want))
+	for _, tfMapRaw := range want {
+		tfMap := tfMapRaw.(map[string]interface{})
+		wanted[recordsExclusiveKey(ExpandRecordName(tfMap["name"].(string), zoneName), tfMap["type"].(string), tfMap["set_identifier"].(string))] = struct{}{}
+	}
+
+	var changes []*route53.Change
+	for _, v := range have {
+		if isZoneApexNSOrSOA(v, zoneName) {
+			continue
+		}
+
+		name := strings.ToLower(strings.TrimSuffix(CleanRecordName(aws.StringValue(v.Name)), "."))
+		if _, ok := wanted[recordsExclusiveKey(name, aws.StringValue(v.Type), aws.StringValue(v.SetIdentifier))]; ok {
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting Route53 Hosted Zone (%s) record: %s %s", zoneID, aws.StringValue(v.Name), aws.StringValue(v.Type))
+		changes = append(changes, &route53.Change{
+			Action:            aws.String(route53.ChangeActionDelete),
+			ResourceRecordSet: v,
+		})
+	}
+
+	// A change batch can contain at most 1,000 changes.
+	for len(changes) > 0 {
+		n := len(changes)
+		if n > 1000 {
+			n = 1000
+		}
+
+		input := &route53.ChangeResourceRecordSetsInput{
+			HostedZoneId: aws.String(zoneID),
+			ChangeBatch: &route53.ChangeBatch{
+				Comment: aws.String("Deleted by Terraform"),
+				Changes: changes[:n],
+			},
+		}
+
+		outputRaw, err := ChangeRecordSet(conn, input)
+
+		if err != nil {
+			return fmt.Errorf("deleting records: %w", err)
+		}
+
+		if output, ok := outputRaw.(*route53.ChangeResourceRecordSetsOutput); ok && output.ChangeInfo != nil {
+			if err := WaitForRecordSetToSync(conn, CleanChangeID(aws.StringValue(output.ChangeInfo.Id))); err != nil {
+				return fmt.Errorf("waiting for records delete: %w", err)
+			}
+		}
+
+		changes = changes[n:]
+	}
+
+	return nil
+}
+
+func recordsExclusiveKey(name, recordType, setIdentifier string) string {
+	return strings.Join([]string{strings.ToLower(strings.TrimSuffix(name, ".")), strings.ToUpper(recordType), setIdentifier}, "|")
+}
+
+func isZoneApexNSOrSOA(recordSet *route53.ResourceRecordSet, zoneName string) bool {
+	if !strings.EqualFold(strings.TrimSuffix(aws.StringValue(recordSet.Name), "."), strings.TrimSuffix(zoneName, ".")) {
+		return false
+	}
+
+	switch aws.StringValue(recordSet.Type) {
+	case route53.RRTypeNs, route53.RRTypeSoa:
+		return true
+	default:
+		return false
+	}
+}
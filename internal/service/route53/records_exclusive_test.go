@@ -0,0 +1,168 @@
+package route53_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+)
+
+func TestAccRoute53RecordsExclusive_basic(t *testing.T) {
+	zoneName := acctest.RandomDomainName()
+	resourceName := "aws_route53_records_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsExclusiveConfig_basic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsExclusiveCount(resourceName, zoneName, 1),
+					resource.TestCheckResourceAttrPair(resourceName, "zone_id", "aws_route53_zone.test", "zone_id"),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name": "www",
+						"type": "A",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"record"},
+			},
+		},
+	})
+}
+
+func TestAccRoute53RecordsExclusive_outOfBandAddition(t *testing.T) {
+	zoneName := acctest.RandomDomainName()
+	resourceName := "aws_route53_records_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsExclusiveConfig_basic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsExclusiveCount(resourceName, zoneName, 1),
+					testAccCheckRecordsExclusiveCreateOutOfBand(resourceName, "oob."+zoneName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccRecordsExclusiveConfig_basic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsExclusiveCount(resourceName, zoneName, 1),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckRecordsExclusiveCount checks the number of records in the hosted
+// zone other than the zone apex NS and SOA records.
+func testAccCheckRecordsExclusiveCount(n, zoneName string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route53 Records Exclusive ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn
+
+		output, err := tfroute53.FindResourceRecordSetsByHostedZoneID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		var got int
+		for _, v := range output {
+			if recordType := aws.StringValue(v.Type); (recordType == route53.RRTypeNs || recordType == route53.RRTypeSoa) && strings.TrimSuffix(aws.StringValue(v.Name), ".") == zoneName {
+				continue
+			}
+
+			got++
+		}
+
+		if got != want {
+			return fmt.Errorf("Route53 Hosted Zone (%s) has %d records, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRecordsExclusiveCreateOutOfBand(n, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn
+
+		_, err := tfroute53.ChangeRecordSet(conn, &route53.ChangeResourceRecordSetsInput{
+			HostedZoneId: aws.String(rs.Primary.ID),
+			ChangeBatch: &route53.ChangeBatch{
+				Changes: []*route53.Change{{
+					Action: aws.String(route53.ChangeActionCreate),
+					ResourceRecordSet: &route53.ResourceRecordSet{
+						Name:            aws.String(name),
+						Type:            aws.String(route53.RRTypeA),
+						TTL:             aws.Int64(30),
+						ResourceRecords: []*route53.ResourceRecord{{Value: aws.String("127.0.0.2")}},
+					},
+				}},
+			},
+		})
+
+		return err
+	}
+}
+
+func testAccRecordsExclusiveConfig_basic(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name          = %[1]q
+  force_destroy = true
+}
+
+resource "aws_route53_record" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = "www"
+  type    = "A"
+  ttl     = 30
+  records = ["127.0.0.1"]
+}
+
+resource "aws_route53_records_exclusive" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  record {
+    name = aws_route53_record.test.name
+    type = aws_route53_record.test.type
+  }
+}
+`, zoneName)
+}
@@ -0,0 +1,192 @@
+package route53recoverycluster
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53recoverycluster"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceRoutingControlState() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRoutingControlStatePut,
+		Read:   resourceRoutingControlStateRead,
+		Update: resourceRoutingControlStatePut,
+		Delete: resourceRoutingControlStateDelete,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_endpoints": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"endpoint": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"routing_control_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"routing_control_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"routing_control_state": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(route53recoverycluster.RoutingControlState_Values(), false),
+			},
+			"safety_rules_to_override": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+		},
+	}
+}
+
+func resourceRoutingControlStatePut(d *schema.ResourceData, meta interface{}) error {
+	arn := d.Get("routing_control_arn").(string)
+	input := &route53recoverycluster.UpdateRoutingControlStateInput{
+		RoutingControlArn:   aws.String(arn),
+		RoutingControlState: aws.String(d.Get("routing_control_state").(string)),
+	}
+
+	if v, ok := d.GetOk("safety_rules_to_override"); ok && v.(*schema.Set).Len() > 0 {
+		input.SafetyRulesToOverride = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	err := withClusterEndpoints(meta, d.Get("cluster_endpoints").([]interface{}), func(conn *route53recoverycluster.Route53RecoveryCluster) error {
+		_, err := conn.UpdateRoutingControlState(input)
+
+		return err
+	})
+
+	if err != nil {
+		return fmt.Errorf("updating Route53 Recovery Cluster Routing Control (%s) state: %w", arn, err)
+	}
+
+	d.SetId(arn)
+
+	return resourceRoutingControlStateRead(d, meta)
+}
+
+func resourceRoutingControlStateRead(d *schema.ResourceData, meta interface{}) error {
+	var output *route53recoverycluster.GetRoutingControlStateOutput
+
+	err := withClusterEndpoints(meta, d.Get("cluster_endpoints").([]interface{}), func(conn *route53recoverycluster.Route53RecoveryCluster) error {
+		var err error
+		output, err = FindRoutingControlStateByARN(conn, d.Id())
+
+		return err
+	})
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route53 Recovery Cluster Routing Control (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading Route53 Recovery Cluster Routing Control (%s) state: %w", d.Id(), err)
+	}
+
+	d.Set("routing_control_arn", output.RoutingControlArn)
+	d.Set("routing_control_name", output.RoutingControlName)
+	d.Set("routing_control_state", output.RoutingControlState)
+
+	return nil
+}
+
+func resourceRoutingControlStateDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARN] Route53 Recovery Cluster Routing Control (%s) state only removed from state; the routing control keeps its current state", d.Id())
+
+	return nil
+}
+
+func FindRoutingControlStateByARN(conn *route53recoverycluster.Route53RecoveryCluster, arn string) (*route53recoverycluster.GetRoutingControlStateOutput, error) {
+	input := &route53recoverycluster.GetRoutingControlStateInput{
+		RoutingControlArn: aws.String(arn),
+	}
+
+	output, err := conn.GetRoutingControlState(input)
+
+	if tfawserr.ErrCodeEquals(err, route53recoverycluster.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// withClusterEndpoints calls f with a client for each of the cluster's regional
+// endpoints in turn until one succeeds. The cluster data plane is only reachable
+// through these endpoints and any of them may be temporarily unavailable, so
+// only errors that would recur on every endpoint are returned immediately.
+func withClusterEndpoints(meta interface{}, tfList []interface{}, f func(*route53recoverycluster.Route53RecoveryCluster) error) error {
+	sess := meta.(*conns.AWSClient).Session
+	err := errors.New("no cluster endpoints configured")
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		conn := route53recoverycluster.New(sess, aws.NewConfig().WithEndpoint(tfMap["endpoint"].(string)).WithRegion(tfMap["region"].(string)))
+
+		err = f(conn)
+
+		if err == nil || tfresource.NotFound(err) {
+			return err
+		}
+
+		if tfawserr.ErrCodeEquals(err,
+			route53recoverycluster.ErrCodeAccessDeniedException,
+			route53recoverycluster.ErrCodeConflictException,
+			route53recoverycluster.ErrCodeServiceLimitExceededException,
+			route53recoverycluster.ErrCodeValidationException,
+		) {
+			return err
+		}
+
+		log.Printf("[WARN] Route53 Recovery Cluster endpoint (%s) failed, trying next endpoint: %s", tfMap["endpoint"].(string), err)
+	}
+
+	return err
+}
@@ -0,0 +1,67 @@
+package route53recoverycluster_test
+
+import (
+	"fmt"
+	"testing"
+
+	r53rcc "github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccRoute53RecoveryClusterRoutingControlState_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route53recoverycluster_routing_control_state.test"
+
+	// Not parallel because of the low quota on clusters per account.
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(r53rcc.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, r53rcc.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoutingControlStateConfig_basic(rName, "On"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "routing_control_arn", "aws_route53recoverycontrolconfig_routing_control.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "routing_control_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "routing_control_state", "On"),
+				),
+			},
+			{
+				Config: testAccRoutingControlStateConfig_basic(rName, "Off"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "routing_control_state", "Off"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRoutingControlStateConfig_basic(rName, state string) string {
+	return fmt.Sprintf(`
+resource "aws_route53recoverycontrolconfig_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53recoverycontrolconfig_routing_control" "test" {
+  name        = %[1]q
+  cluster_arn = aws_route53recoverycontrolconfig_cluster.test.arn
+}
+
+resource "aws_route53recoverycluster_routing_control_state" "test" {
+  routing_control_arn   = aws_route53recoverycontrolconfig_routing_control.test.arn
+  routing_control_state = %[2]q
+
+  dynamic "cluster_endpoints" {
+    for_each = aws_route53recoverycontrolconfig_cluster.test.cluster_endpoints
+
+    content {
+      endpoint = cluster_endpoints.value.endpoint
+      region   = cluster_endpoints.value.region
+    }
+  }
+}
+`, rName, state)
+}
@@ -95,6 +95,22 @@ func ResourceAppMonitor() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"custom_events": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      cloudwatchrum.CustomEventsStatusDisabled,
+							ValidateFunc: validation.StringInSlice(cloudwatchrum.CustomEventsStatus_Values(), false),
+						},
+					},
+				},
+			},
 			"cw_log_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -138,6 +154,10 @@ func resourceAppMonitorCreate(d *schema.ResourceData, meta interface{}) error {
 		input.AppMonitorConfiguration = expandAppMonitorConfiguration(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("custom_events"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.CustomEvents = expandCustomEvents(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if len(tags) > 0 {
 		input.Tags = Tags(tags.IgnoreAWS())
 	}
@@ -181,6 +201,13 @@ func resourceAppMonitorRead(d *schema.ResourceData, meta interface{}) error {
 		Service:   "rum",
 	}.String()
 	d.Set("arn", arn)
+	if appMon.CustomEvents != nil {
+		if err := d.Set("custom_events", []interface{}{flattenCustomEvents(appMon.CustomEvents)}); err != nil {
+			return fmt.Errorf("setting custom_events: %w", err)
+		}
+	} else {
+		d.Set("custom_events", nil)
+	}
 	d.Set("cw_log_enabled", appMon.DataStorage.CwLog.CwLogEnabled)
 	d.Set("cw_log_group", appMon.DataStorage.CwLog.CwLogGroup)
 	d.Set("domain", appMon.Domain)
@@ -216,6 +243,10 @@ func resourceAppMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
 			input.AppMonitorConfiguration = expandAppMonitorConfiguration(d.Get("app_monitor_configuration").([]interface{})[0].(map[string]interface{}))
 		}
 
+		if d.HasChange("custom_events") {
+			input.CustomEvents = expandCustomEvents(d.Get("custom_events").([]interface{})[0].(map[string]interface{}))
+		}
+
 		if d.HasChange("domain") {
 			input.Domain = aws.String(d.Get("domain").(string))
 		}
@@ -346,3 +377,31 @@ func flattenAppMonitorConfiguration(apiObject *cloudwatchrum.AppMonitorConfigura
 
 	return tfMap
 }
+
+func expandCustomEvents(tfMap map[string]interface{}) *cloudwatchrum.CustomEvents {
+	if tfMap == nil {
+		return nil
+	}
+
+	config := &cloudwatchrum.CustomEvents{}
+
+	if v, ok := tfMap["status"].(string); ok && v != "" {
+		config.Status = aws.String(v)
+	}
+
+	return config
+}
+
+func flattenCustomEvents(apiObject *cloudwatchrum.CustomEvents) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Status; v != nil {
+		tfMap["status"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
@@ -64,6 +64,42 @@ func TestAccRUMAppMonitor_basic(t *testing.T) {
 	})
 }
 
+func TestAccRUMAppMonitor_customEvents(t *testing.T) {
+	var appMon cloudwatchrum.AppMonitor
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rum_app_monitor.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatchrum.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppMonitorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppMonitorConfig_customEvents(rName, "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppMonitorExists(resourceName, &appMon),
+					resource.TestCheckResourceAttr(resourceName, "custom_events.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "custom_events.0.status", "ENABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAppMonitorConfig_customEvents(rName, "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppMonitorExists(resourceName, &appMon),
+					resource.TestCheckResourceAttr(resourceName, "custom_events.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "custom_events.0.status", "DISABLED"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRUMAppMonitor_tags(t *testing.T) {
 	var appMon cloudwatchrum.AppMonitor
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -200,6 +236,19 @@ resource "aws_rum_app_monitor" "test" {
 `, rName)
 }
 
+func testAccAppMonitorConfig_customEvents(rName, status string) string {
+	return fmt.Sprintf(`
+resource "aws_rum_app_monitor" "test" {
+  name   = %[1]q
+  domain = "localhost"
+
+  custom_events {
+    status = %[2]q
+  }
+}
+`, rName, status)
+}
+
 func testAccAppMonitorConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_rum_app_monitor" "test" {
@@ -32,3 +32,87 @@ func FindAppMonitorByName(conn *cloudwatchrum.CloudWatchRUM, name string) (*clou
 
 	return output.AppMonitor, nil
 }
+
+func FindMetricsDestinationByThreePartKey(conn *cloudwatchrum.CloudWatchRUM, appMonitorName, destination, destinationARN string) (*cloudwatchrum.MetricDestinationSummary, error) {
+	input := &cloudwatchrum.ListRumMetricsDestinationsInput{
+		AppMonitorName: aws.String(appMonitorName),
+	}
+	var output *cloudwatchrum.MetricDestinationSummary
+
+	err := conn.ListRumMetricsDestinationsPages(input, func(page *cloudwatchrum.ListRumMetricsDestinationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Destinations {
+			if v == nil {
+				continue
+			}
+
+			if aws.StringValue(v.Destination) == destination && aws.StringValue(v.DestinationArn) == destinationARN {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchrum.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindMetricDefinitionsByThreePartKey(conn *cloudwatchrum.CloudWatchRUM, appMonitorName, destination, destinationARN string) ([]*cloudwatchrum.MetricDefinition, error) {
+	input := &cloudwatchrum.BatchGetRumMetricDefinitionsInput{
+		AppMonitorName: aws.String(appMonitorName),
+		Destination:    aws.String(destination),
+	}
+
+	if destinationARN != "" {
+		input.DestinationArn = aws.String(destinationARN)
+	}
+
+	var output []*cloudwatchrum.MetricDefinition
+
+	err := conn.BatchGetRumMetricDefinitionsPages(input, func(page *cloudwatchrum.BatchGetRumMetricDefinitionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.MetricDefinitions {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchrum.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,34 @@
+package rum
+
+import (
+	"fmt"
+	"strings"
+)
+
+const metricsDestinationResourceIDSeparator = ","
+
+func MetricsDestinationCreateResourceID(appMonitorName, destination, destinationARN string) string {
+	parts := []string{appMonitorName, destination}
+
+	if destinationARN != "" {
+		parts = append(parts, destinationARN)
+	}
+
+	id := strings.Join(parts, metricsDestinationResourceIDSeparator)
+
+	return id
+}
+
+func MetricsDestinationParseResourceID(id string) (string, string, string, error) {
+	parts := strings.SplitN(id, metricsDestinationResourceIDSeparator, 3)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], "", nil
+	}
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected APPMONITORNAME%[2]sDESTINATION[%[2]sDESTINATIONARN]", id, metricsDestinationResourceIDSeparator)
+}
@@ -0,0 +1,378 @@
+package rum
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchrum"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceMetricsDestination() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMetricsDestinationCreate,
+		Read:   resourceMetricsDestinationRead,
+		Update: resourceMetricsDestinationUpdate,
+		Delete: resourceMetricsDestinationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"app_monitor_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"destination": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(cloudwatchrum.MetricDestination_Values(), false),
+			},
+			"destination_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"iam_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"metric_definition": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dimension_keys": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"event_pattern": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsJSON,
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"unit_label": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+						"value_key": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceMetricsDestinationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RUMConn
+
+	appMonitorName := d.Get("app_monitor_name").(string)
+	destination := d.Get("destination").(string)
+	destinationARN := d.Get("destination_arn").(string)
+	id := MetricsDestinationCreateResourceID(appMonitorName, destination, destinationARN)
+	input := &cloudwatchrum.PutRumMetricsDestinationInput{
+		AppMonitorName: aws.String(appMonitorName),
+		Destination:    aws.String(destination),
+	}
+
+	if destinationARN != "" {
+		input.DestinationArn = aws.String(destinationARN)
+	}
+
+	if v, ok := d.GetOk("iam_role_arn"); ok {
+		input.IamRoleArn = aws.String(v.(string))
+	}
+
+	_, err := conn.PutRumMetricsDestination(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating CloudWatch RUM Metrics Destination (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	if v, ok := d.GetOk("metric_definition"); ok && v.(*schema.Set).Len() > 0 {
+		if err := createMetricDefinitions(conn, appMonitorName, destination, destinationARN, v.(*schema.Set).List()); err != nil {
+			return fmt.Errorf("error creating CloudWatch RUM Metrics Destination (%s) metric definitions: %w", d.Id(), err)
+		}
+	}
+
+	return resourceMetricsDestinationRead(d, meta)
+}
+
+func resourceMetricsDestinationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RUMConn
+
+	appMonitorName, destination, destinationARN, err := MetricsDestinationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindMetricsDestinationByThreePartKey(conn, appMonitorName, destination, destinationARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch RUM Metrics Destination (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudWatch RUM Metrics Destination (%s): %w", d.Id(), err)
+	}
+
+	metricDefinitions, err := FindMetricDefinitionsByThreePartKey(conn, appMonitorName, destination, destinationARN)
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudWatch RUM Metrics Destination (%s) metric definitions: %w", d.Id(), err)
+	}
+
+	d.Set("app_monitor_name", appMonitorName)
+	d.Set("destination", output.Destination)
+	d.Set("destination_arn", output.DestinationArn)
+	d.Set("iam_role_arn", output.IamRoleArn)
+
+	if err := d.Set("metric_definition", flattenMetricDefinitions(metricDefinitions)); err != nil {
+		return fmt.Errorf("setting metric_definition: %w", err)
+	}
+
+	return nil
+}
+
+func resourceMetricsDestinationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RUMConn
+
+	appMonitorName, destination, destinationARN, err := MetricsDestinationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("iam_role_arn") {
+		input := &cloudwatchrum.PutRumMetricsDestinationInput{
+			AppMonitorName: aws.String(appMonitorName),
+			Destination:    aws.String(destination),
+		}
+
+		if destinationARN != "" {
+			input.DestinationArn = aws.String(destinationARN)
+		}
+
+		if v, ok := d.GetOk("iam_role_arn"); ok {
+			input.IamRoleArn = aws.String(v.(string))
+		}
+
+		_, err := conn.PutRumMetricsDestination(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating CloudWatch RUM Metrics Destination (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("metric_definition") {
+		o, n := d.GetChange("metric_definition")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		// Changed definitions are deleted and created again.
+		if del := os.Difference(ns); del.Len() > 0 {
+			metricDefinitions, err := FindMetricDefinitionsByThreePartKey(conn, appMonitorName, destination, destinationARN)
+
+			if err != nil {
+				return fmt.Errorf("error reading CloudWatch RUM Metrics Destination (%s) metric definitions: %w", d.Id(), err)
+			}
+
+			var ids []*string
+
+			for _, v := range metricDefinitions {
+				if del.Contains(flattenMetricDefinition(v)) {
+					ids = append(ids, v.MetricDefinitionId)
+				}
+			}
+
+			if err := deleteMetricDefinitions(conn, appMonitorName, destination, destinationARN, ids); err != nil {
+				return fmt.Errorf("error deleting CloudWatch RUM Metrics Destination (%s) metric definitions: %w", d.Id(), err)
+			}
+		}
+
+		if add := ns.Difference(os); add.Len() > 0 {
+			if err := createMetricDefinitions(conn, appMonitorName, destination, destinationARN, add.List()); err != nil {
+				return fmt.Errorf("error creating CloudWatch RUM Metrics Destination (%s) metric definitions: %w", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceMetricsDestinationRead(d, meta)
+}
+
+func resourceMetricsDestinationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RUMConn
+
+	appMonitorName, destination, destinationARN, err := MetricsDestinationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &cloudwatchrum.DeleteRumMetricsDestinationInput{
+		AppMonitorName: aws.String(appMonitorName),
+		Destination:    aws.String(destination),
+	}
+
+	if destinationARN != "" {
+		input.DestinationArn = aws.String(destinationARN)
+	}
+
+	log.Printf("[DEBUG] Deleting CloudWatch RUM Metrics Destination: %s", d.Id())
+	if _, err := conn.DeleteRumMetricsDestination(input); err != nil {
+		if tfawserr.ErrCodeEquals(err, cloudwatchrum.ErrCodeResourceNotFoundException) {
+			return nil
+		}
+		return fmt.Errorf("error deleting CloudWatch RUM Metrics Destination (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func createMetricDefinitions(conn *cloudwatchrum.CloudWatchRUM, appMonitorName, destination, destinationARN string, tfList []interface{}) error {
+	input := &cloudwatchrum.BatchCreateRumMetricDefinitionsInput{
+		AppMonitorName:    aws.String(appMonitorName),
+		Destination:       aws.String(destination),
+		MetricDefinitions: expandMetricDefinitionRequests(tfList),
+	}
+
+	if destinationARN != "" {
+		input.DestinationArn = aws.String(destinationARN)
+	}
+
+	output, err := conn.BatchCreateRumMetricDefinitions(input)
+
+	if err != nil {
+		return err
+	}
+
+	// Definitions are created independently, so any errors are reported in the response.
+	if len(output.Errors) > 0 {
+		v := output.Errors[0]
+		return fmt.Errorf("%s: %s: %s", aws.StringValue(v.MetricDefinition.Name), aws.StringValue(v.ErrorCode), aws.StringValue(v.ErrorMessage))
+	}
+
+	return nil
+}
+
+func deleteMetricDefinitions(conn *cloudwatchrum.CloudWatchRUM, appMonitorName, destination, destinationARN string, ids []*string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	input := &cloudwatchrum.BatchDeleteRumMetricDefinitionsInput{
+		AppMonitorName:      aws.String(appMonitorName),
+		Destination:         aws.String(destination),
+		MetricDefinitionIds: ids,
+	}
+
+	if destinationARN != "" {
+		input.DestinationArn = aws.String(destinationARN)
+	}
+
+	output, err := conn.BatchDeleteRumMetricDefinitions(input)
+
+	if err != nil {
+		return err
+	}
+
+	if len(output.Errors) > 0 {
+		v := output.Errors[0]
+		return fmt.Errorf("%s: %s: %s", aws.StringValue(v.MetricDefinitionId), aws.StringValue(v.ErrorCode), aws.StringValue(v.ErrorMessage))
+	}
+
+	return nil
+}
+
+func expandMetricDefinitionRequests(tfList []interface{}) []*cloudwatchrum.MetricDefinitionRequest {
+	var apiObjects []*cloudwatchrum.MetricDefinitionRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &cloudwatchrum.MetricDefinitionRequest{
+			Name: aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["dimension_keys"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.DimensionKeys = flex.ExpandStringMap(v)
+		}
+
+		if v, ok := tfMap["event_pattern"].(string); ok && v != "" {
+			apiObject.EventPattern = aws.String(v)
+		}
+
+		if v, ok := tfMap["unit_label"].(string); ok && v != "" {
+			apiObject.UnitLabel = aws.String(v)
+		}
+
+		if v, ok := tfMap["value_key"].(string); ok && v != "" {
+			apiObject.ValueKey = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenMetricDefinition(apiObject *cloudwatchrum.MetricDefinition) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"dimension_keys": flex.PointersMapToStringList(apiObject.DimensionKeys),
+		"event_pattern":  aws.StringValue(apiObject.EventPattern),
+		"name":           aws.StringValue(apiObject.Name),
+		"unit_label":     aws.StringValue(apiObject.UnitLabel),
+		"value_key":      aws.StringValue(apiObject.ValueKey),
+	}
+
+	return tfMap
+}
+
+func flattenMetricDefinitions(apiObjects []*cloudwatchrum.MetricDefinition) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenMetricDefinition(apiObject))
+	}
+
+	return tfList
+}
@@ -0,0 +1,256 @@
+package rum_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatchrum"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudwatchrum "github.com/hashicorp/terraform-provider-aws/internal/service/rum"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRUMMetricsDestination_basic(t *testing.T) {
+	var dest cloudwatchrum.MetricDestinationSummary
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rum_metrics_destination.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatchrum.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMetricsDestinationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricsDestinationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMetricsDestinationExists(resourceName, &dest),
+					resource.TestCheckResourceAttrPair(resourceName, "app_monitor_name", "aws_rum_app_monitor.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "destination", "CloudWatch"),
+					resource.TestCheckResourceAttr(resourceName, "destination_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "iam_role_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "metric_definition.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRUMMetricsDestination_disappears(t *testing.T) {
+	var dest cloudwatchrum.MetricDestinationSummary
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rum_metrics_destination.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatchrum.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMetricsDestinationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricsDestinationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMetricsDestinationExists(resourceName, &dest),
+					acctest.CheckResourceDisappears(acctest.Provider, tfcloudwatchrum.ResourceMetricsDestination(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRUMMetricsDestination_metricDefinitions(t *testing.T) {
+	var dest cloudwatchrum.MetricDestinationSummary
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rum_metrics_destination.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatchrum.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMetricsDestinationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricsDestinationConfig_metricDefinitions1(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMetricsDestinationExists(resourceName, &dest),
+					resource.TestCheckResourceAttr(resourceName, "metric_definition.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "metric_definition.*", map[string]string{
+						"dimension_keys.%":                    "1",
+						"dimension_keys.metadata.browserName": "BrowserName",
+						"name":                                "PerformanceNavigationDuration",
+						"unit_label":                          "Milliseconds",
+						"value_key":                           "event_details.duration",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMetricsDestinationConfig_metricDefinitions2(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMetricsDestinationExists(resourceName, &dest),
+					resource.TestCheckResourceAttr(resourceName, "metric_definition.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "metric_definition.*", map[string]string{
+						"dimension_keys.%":                    "1",
+						"dimension_keys.metadata.countryCode": "CountryCode",
+						"name":                                "PerformanceNavigationDuration",
+						"unit_label":                          "Milliseconds",
+						"value_key":                           "event_details.duration",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "metric_definition.*", map[string]string{
+						"dimension_keys.%": "0",
+						"name":             "JsErrorCount",
+						"unit_label":       "Count",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMetricsDestinationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RUMConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_rum_metrics_destination" {
+			continue
+		}
+
+		appMonitorName, destination, destinationARN, err := tfcloudwatchrum.MetricsDestinationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfcloudwatchrum.FindMetricsDestinationByThreePartKey(conn, appMonitorName, destination, destinationARN)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("CloudWatch RUM Metrics Destination %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckMetricsDestinationExists(n string, v *cloudwatchrum.MetricDestinationSummary) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudWatch RUM Metrics Destination ID is set")
+		}
+
+		appMonitorName, destination, destinationARN, err := tfcloudwatchrum.MetricsDestinationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RUMConn
+
+		output, err := tfcloudwatchrum.FindMetricsDestinationByThreePartKey(conn, appMonitorName, destination, destinationARN)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccMetricsDestinationConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_rum_app_monitor" "test" {
+  name   = %[1]q
+  domain = "localhost"
+}
+`, rName)
+}
+
+func testAccMetricsDestinationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccMetricsDestinationConfig_base(rName), `
+resource "aws_rum_metrics_destination" "test" {
+  app_monitor_name = aws_rum_app_monitor.test.name
+  destination      = "CloudWatch"
+}
+`)
+}
+
+func testAccMetricsDestinationConfig_metricDefinitions1(rName string) string {
+	return acctest.ConfigCompose(testAccMetricsDestinationConfig_base(rName), `
+resource "aws_rum_metrics_destination" "test" {
+  app_monitor_name = aws_rum_app_monitor.test.name
+  destination      = "CloudWatch"
+
+  metric_definition {
+    name       = "PerformanceNavigationDuration"
+    unit_label = "Milliseconds"
+    value_key  = "event_details.duration"
+
+    dimension_keys = {
+      "metadata.browserName" = "BrowserName"
+    }
+
+    event_pattern = jsonencode({
+      event_type = ["com.amazon.rum.performance_navigation_event"]
+    })
+  }
+}
+`)
+}
+
+func testAccMetricsDestinationConfig_metricDefinitions2(rName string) string {
+	return acctest.ConfigCompose(testAccMetricsDestinationConfig_base(rName), `
+resource "aws_rum_metrics_destination" "test" {
+  app_monitor_name = aws_rum_app_monitor.test.name
+  destination      = "CloudWatch"
+
+  metric_definition {
+    name       = "PerformanceNavigationDuration"
+    unit_label = "Milliseconds"
+    value_key  = "event_details.duration"
+
+    dimension_keys = {
+      "metadata.countryCode" = "CountryCode"
+    }
+
+    event_pattern = jsonencode({
+      event_type = ["com.amazon.rum.performance_navigation_event"]
+    })
+  }
+
+  metric_definition {
+    name       = "JsErrorCount"
+    unit_label = "Count"
+
+    event_pattern = jsonencode({
+      event_type = ["com.amazon.rum.js_error_event"]
+    })
+  }
+}
+`)
+}
@@ -149,9 +149,11 @@ func resourceBucketACLCreate(ctx context.Context, d *schema.ResourceData, meta i
 		input.AccessControlPolicy = expandBucketACLAccessControlPolicy(v.([]interface{}))
 	}
 
+	// AccessControlListNotSupported is returned until a change of the bucket's ownership controls
+	// that re-enables ACLs has propagated.
 	_, err := tfresource.RetryWhenAWSErrCodeEquals(2*time.Minute, func() (interface{}, error) {
 		return conn.PutBucketAclWithContext(ctx, input)
-	}, s3.ErrCodeNoSuchBucket)
+	}, s3.ErrCodeNoSuchBucket, ErrCodeAccessControlListNotSupported)
 
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error creating S3 bucket ACL for %s: %w", bucket, err))
@@ -229,7 +231,9 @@ func resourceBucketACLUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		input.ACL = aws.String(acl)
 	}
 
-	_, err = conn.PutBucketAclWithContext(ctx, input)
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(2*time.Minute, func() (interface{}, error) {
+		return conn.PutBucketAclWithContext(ctx, input)
+	}, ErrCodeAccessControlListNotSupported)
 
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error updating S3 bucket ACL (%s): %w", d.Id(), err))
@@ -1,30 +1,49 @@
 package s3
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// Optional fields supported by the S3 API but not yet enumerated by the AWS SDK.
+const (
+	inventoryOptionalFieldObjectAccessControlList = "ObjectAccessControlList"
+	inventoryOptionalFieldObjectOwner             = "ObjectOwner"
+)
+
+func inventoryOptionalField_Values() []string {
+	return append(s3.InventoryOptionalField_Values(),
+		inventoryOptionalFieldObjectAccessControlList,
+		inventoryOptionalFieldObjectOwner,
+	)
+}
+
 func ResourceBucketInventory() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceBucketInventoryPut,
-		Read:   resourceBucketInventoryRead,
-		Update: resourceBucketInventoryPut,
-		Delete: resourceBucketInventoryDelete,
+		CreateWithoutTimeout: resourceBucketInventoryPut,
+		ReadWithoutTimeout:   resourceBucketInventoryRead,
+		UpdateWithoutTimeout: resourceBucketInventoryPut,
+		DeleteWithoutTimeout: resourceBucketInventoryDelete,
+
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -166,7 +185,7 @@ func ResourceBucketInventory() *schema.Resource {
 				Optional: true,
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: validation.StringInSlice(s3.InventoryOptionalField_Values(), false),
+					ValidateFunc: validation.StringInSlice(inventoryOptionalField_Values(), false),
 				},
 				Set: schema.HashString,
 			},
@@ -174,7 +193,8 @@ func ResourceBucketInventory() *schema.Resource {
 	}
 }
 
-func resourceBucketInventoryPut(d *schema.ResourceData, meta interface{}) error {
+func resourceBucketInventoryPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).S3Conn
 	bucket := d.Get("bucket").(string)
 	name := d.Get("name").(string)
@@ -215,6 +235,8 @@ func resourceBucketInventoryPut(d *schema.ResourceData, meta interface{}) error
 		inventoryConfiguration.Destination = &s3.InventoryDestination{
 			S3BucketDestination: expandInventoryBucketDestination(bucketMap),
 		}
+
+		diags = append(diags, checkInventoryDestinationBucketPolicy(ctx, conn, bucketMap["bucket_arn"].(string))...)
 	}
 
 	input := &s3.PutBucketInventoryConfigurationInput{
@@ -225,7 +247,7 @@ func resourceBucketInventoryPut(d *schema.ResourceData, meta interface{}) error
 
 	log.Printf("[DEBUG] Putting S3 bucket inventory configuration: %s", input)
 	err := resource.Retry(propagationTimeout, func() *resource.RetryError {
-		_, err := conn.PutBucketInventoryConfiguration(input)
+		_, err := conn.PutBucketInventoryConfigurationWithContext(ctx, input)
 
 		if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
 			return resource.RetryableError(err)
@@ -239,24 +261,24 @@ func resourceBucketInventoryPut(d *schema.ResourceData, meta interface{}) error
 	})
 
 	if tfresource.TimedOut(err) {
-		_, err = conn.PutBucketInventoryConfiguration(input)
+		_, err = conn.PutBucketInventoryConfigurationWithContext(ctx, input)
 	}
 
 	if err != nil {
-		return fmt.Errorf("error putting S3 Bucket Inventory Configuration: %w", err)
+		return append(diags, diag.Errorf("putting S3 Bucket Inventory Configuration: %s", err)...)
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", bucket, name))
 
-	return resourceBucketInventoryRead(d, meta)
+	return append(diags, resourceBucketInventoryRead(ctx, d, meta)...)
 }
 
-func resourceBucketInventoryDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceBucketInventoryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).S3Conn
 
 	bucket, name, err := BucketInventoryParseID(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	input := &s3.DeleteBucketInventoryConfigurationInput{
@@ -265,7 +287,7 @@ func resourceBucketInventoryDelete(d *schema.ResourceData, meta interface{}) err
 	}
 
 	log.Printf("[DEBUG] Deleting S3 bucket inventory configuration: %s", input)
-	_, err = conn.DeleteBucketInventoryConfiguration(input)
+	_, err = conn.DeleteBucketInventoryConfigurationWithContext(ctx, input)
 
 	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
 		return nil
@@ -276,18 +298,18 @@ func resourceBucketInventoryDelete(d *schema.ResourceData, meta interface{}) err
 	}
 
 	if err != nil {
-		return fmt.Errorf("error deleting S3 Bucket Inventory Configuration (%s): %w", d.Id(), err)
+		return diag.Errorf("deleting S3 Bucket Inventory Configuration (%s): %s", d.Id(), err)
 	}
 
 	return nil
 }
 
-func resourceBucketInventoryRead(d *schema.ResourceData, meta interface{}) error {
+func resourceBucketInventoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).S3Conn
 
 	bucket, name, err := BucketInventoryParseID(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("bucket", bucket)
@@ -302,7 +324,7 @@ func resourceBucketInventoryRead(d *schema.ResourceData, meta interface{}) error
 	var output *s3.GetBucketInventoryConfigurationOutput
 	err = resource.Retry(propagationTimeout, func() *resource.RetryError {
 		var err error
-		output, err = conn.GetBucketInventoryConfiguration(input)
+		output, err = conn.GetBucketInventoryConfigurationWithContext(ctx, input)
 
 		if d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
 			return resource.RetryableError(err)
@@ -320,7 +342,7 @@ func resourceBucketInventoryRead(d *schema.ResourceData, meta interface{}) error
 	})
 
 	if tfresource.TimedOut(err) {
-		output, err = conn.GetBucketInventoryConfiguration(input)
+		output, err = conn.GetBucketInventoryConfigurationWithContext(ctx, input)
 	}
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
@@ -336,26 +358,26 @@ func resourceBucketInventoryRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if err != nil {
-		return fmt.Errorf("error getting S3 Bucket Inventory Configuration (%s): %w", d.Id(), err)
+		return diag.Errorf("reading S3 Bucket Inventory Configuration (%s): %s", d.Id(), err)
 	}
 
 	if output == nil || output.InventoryConfiguration == nil {
-		return fmt.Errorf("error getting S3 Bucket Inventory Configuration (%s): empty response", d.Id())
+		return diag.Errorf("reading S3 Bucket Inventory Configuration (%s): empty response", d.Id())
 	}
 
 	d.Set("enabled", output.InventoryConfiguration.IsEnabled)
 	d.Set("included_object_versions", output.InventoryConfiguration.IncludedObjectVersions)
 
 	if err := d.Set("optional_fields", flex.FlattenStringList(output.InventoryConfiguration.OptionalFields)); err != nil {
-		return fmt.Errorf("error setting optional_fields: %s", err)
+		return diag.Errorf("setting optional_fields: %s", err)
 	}
 
 	if err := d.Set("filter", flattenInventoryFilter(output.InventoryConfiguration.Filter)); err != nil {
-		return fmt.Errorf("error setting filter: %s", err)
+		return diag.Errorf("setting filter: %s", err)
 	}
 
 	if err := d.Set("schedule", flattenInventorySchedule(output.InventoryConfiguration.Schedule)); err != nil {
-		return fmt.Errorf("error setting schedule: %s", err)
+		return diag.Errorf("setting schedule: %s", err)
 	}
 
 	if output.InventoryConfiguration.Destination != nil {
@@ -364,13 +386,114 @@ func resourceBucketInventoryRead(d *schema.ResourceData, meta interface{}) error
 		}
 
 		if err := d.Set("destination", []map[string]interface{}{destination}); err != nil {
-			return fmt.Errorf("error setting destination: %s", err)
+			return diag.Errorf("setting destination: %s", err)
 		}
 	}
 
 	return nil
 }
 
+// checkInventoryDestinationBucketPolicy returns a warning if the destination bucket's policy
+// does not allow Amazon S3 to write inventory reports to it.
+// The check is made at apply time rather than in CustomizeDiff: CustomizeDiff can only fail a plan,
+// and the destination bucket's policy is often created in the same apply as the inventory configuration.
+// See https://docs.aws.amazon.com/AmazonS3/latest/userguide/example-bucket-policies.html#example-bucket-policies-s3-inventory-1.
+func checkInventoryDestinationBucketPolicy(ctx context.Context, conn *s3.S3, bucketARN string) diag.Diagnostics {
+	parsedARN, err := arn.Parse(bucketARN)
+
+	if err != nil {
+		return nil
+	}
+
+	bucket := parsedARN.Resource
+	output, err := conn.GetBucketPolicyWithContext(ctx, &s3.GetBucketPolicyInput{
+		Bucket: aws.String(bucket),
+	})
+
+	var policy string
+
+	switch {
+	case tfawserr.ErrCodeEquals(err, ErrCodeNoSuchBucketPolicy):
+	case err != nil:
+		// The destination bucket may be owned by another account; don't block on it.
+		log.Printf("[WARN] reading S3 Bucket (%s) policy: %s", bucket, err)
+		return nil
+	default:
+		policy = aws.StringValue(output.Policy)
+	}
+
+	if inventoryDestinationPolicyAllowsS3(policy) {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("S3 Bucket (%s) policy does not allow Amazon S3 to write inventory reports", bucket),
+			Detail:   "Inventory reports will not be delivered until the destination bucket policy grants the s3.amazonaws.com service principal s3:PutObject. See https://docs.aws.amazon.com/AmazonS3/latest/userguide/configure-inventory.html.",
+		},
+	}
+}
+
+func inventoryDestinationPolicyAllowsS3(policy string) bool {
+	if policy == "" {
+		return false
+	}
+
+	var doc tfiam.IAMPolicyDoc
+
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return false
+	}
+
+	for _, statement := range doc.Statements {
+		if statement == nil || statement.Effect != "Allow" {
+			continue
+		}
+
+		var principalOK bool
+		for _, principal := range statement.Principals {
+			if principal.Type == "*" || (principal.Type == "Service" && policyValuesContainAny(principal.Identifiers, "s3.amazonaws.com")) {
+				principalOK = true
+				break
+			}
+		}
+
+		if principalOK && policyValuesContainAny(statement.Actions, "s3:PutObject", "s3:*", "*") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func policyValuesContainAny(v interface{}, values ...string) bool {
+	var elems []string
+
+	switch v := v.(type) {
+	case string:
+		elems = []string{v}
+	case []string:
+		elems = v
+	case []interface{}:
+		for _, e := range v {
+			if e, ok := e.(string); ok {
+				elems = append(elems, e)
+			}
+		}
+	}
+
+	for _, e := range elems {
+		for _, value := range values {
+			if strings.EqualFold(e, value) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 func expandInventoryFilter(m map[string]interface{}) *s3.InventoryFilter {
 	v, ok := m["prefix"]
 	if !ok {
@@ -64,6 +64,41 @@ func TestAccS3BucketInventory_basic(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketInventory_optionalFields(t *testing.T) {
+	var conf s3.InventoryConfiguration
+	rString := sdkacctest.RandString(8)
+	resourceName := "aws_s3_bucket_inventory.test"
+
+	bucketName := fmt.Sprintf("tf-acc-bucket-inventory-%s", rString)
+	inventoryName := t.Name()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketInventoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketInventoryConfig_optionalFields(bucketName, inventoryName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketInventoryExistsConfig(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "optional_fields.#", "4"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "optional_fields.*", "BucketKeyStatus"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "optional_fields.*", "ChecksumAlgorithm"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "optional_fields.*", "ObjectAccessControlList"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "optional_fields.*", "ObjectOwner"),
+					resource.TestCheckResourceAttr(resourceName, "destination.0.bucket.0.format", "Parquet"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccS3BucketInventory_encryptWithSSES3(t *testing.T) {
 	var conf s3.InventoryConfiguration
 	rString := sdkacctest.RandString(8)
@@ -244,6 +279,63 @@ resource "aws_s3_bucket_inventory" "test" {
 `, inventoryName)
 }
 
+func testAccBucketInventoryConfig_optionalFields(bucketName, inventoryName string) string {
+	return testAccBucketInventoryBucketConfig(bucketName) + fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_s3_bucket_policy" "test" {
+  bucket = aws_s3_bucket.test.id
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "s3.amazonaws.com"
+      }
+      Action   = "s3:PutObject"
+      Resource = "${aws_s3_bucket.test.arn}/*"
+      Condition = {
+        StringEquals = {
+          "aws:SourceAccount" = data.aws_caller_identity.current.account_id
+        }
+        ArnLike = {
+          "aws:SourceArn" = aws_s3_bucket.test.arn
+        }
+      }
+    }]
+  })
+}
+
+resource "aws_s3_bucket_inventory" "test" {
+  # Ensure the destination policy exists before the configuration is put.
+  depends_on = [aws_s3_bucket_policy.test]
+
+  bucket = aws_s3_bucket.test.id
+  name   = %[1]q
+
+  included_object_versions = "Current"
+
+  optional_fields = [
+    "BucketKeyStatus",
+    "ChecksumAlgorithm",
+    "ObjectAccessControlList",
+    "ObjectOwner",
+  ]
+
+  schedule {
+    frequency = "Daily"
+  }
+
+  destination {
+    bucket {
+      format     = "Parquet"
+      bucket_arn = aws_s3_bucket.test.arn
+    }
+  }
+}
+`, inventoryName)
+}
+
 func testAccBucketInventoryConfig_encryptSSE(bucketName, inventoryName string) string {
 	return testAccBucketInventoryBucketConfig(bucketName) + fmt.Sprintf(`
 resource "aws_s3_bucket_inventory" "test" {
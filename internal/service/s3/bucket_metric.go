@@ -14,8 +14,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+var metricsFilterAtLeastOneOfKeys = []string{"filter.0.access_point", "filter.0.prefix", "filter.0.tags"}
+
 func ResourceBucketMetric() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceBucketMetricPut,
@@ -38,16 +41,22 @@ func ResourceBucketMetric() *schema.Resource {
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"access_point": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+							AtLeastOneOf: metricsFilterAtLeastOneOfKeys,
+						},
 						"prefix": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							AtLeastOneOf: filterAtLeastOneOfKeys,
+							AtLeastOneOf: metricsFilterAtLeastOneOfKeys,
 						},
 						"tags": {
 							Type:         schema.TypeMap,
 							Optional:     true,
 							Elem:         &schema.Schema{Type: schema.TypeString},
-							AtLeastOneOf: filterAtLeastOneOfKeys,
+							AtLeastOneOf: metricsFilterAtLeastOneOfKeys,
 						},
 					},
 				},
@@ -192,6 +201,11 @@ func resourceBucketMetricRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func ExpandMetricsFilter(m map[string]interface{}) *s3.MetricsFilter {
+	var accessPoint string
+	if v, ok := m["access_point"]; ok {
+		accessPoint = v.(string)
+	}
+
 	var prefix string
 	if v, ok := m["prefix"]; ok {
 		prefix = v.(string)
@@ -202,18 +216,30 @@ func ExpandMetricsFilter(m map[string]interface{}) *s3.MetricsFilter {
 		tags = Tags(tftags.New(v).IgnoreAWS())
 	}
 
+	conditions := len(tags)
+	if accessPoint != "" {
+		conditions++
+	}
+	if prefix != "" {
+		conditions++
+	}
+
 	metricsFilter := &s3.MetricsFilter{}
-	if prefix != "" && len(tags) > 0 {
-		metricsFilter.And = &s3.MetricsAndOperator{
-			Prefix: aws.String(prefix),
-			Tags:   tags,
+	if conditions > 1 {
+		metricsFilter.And = &s3.MetricsAndOperator{}
+		if accessPoint != "" {
+			metricsFilter.And.AccessPointArn = aws.String(accessPoint)
 		}
-	} else if len(tags) > 1 {
-		metricsFilter.And = &s3.MetricsAndOperator{
-			Tags: tags,
+		if prefix != "" {
+			metricsFilter.And.Prefix = aws.String(prefix)
+		}
+		if len(tags) > 0 {
+			metricsFilter.And.Tags = tags
 		}
 	} else if len(tags) == 1 {
 		metricsFilter.Tag = tags[0]
+	} else if accessPoint != "" {
+		metricsFilter.AccessPointArn = aws.String(accessPoint)
 	} else {
 		metricsFilter.Prefix = aws.String(prefix)
 	}
@@ -224,12 +250,17 @@ func FlattenMetricsFilter(metricsFilter *s3.MetricsFilter) map[string]interface{
 	m := make(map[string]interface{})
 
 	if and := metricsFilter.And; and != nil {
+		if and.AccessPointArn != nil {
+			m["access_point"] = aws.StringValue(and.AccessPointArn)
+		}
 		if and.Prefix != nil {
 			m["prefix"] = aws.StringValue(and.Prefix)
 		}
 		if and.Tags != nil {
 			m["tags"] = KeyValueTags(and.Tags).IgnoreAWS().Map()
 		}
+	} else if metricsFilter.AccessPointArn != nil {
+		m["access_point"] = aws.StringValue(metricsFilter.AccessPointArn)
 	} else if metricsFilter.Prefix != nil {
 		m["prefix"] = aws.StringValue(metricsFilter.Prefix)
 	} else if metricsFilter.Tag != nil {
@@ -111,6 +111,26 @@ func TestExpandMetricsFilter(t *testing.T) {
 				},
 			},
 		},
+		{
+			Config: map[string]interface{}{
+				"access_point": "arn:aws:s3:us-west-2:123456789012:accesspoint/test",
+			},
+			ExpectedS3MetricsFilter: &s3.MetricsFilter{
+				AccessPointArn: aws.String("arn:aws:s3:us-west-2:123456789012:accesspoint/test"),
+			},
+		},
+		{
+			Config: map[string]interface{}{
+				"access_point": "arn:aws:s3:us-west-2:123456789012:accesspoint/test",
+				"prefix":       "prefix/",
+			},
+			ExpectedS3MetricsFilter: &s3.MetricsFilter{
+				And: &s3.MetricsAndOperator{
+					AccessPointArn: aws.String("arn:aws:s3:us-west-2:123456789012:accesspoint/test"),
+					Prefix:         aws.String("prefix/"),
+				},
+			},
+		},
 	}
 
 	for i, tc := range testCases {
@@ -224,6 +244,33 @@ func TestFlattenMetricsFilter(t *testing.T) {
 				},
 			},
 		},
+		{
+			S3MetricsFilter: &s3.MetricsFilter{
+				AccessPointArn: aws.String("arn:aws:s3:us-west-2:123456789012:accesspoint/test"),
+			},
+			ExpectedConfig: map[string]interface{}{
+				"access_point": "arn:aws:s3:us-west-2:123456789012:accesspoint/test",
+			},
+		},
+		{
+			S3MetricsFilter: &s3.MetricsFilter{
+				And: &s3.MetricsAndOperator{
+					AccessPointArn: aws.String("arn:aws:s3:us-west-2:123456789012:accesspoint/test"),
+					Tags: []*s3.Tag{
+						{
+							Key:   aws.String("tag1key"),
+							Value: aws.String("tag1value"),
+						},
+					},
+				},
+			},
+			ExpectedConfig: map[string]interface{}{
+				"access_point": "arn:aws:s3:us-west-2:123456789012:accesspoint/test",
+				"tags": map[string]string{
+					"tag1key": "tag1value",
+				},
+			},
+		},
 	}
 
 	for i, tc := range testCases {
@@ -559,6 +606,51 @@ func TestAccS3BucketMetric_withFilterSingleTag(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketMetric_withFilterAccessPoint(t *testing.T) {
+	var conf s3.MetricsConfiguration
+	rInt := sdkacctest.RandInt()
+	resourceName := "aws_s3_bucket_metric.test"
+	accessPointResourceName := "aws_s3_access_point.test"
+
+	bucketName := fmt.Sprintf("tf-acc-%d", rInt)
+	metricName := t.Name()
+	prefix := fmt.Sprintf("prefix-%d/", rInt)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketMetricDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketMetricConfig_filterAccessPoint(bucketName, metricName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketMetricsExistsConfig(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "filter.0.access_point", accessPointResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.prefix", ""),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccBucketMetricConfig_filterAccessPointAndPrefix(bucketName, metricName, prefix),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketMetricsExistsConfig(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "filter.0.access_point", accessPointResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.prefix", prefix),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckBucketMetricDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
 
@@ -757,3 +849,44 @@ resource "aws_s3_bucket_metric" "test" {
 }
 `, testAccBucketMetricsBucketConfig(bucketName), metricName)
 }
+
+func testAccBucketMetricConfig_filterAccessPoint(bucketName, metricName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "aws_s3_access_point" "test" {
+  bucket = aws_s3_bucket.bucket.id
+  name   = %[2]q
+}
+
+resource "aws_s3_bucket_metric" "test" {
+  bucket = aws_s3_bucket.bucket.id
+  name   = %[3]q
+
+  filter {
+    access_point = aws_s3_access_point.test.arn
+  }
+}
+`, testAccBucketMetricsBucketConfig(bucketName), bucketName, metricName)
+}
+
+func testAccBucketMetricConfig_filterAccessPointAndPrefix(bucketName, metricName, prefix string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "aws_s3_access_point" "test" {
+  bucket = aws_s3_bucket.bucket.id
+  name   = %[2]q
+}
+
+resource "aws_s3_bucket_metric" "test" {
+  bucket = aws_s3_bucket.bucket.id
+  name   = %[3]q
+
+  filter {
+    access_point = aws_s3_access_point.test.arn
+    prefix       = %[4]q
+  }
+}
+`, testAccBucketMetricsBucketConfig(bucketName), bucketName, metricName, prefix)
+}
@@ -325,6 +325,10 @@ func resourceBucketNotificationPut(d *schema.ResourceData, meta interface{}) err
 		NotificationConfiguration: notificationConfiguration,
 	}
 
+	mutexKey := bucketNotificationMutexKey(bucket)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
 	log.Printf("[DEBUG] S3 bucket: %s, Putting notification: %v", bucket, i)
 	err := resource.Retry(propagationTimeout, func() *resource.RetryError {
 		_, err := conn.PutBucketNotificationConfiguration(i)
@@ -361,6 +365,10 @@ func resourceBucketNotificationDelete(d *schema.ResourceData, meta interface{})
 		NotificationConfiguration: &s3.NotificationConfiguration{},
 	}
 
+	mutexKey := bucketNotificationMutexKey(d.Id())
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
 	log.Printf("[DEBUG] S3 bucket: %s, Deleting notification: %v", d.Id(), i)
 	_, err := conn.PutBucketNotificationConfiguration(i)
 
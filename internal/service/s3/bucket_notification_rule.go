@@ -0,0 +1,434 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceBucketNotificationRule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBucketNotificationRuleCreate,
+		ReadWithoutTimeout:   resourceBucketNotificationRuleRead,
+		UpdateWithoutTimeout: resourceBucketNotificationRuleUpdate,
+		DeleteWithoutTimeout: resourceBucketNotificationRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"events": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"filter_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"filter_suffix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"lambda_function_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: []string{"lambda_function_arn", "queue_arn", "topic_arn"},
+			},
+			"notification_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"queue_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: []string{"lambda_function_arn", "queue_arn", "topic_arn"},
+			},
+			"topic_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: []string{"lambda_function_arn", "queue_arn", "topic_arn"},
+			},
+		},
+	}
+}
+
+func resourceBucketNotificationRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+	notificationID := d.Get("notification_id").(string)
+	id := BucketNotificationRuleCreateResourceID(bucket, notificationID)
+
+	err := updateBucketNotificationConfiguration(ctx, conn, bucket, func(config *s3.NotificationConfiguration) error {
+		if removeBucketNotificationRule(config, notificationID) {
+			return fmt.Errorf("notification configuration with ID (%s) already exists", notificationID)
+		}
+
+		addBucketNotificationRule(config, d)
+
+		return nil
+	})
+
+	if err != nil {
+		return diag.Errorf("creating S3 Bucket Notification Rule (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceBucketNotificationRuleRead(ctx, d, meta)
+}
+
+func resourceBucketNotificationRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket, notificationID, err := BucketNotificationRuleParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFoundContext(ctx, propagationTimeout, func() (interface{}, error) {
+		return FindBucketNotificationRuleByTwoPartKey(ctx, conn, bucket, notificationID)
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Bucket Notification Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading S3 Bucket Notification Rule (%s): %s", d.Id(), err)
+	}
+
+	output := outputRaw.(*s3.NotificationConfiguration)
+
+	d.Set("bucket", bucket)
+	d.Set("notification_id", notificationID)
+	d.Set("lambda_function_arn", nil)
+	d.Set("queue_arn", nil)
+	d.Set("topic_arn", nil)
+
+	var tfMap map[string]interface{}
+
+	switch {
+	case len(output.LambdaFunctionConfigurations) > 0:
+		tfMap = flattenLambdaFunctionConfigurations(output.LambdaFunctionConfigurations)[0]
+		d.Set("lambda_function_arn", tfMap["lambda_function_arn"])
+	case len(output.QueueConfigurations) > 0:
+		tfMap = flattenQueueConfigurations(output.QueueConfigurations)[0]
+		d.Set("queue_arn", tfMap["queue_arn"])
+	case len(output.TopicConfigurations) > 0:
+		tfMap = flattenTopicConfigurations(output.TopicConfigurations)[0]
+		d.Set("topic_arn", tfMap["topic_arn"])
+	}
+
+	d.Set("events", tfMap["events"])
+	d.Set("filter_prefix", tfMap["filter_prefix"])
+	d.Set("filter_suffix", tfMap["filter_suffix"])
+
+	return nil
+}
+
+func resourceBucketNotificationRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket, notificationID, err := BucketNotificationRuleParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = updateBucketNotificationConfiguration(ctx, conn, bucket, func(config *s3.NotificationConfiguration) error {
+		removeBucketNotificationRule(config, notificationID)
+		addBucketNotificationRule(config, d)
+
+		return nil
+	})
+
+	if err != nil {
+		return diag.Errorf("updating S3 Bucket Notification Rule (%s): %s", d.Id(), err)
+	}
+
+	return resourceBucketNotificationRuleRead(ctx, d, meta)
+}
+
+func resourceBucketNotificationRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket, notificationID, err := BucketNotificationRuleParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = FindBucketNotificationRuleByTwoPartKey(ctx, conn, bucket, notificationID)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading S3 Bucket Notification Rule (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Bucket Notification Rule: %s", d.Id())
+	err = updateBucketNotificationConfiguration(ctx, conn, bucket, func(config *s3.NotificationConfiguration) error {
+		if !removeBucketNotificationRule(config, notificationID) {
+			return errBucketNotificationUnchanged
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return diag.Errorf("deleting S3 Bucket Notification Rule (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const bucketNotificationRuleResourceIDSeparator = ","
+
+func BucketNotificationRuleCreateResourceID(bucket, notificationID string) string {
+	parts := []string{bucket, notificationID}
+	id := strings.Join(parts, bucketNotificationRuleResourceIDSeparator)
+
+	return id
+}
+
+func BucketNotificationRuleParseResourceID(id string) (string, string, error) {
+	// Bucket names cannot contain the separator but notification IDs can.
+	parts := strings.SplitN(id, bucketNotificationRuleResourceIDSeparator, 2)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected BUCKET%[2]sNOTIFICATION-ID", id, bucketNotificationRuleResourceIDSeparator)
+}
+
+// FindBucketNotificationRuleByTwoPartKey returns a notification configuration
+// holding only the single topic, queue or Lambda function configuration with the specified ID.
+func FindBucketNotificationRuleByTwoPartKey(ctx context.Context, conn *s3.S3, bucket, notificationID string) (*s3.NotificationConfiguration, error) {
+	input := &s3.GetBucketNotificationConfigurationRequest{
+		Bucket: aws.String(bucket),
+	}
+
+	output, err := conn.GetBucketNotificationConfigurationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, v := range output.LambdaFunctionConfigurations {
+		if aws.StringValue(v.Id) == notificationID {
+			return &s3.NotificationConfiguration{LambdaFunctionConfigurations: []*s3.LambdaFunctionConfiguration{v}}, nil
+		}
+	}
+
+	for _, v := range output.QueueConfigurations {
+		if aws.StringValue(v.Id) == notificationID {
+			return &s3.NotificationConfiguration{QueueConfigurations: []*s3.QueueConfiguration{v}}, nil
+		}
+	}
+
+	for _, v := range output.TopicConfigurations {
+		if aws.StringValue(v.Id) == notificationID {
+			return &s3.NotificationConfiguration{TopicConfigurations: []*s3.TopicConfiguration{v}}, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest: input,
+		Message:     fmt.Sprintf("notification configuration with ID (%s) not found", notificationID),
+	}
+}
+
+var errBucketNotificationUnchanged = errors.New("notification configuration unchanged")
+
+// updateBucketNotificationConfiguration performs a read-modify-write of the
+// bucket's notification configuration. Writes from this provider instance are
+// serialized per bucket and conflicting concurrent operations are retried.
+// If f returns errBucketNotificationUnchanged the configuration is not written.
+func updateBucketNotificationConfiguration(ctx context.Context, conn *s3.S3, bucket string, f func(*s3.NotificationConfiguration) error) error {
+	mutexKey := bucketNotificationMutexKey(bucket)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, propagationTimeout, func() (interface{}, error) {
+		output, err := conn.GetBucketNotificationConfigurationWithContext(ctx, &s3.GetBucketNotificationConfigurationRequest{
+			Bucket: aws.String(bucket),
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		config := &s3.NotificationConfiguration{
+			EventBridgeConfiguration:     output.EventBridgeConfiguration,
+			LambdaFunctionConfigurations: output.LambdaFunctionConfigurations,
+			QueueConfigurations:          output.QueueConfigurations,
+			TopicConfigurations:          output.TopicConfigurations,
+		}
+
+		if err := f(config); err != nil {
+			return nil, err
+		}
+
+		input := &s3.PutBucketNotificationConfigurationInput{
+			Bucket:                    aws.String(bucket),
+			NotificationConfiguration: config,
+		}
+
+		log.Printf("[DEBUG] Putting S3 Bucket (%s) Notification Configuration: %s", bucket, input)
+		return conn.PutBucketNotificationConfigurationWithContext(ctx, input)
+	}, s3.ErrCodeNoSuchBucket, ErrCodeOperationAborted)
+
+	if errors.Is(err, errBucketNotificationUnchanged) {
+		return nil
+	}
+
+	return err
+}
+
+func bucketNotificationMutexKey(bucket string) string {
+	return fmt.Sprintf("s3-bucket-notification-%s", bucket)
+}
+
+// removeBucketNotificationRule removes any notification configuration with the
+// specified ID and reports whether one was present.
+func removeBucketNotificationRule(config *s3.NotificationConfiguration, notificationID string) bool {
+	var found bool
+
+	lambdaConfigs := make([]*s3.LambdaFunctionConfiguration, 0, len(config.LambdaFunctionConfigurations))
+	for _, v := range config.LambdaFunctionConfigurations {
+		if aws.StringValue(v.Id) == notificationID {
+			found = true
+			continue
+		}
+		lambdaConfigs = append(lambdaConfigs, v)
+	}
+	config.LambdaFunctionConfigurations = lambdaConfigs
+
+	queueConfigs := make([]*s3.QueueConfiguration, 0, len(config.QueueConfigurations))
+	for _, v := range config.QueueConfigurations {
+		if aws.StringValue(v.Id) == notificationID {
+			found = true
+			continue
+		}
+		queueConfigs = append(queueConfigs, v)
+	}
+	config.QueueConfigurations = queueConfigs
+
+	topicConfigs := make([]*s3.TopicConfiguration, 0, len(config.TopicConfigurations))
+	for _, v := range config.TopicConfigurations {
+		if aws.StringValue(v.Id) == notificationID {
+			found = true
+			continue
+		}
+		topicConfigs = append(topicConfigs, v)
+	}
+	config.TopicConfigurations = topicConfigs
+
+	return found
+}
+
+func addBucketNotificationRule(config *s3.NotificationConfiguration, d *schema.ResourceData) {
+	notificationID := aws.String(d.Get("notification_id").(string))
+	events := flex.ExpandStringSet(d.Get("events").(*schema.Set))
+	filter := expandBucketNotificationRuleFilter(d.Get("filter_prefix").(string), d.Get("filter_suffix").(string))
+
+	if v, ok := d.GetOk("lambda_function_arn"); ok {
+		config.LambdaFunctionConfigurations = append(config.LambdaFunctionConfigurations, &s3.LambdaFunctionConfiguration{
+			Events:            events,
+			Filter:            filter,
+			Id:                notificationID,
+			LambdaFunctionArn: aws.String(v.(string)),
+		})
+	}
+
+	if v, ok := d.GetOk("queue_arn"); ok {
+		config.QueueConfigurations = append(config.QueueConfigurations, &s3.QueueConfiguration{
+			Events:   events,
+			Filter:   filter,
+			Id:       notificationID,
+			QueueArn: aws.String(v.(string)),
+		})
+	}
+
+	if v, ok := d.GetOk("topic_arn"); ok {
+		config.TopicConfigurations = append(config.TopicConfigurations, &s3.TopicConfiguration{
+			Events:   events,
+			Filter:   filter,
+			Id:       notificationID,
+			TopicArn: aws.String(v.(string)),
+		})
+	}
+}
+
+func expandBucketNotificationRuleFilter(prefix, suffix string) *s3.NotificationConfigurationFilter {
+	filterRules := make([]*s3.FilterRule, 0, filterRulesSliceStartLen)
+
+	if prefix != "" {
+		filterRules = append(filterRules, &s3.FilterRule{
+			Name:  aws.String(s3.FilterRuleNamePrefix),
+			Value: aws.String(prefix),
+		})
+	}
+
+	if suffix != "" {
+		filterRules = append(filterRules, &s3.FilterRule{
+			Name:  aws.String(s3.FilterRuleNameSuffix),
+			Value: aws.String(suffix),
+		})
+	}
+
+	if len(filterRules) == 0 {
+		return nil
+	}
+
+	return &s3.NotificationConfigurationFilter{
+		Key: &s3.KeyFilter{
+			FilterRules: filterRules,
+		},
+	}
+}
@@ -0,0 +1,215 @@
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccS3BucketNotificationRule_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_notification_rule.test1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketNotificationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketNotificationRuleConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketNotificationRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "notification_id", "first"),
+					resource.TestCheckResourceAttr(resourceName, "events.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter_prefix", "first/"),
+					resource.TestCheckResourceAttr(resourceName, "filter_suffix", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "queue_arn", "aws_sqs_queue.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "topic_arn", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccS3BucketNotificationRule_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_notification_rule.test1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketNotificationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketNotificationRuleConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketNotificationRuleExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfs3.ResourceBucketNotificationRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3BucketNotificationRule_multiple(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName1 := "aws_s3_bucket_notification_rule.test1"
+	resourceName2 := "aws_s3_bucket_notification_rule.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketNotificationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketNotificationRuleConfig_multiple(rName, "second/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketNotificationRuleExists(resourceName1),
+					testAccCheckBucketNotificationRuleExists(resourceName2),
+					resource.TestCheckResourceAttr(resourceName2, "filter_prefix", "second/"),
+				),
+			},
+			{
+				Config: testAccBucketNotificationRuleConfig_multiple(rName, "updated/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketNotificationRuleExists(resourceName1),
+					testAccCheckBucketNotificationRuleExists(resourceName2),
+					resource.TestCheckResourceAttr(resourceName1, "filter_prefix", "first/"),
+					resource.TestCheckResourceAttr(resourceName2, "filter_prefix", "updated/"),
+				),
+			},
+			{
+				Config: testAccBucketNotificationRuleConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketNotificationRuleExists(resourceName1),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBucketNotificationRuleExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Bucket Notification Rule ID is set")
+		}
+
+		bucket, notificationID, err := tfs3.BucketNotificationRuleParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+		_, err = tfs3.FindBucketNotificationRuleByTwoPartKey(context.Background(), conn, bucket, notificationID)
+
+		return err
+	}
+}
+
+func testAccCheckBucketNotificationRuleDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_bucket_notification_rule" {
+			continue
+		}
+
+		bucket, notificationID, err := tfs3.BucketNotificationRuleParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfs3.FindBucketNotificationRuleByTwoPartKey(context.Background(), conn, bucket, notificationID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Bucket Notification Rule %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccBucketNotificationRuleConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Principal = "*"
+      Action    = "sqs:SendMessage"
+      Resource  = "arn:${data.aws_partition.current.partition}:sqs:*:*:%[1]s"
+      Condition = {
+        ArnEquals = {
+          "aws:SourceArn" = aws_s3_bucket.test.arn
+        }
+      }
+    }]
+  })
+}
+
+resource "aws_s3_bucket_notification_rule" "test1" {
+  bucket          = aws_s3_bucket.test.id
+  notification_id = "first"
+  queue_arn       = aws_sqs_queue.test.arn
+  events          = ["s3:ObjectCreated:*"]
+  filter_prefix   = "first/"
+}
+`, rName)
+}
+
+func testAccBucketNotificationRuleConfig_basic(rName string) string {
+	return testAccBucketNotificationRuleConfig_base(rName)
+}
+
+func testAccBucketNotificationRuleConfig_multiple(rName, prefix string) string {
+	return acctest.ConfigCompose(testAccBucketNotificationRuleConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_bucket_notification_rule" "test2" {
+  bucket          = aws_s3_bucket.test.id
+  notification_id = "second"
+  queue_arn       = aws_sqs_queue.test.arn
+  events          = ["s3:ObjectRemoved:*"]
+  filter_prefix   = %[1]q
+}
+`, prefix))
+}
@@ -63,7 +63,7 @@ func resourceBucketOwnershipControlsCreate(d *schema.ResourceData, meta interfac
 		},
 	}
 
-	_, err := conn.PutBucketOwnershipControls(input)
+	err := putBucketOwnershipControls(conn, input)
 
 	if err != nil {
 		return fmt.Errorf("error creating S3 Bucket (%s) Ownership Controls: %w", bucket, err)
@@ -126,7 +126,7 @@ func resourceBucketOwnershipControlsUpdate(d *schema.ResourceData, meta interfac
 		},
 	}
 
-	_, err := conn.PutBucketOwnershipControls(input)
+	err := putBucketOwnershipControls(conn, input)
 
 	if err != nil {
 		return fmt.Errorf("error updating S3 Bucket (%s) Ownership Controls: %w", d.Id(), err)
@@ -165,6 +165,33 @@ func resourceBucketOwnershipControlsDelete(d *schema.ResourceData, meta interfac
 	return nil
 }
 
+// putBucketOwnershipControls sets the bucket's ownership controls. Enforcing bucket
+// owner ownership disables ACLs, but fails while the bucket ACL still grants access
+// to other accounts, so in that case the ACL is reset to private and the request retried.
+func putBucketOwnershipControls(conn *s3.S3, input *s3.PutBucketOwnershipControlsInput) error {
+	_, err := conn.PutBucketOwnershipControls(input)
+
+	if !tfawserr.ErrCodeEquals(err, ErrCodeInvalidBucketACLWithObjectOwnership) {
+		return err
+	}
+
+	log.Printf("[WARN] S3 Bucket (%s) ACL grants access to other accounts, resetting ACL to %s before disabling ACLs", aws.StringValue(input.Bucket), s3.BucketCannedACLPrivate)
+	_, err = conn.PutBucketAcl(&s3.PutBucketAclInput{
+		ACL:    aws.String(s3.BucketCannedACLPrivate),
+		Bucket: input.Bucket,
+	})
+
+	if err != nil {
+		return fmt.Errorf("resetting ACL: %w", err)
+	}
+
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(propagationTimeout, func() (interface{}, error) {
+		return conn.PutBucketOwnershipControls(input)
+	}, ErrCodeInvalidBucketACLWithObjectOwnership)
+
+	return err
+}
+
 func expandOwnershipControlsRules(tfList []interface{}) []*s3.OwnershipControlsRule {
 	if len(tfList) == 0 || tfList[0] == nil {
 		return nil
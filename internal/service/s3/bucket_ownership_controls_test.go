@@ -43,6 +43,45 @@ func TestAccS3BucketOwnershipControls_basic(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketOwnershipControls_migrateACL(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_ownership_controls.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketOwnershipControlsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketOwnershipControlsConfig_acl(rName, s3.ObjectOwnershipObjectWriter),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketOwnershipControlsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.object_ownership", s3.ObjectOwnershipObjectWriter),
+					resource.TestCheckResourceAttr("aws_s3_bucket_acl.test", "acl", "log-delivery-write"),
+				),
+			},
+			{
+				// The log delivery grant is removed when ACLs are disabled.
+				Config: testAccBucketOwnershipControlsConfig_ruleObject(rName, s3.ObjectOwnershipBucketOwnerEnforced),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketOwnershipControlsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.object_ownership", s3.ObjectOwnershipBucketOwnerEnforced),
+				),
+			},
+			{
+				// The ACL is applied once ACLs are re-enabled.
+				Config: testAccBucketOwnershipControlsConfig_acl(rName, s3.ObjectOwnershipObjectWriter),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketOwnershipControlsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.object_ownership", s3.ObjectOwnershipObjectWriter),
+					resource.TestCheckResourceAttr("aws_s3_bucket_acl.test", "acl", "log-delivery-write"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3BucketOwnershipControls_disappears(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_s3_bucket_ownership_controls.test"
@@ -195,3 +234,14 @@ resource "aws_s3_bucket_ownership_controls" "test" {
 }
 `, rName, objectOwnership)
 }
+
+func testAccBucketOwnershipControlsConfig_acl(rName, objectOwnership string) string {
+	return acctest.ConfigCompose(testAccBucketOwnershipControlsConfig_ruleObject(rName, objectOwnership), `
+resource "aws_s3_bucket_acl" "test" {
+  depends_on = [aws_s3_bucket_ownership_controls.test]
+
+  bucket = aws_s3_bucket.test.id
+  acl    = "log-delivery-write"
+}
+`)
+}
@@ -4,8 +4,10 @@ package s3
 // https://docs.aws.amazon.com/sdk-for-go/api/service/s3/#pkg-constants
 
 const (
+	ErrCodeAccessControlListNotSupported             = "AccessControlListNotSupported"
 	ErrCodeAccessDenied                              = "AccessDenied"
 	ErrCodeBucketNotEmpty                            = "BucketNotEmpty"
+	ErrCodeInvalidBucketACLWithObjectOwnership       = "InvalidBucketAclWithObjectOwnership"
 	ErrCodeInvalidBucketState                        = "InvalidBucketState"
 	ErrCodeInvalidRequest                            = "InvalidRequest"
 	ErrCodeMalformedPolicy                           = "MalformedPolicy"
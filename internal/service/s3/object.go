@@ -136,6 +136,16 @@ func ResourceObject() *schema.Resource {
 				Optional:     true,
 				Elem:         &schema.Schema{Type: schema.TypeString},
 			},
+			"multipart_chunk_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(objectMultipartChunkSizeMin, objectMultipartChunkSizeMax),
+			},
+			"multipart_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, objectMultipartConcurrencyMax),
+			},
 			"object_lock_legal_hold_status": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -420,7 +430,7 @@ func resourceObjectImport(d *schema.ResourceData, meta interface{}) ([]*schema.R
 
 func resourceObjectUpload(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).S3Conn
-	uploader := s3manager.NewUploaderWithClient(conn)
+	uploader := newObjectUploader(conn, d)
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -541,6 +551,27 @@ func resourceObjectUpload(d *schema.ResourceData, meta interface{}) error {
 	return resourceObjectRead(d, meta)
 }
 
+const (
+	// Part sizes are configured in MiB. S3 requires parts of at least 5 MiB and at most 5 GiB.
+	objectMultipartChunkSizeMin   = 5
+	objectMultipartChunkSizeMax   = 5 * 1024
+	objectMultipartConcurrencyMax = 64
+)
+
+// newObjectUploader returns an S3 upload manager configured from the resource's multipart arguments.
+// Objects larger than the part size are uploaded in parallel using a multipart upload.
+func newObjectUploader(conn *s3.S3, d *schema.ResourceData) *s3manager.Uploader {
+	return s3manager.NewUploaderWithClient(conn, func(u *s3manager.Uploader) {
+		if v, ok := d.GetOk("multipart_chunk_size"); ok {
+			u.PartSize = int64(v.(int)) * 1024 * 1024
+		}
+
+		if v, ok := d.GetOk("multipart_concurrency"); ok {
+			u.Concurrency = v.(int)
+		}
+	})
+}
+
 func resourceObjectSetKMS(d *schema.ResourceData, meta interface{}, sseKMSKeyId *string) error {
 	// Only set non-default KMS key ID (one that doesn't match default)
 	if sseKMSKeyId != nil {
@@ -8,6 +8,7 @@ import (
 	"reflect"
 	"regexp"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -110,6 +111,43 @@ func TestAccS3Object_source(t *testing.T) {
 	})
 }
 
+func TestAccS3Object_sourceMultipart(t *testing.T) {
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	// 11 MiB uploaded in 5 MiB parts.
+	source := testAccObjectCreateTempFile(t, strings.Repeat("0123456789abcdef", 11*1024*1024/16))
+	defer os.Remove(source)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_sourceMultipart(rName, source, 5, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(resourceName, &obj),
+					resource.TestMatchResourceAttr(resourceName, "etag", regexp.MustCompile(`-3$`)),
+					resource.TestCheckResourceAttr(resourceName, "multipart_chunk_size", "5"),
+					resource.TestCheckResourceAttr(resourceName, "multipart_concurrency", "2"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_sourceMultipart(rName, source, 6, 4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(resourceName, &obj),
+					resource.TestMatchResourceAttr(resourceName, "etag", regexp.MustCompile(`-3$`)),
+					resource.TestCheckResourceAttr(resourceName, "multipart_chunk_size", "6"),
+					resource.TestCheckResourceAttr(resourceName, "multipart_concurrency", "4"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3Object_content(t *testing.T) {
 	var obj s3.GetObjectOutput
 	resourceName := "aws_s3_object.object"
@@ -1595,6 +1633,23 @@ resource "aws_s3_object" "object" {
 `, rName, source)
 }
 
+func testAccObjectConfig_sourceMultipart(rName, source string, chunkSize, concurrency int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket                = aws_s3_bucket.test.bucket
+  key                   = "test-key"
+  source                = %[2]q
+  content_type          = "binary/octet-stream"
+  multipart_chunk_size  = %[3]d
+  multipart_concurrency = %[4]d
+}
+`, rName, source, chunkSize, concurrency)
+}
+
 func testAccObjectConfig_contentCharacteristics(rName string, source string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
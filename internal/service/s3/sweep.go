@@ -223,6 +223,7 @@ func bucketNameFilter(bucket *s3.Bucket) (bool, error) {
 	name := aws.StringValue(bucket.Name)
 
 	prefixes := []string{
+		sweep.ResourcePrefix,
 		"tf-acc",
 		"tf-object-test",
 		"tf-test",
@@ -59,3 +59,21 @@ func findScheduleGroupByName(ctx context.Context, conn *scheduler.Client, name s
 
 	return out, nil
 }
+
+func findSchedules(ctx context.Context, conn *scheduler.Client, in *scheduler.ListSchedulesInput) ([]types.ScheduleSummary, error) {
+	var out []types.ScheduleSummary
+
+	paginator := scheduler.NewListSchedulesPaginator(conn, in)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, page.Schedules...)
+	}
+
+	return out, nil
+}
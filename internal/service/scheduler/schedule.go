@@ -8,6 +8,7 @@ import (
 	"log"
 	"math"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -38,6 +39,8 @@ func ResourceSchedule() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceScheduleCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -425,6 +428,32 @@ const (
 	ResNameSchedule = "Schedule"
 )
 
+func resourceScheduleCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("target.0.arn") {
+		return nil
+	}
+
+	var configured []string
+
+	for k := range targetParametersServices {
+		if v, ok := d.Get("target.0." + k).([]interface{}); ok && len(v) > 0 {
+			configured = append(configured, k)
+		}
+	}
+
+	sort.Strings(configured)
+
+	if err := validateTargetParameters(d.Get("target.0.arn").(string), configured); err != nil {
+		return err
+	}
+
+	if !d.NewValueKnown("target.0.input") {
+		return nil
+	}
+
+	return validateUniversalTargetInput(d.Get("target.0.arn").(string), d.Get("target.0.input").(string))
+}
+
 func resourceScheduleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).SchedulerClient
 
@@ -1555,6 +1555,27 @@ func TestAccSchedulerSchedule_targetSQSParameters(t *testing.T) {
 	})
 }
 
+func TestAccSchedulerSchedule_targetParametersMismatch(t *testing.T) {
+	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.SchedulerEndpointID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccScheduleConfig_targetParametersMismatch(name),
+				ExpectError: regexp.MustCompile(`kinesis_parameters can only be used with kinesis targets`),
+			},
+		},
+	})
+}
+
 func testAccCheckScheduleDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).SchedulerClient
 	ctx := context.Background()
@@ -2559,3 +2580,31 @@ resource "aws_scheduler_schedule" "test" {
 `, name, messageGroupId),
 	)
 }
+
+func testAccScheduleConfig_targetParametersMismatch(name string) string {
+	return acctest.ConfigCompose(
+		testAccScheduleConfig_base,
+		fmt.Sprintf(`
+data "aws_region" "main" {}
+
+resource "aws_scheduler_schedule" "test" {
+  name = %[1]q
+
+  flexible_time_window {
+    mode = "OFF"
+  }
+
+  schedule_expression = "rate(1 hour)"
+
+  target {
+    arn      = "arn:${data.aws_partition.main.partition}:sqs:${data.aws_region.main.name}:${data.aws_caller_identity.main.account_id}:test"
+    role_arn = aws_iam_role.test.arn
+
+    kinesis_parameters {
+      partition_key = "test"
+    }
+  }
+}
+`, name),
+	)
+}
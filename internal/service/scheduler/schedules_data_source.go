@@ -0,0 +1,97 @@
+package scheduler
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func DataSourceSchedules() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceSchedulesRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringLenBetween(1, 64),
+				),
+			},
+			"name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[0-9a-zA-Z-_.]+$`), `The name prefix must consist of alphanumerics, hyphens, and underscores.`),
+				)),
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"state": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ScheduleState](),
+			},
+		},
+	}
+}
+
+const (
+	DSNameSchedules = "Schedules Data Source"
+)
+
+func dataSourceSchedulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SchedulerClient
+
+	in := &scheduler.ListSchedulesInput{}
+
+	if v, ok := d.Get("group_name").(string); ok && v != "" {
+		in.GroupName = aws.String(v)
+	}
+
+	if v, ok := d.Get("name_prefix").(string); ok && v != "" {
+		in.NamePrefix = aws.String(v)
+	}
+
+	if v, ok := d.Get("state").(string); ok && v != "" {
+		in.State = types.ScheduleState(v)
+	}
+
+	out, err := findSchedules(ctx, conn, in)
+
+	if err != nil {
+		return create.DiagError(names.Scheduler, create.ErrActionReading, DSNameSchedules, "", err)
+	}
+
+	var arns, scheduleNames []string
+
+	for _, v := range out {
+		arns = append(arns, aws.ToString(v.Arn))
+		scheduleNames = append(scheduleNames, aws.ToString(v.Name))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("arns", arns)
+	d.Set("names", scheduleNames)
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package scheduler_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSchedulerSchedulesDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_scheduler_schedules.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.SchedulerEndpointID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchedulesDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "arns.*", "aws_scheduler_schedule.test.0", "arn"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "arns.*", "aws_scheduler_schedule.test.1", "arn"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "names.*", "aws_scheduler_schedule.test.0", "name"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "names.*", "aws_scheduler_schedule.test.1", "name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSchedulerSchedulesDataSource_namePrefix(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_scheduler_schedules.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.SchedulerEndpointID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchedulesDataSourceConfig_namePrefix(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arns.0", "aws_scheduler_schedule.test.1", "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "names.0", "aws_scheduler_schedule.test.1", "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSchedulesDataSourceConfig_base(rName string) string {
+	return acctest.ConfigCompose(
+		testAccScheduleConfig_base,
+		fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {}
+
+resource "aws_scheduler_schedule_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_scheduler_schedule" "test" {
+  count = 2
+
+  name       = "%[1]s-${count.index}"
+  group_name = aws_scheduler_schedule_group.test.name
+
+  flexible_time_window {
+    mode = "OFF"
+  }
+
+  schedule_expression = "rate(1 hour)"
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+}
+`, rName))
+}
+
+func testAccSchedulesDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccSchedulesDataSourceConfig_base(rName),
+		`
+data "aws_scheduler_schedules" "test" {
+  group_name = aws_scheduler_schedule_group.test.name
+
+  depends_on = [aws_scheduler_schedule.test]
+}
+`)
+}
+
+func testAccSchedulesDataSourceConfig_namePrefix(rName string) string {
+	return acctest.ConfigCompose(
+		testAccSchedulesDataSourceConfig_base(rName),
+		fmt.Sprintf(`
+data "aws_scheduler_schedules" "test" {
+  group_name  = aws_scheduler_schedule_group.test.name
+  name_prefix = "%[1]s-1"
+
+  depends_on = [aws_scheduler_schedule.test]
+}
+`, rName))
+}
@@ -0,0 +1,78 @@
+package scheduler
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+// targetParametersServices maps each templated target parameters block to the
+// service of the target it configures.
+var targetParametersServices = map[string]string{
+	"ecs_parameters":                "ecs",
+	"eventbridge_parameters":        "events",
+	"kinesis_parameters":            "kinesis",
+	"sagemaker_pipeline_parameters": "sagemaker",
+	"sqs_parameters":                "sqs",
+}
+
+const universalTargetResourcePrefix = "aws-sdk:"
+
+// isUniversalTarget returns whether the target ARN is a universal target,
+// i.e. of the form arn:aws:scheduler:::aws-sdk:service:apiAction.
+func isUniversalTarget(targetARN arn.ARN) bool {
+	return targetARN.Service == "scheduler" && strings.HasPrefix(targetARN.Resource, universalTargetResourcePrefix)
+}
+
+// validateTargetParameters checks that each configured target parameters block
+// matches the service of the target.
+func validateTargetParameters(targetARN string, configured []string) error {
+	parsedARN, err := arn.Parse(targetARN)
+
+	if err != nil {
+		// Invalid ARNs are reported by the attribute's own validation.
+		return nil
+	}
+
+	if isUniversalTarget(parsedARN) {
+		parts := strings.Split(strings.TrimPrefix(parsedARN.Resource, universalTargetResourcePrefix), ":")
+
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return fmt.Errorf("universal target ARN (%s) must be of the form arn:%s:scheduler:::aws-sdk:service:apiAction", targetARN, parsedARN.Partition)
+		}
+
+		if len(configured) > 0 {
+			return fmt.Errorf("%s cannot be used with universal target (%s): configure the API parameters in input", strings.Join(configured, ", "), targetARN)
+		}
+
+		return nil
+	}
+
+	for _, k := range configured {
+		if service := targetParametersServices[k]; service != parsedARN.Service {
+			return fmt.Errorf("%s can only be used with %s targets, got %s target (%s)", k, service, parsedARN.Service, targetARN)
+		}
+	}
+
+	return nil
+}
+
+// validateUniversalTargetInput checks that the input of a universal target is
+// a JSON object holding the parameters of the API action.
+func validateUniversalTargetInput(targetARN, input string) error {
+	parsedARN, err := arn.Parse(targetARN)
+
+	if err != nil || !isUniversalTarget(parsedARN) || input == "" {
+		return nil
+	}
+
+	var v map[string]interface{}
+
+	if err := json.Unmarshal([]byte(input), &v); err != nil {
+		return fmt.Errorf("input for universal target (%s) must be a JSON object: %w", targetARN, err)
+	}
+
+	return nil
+}
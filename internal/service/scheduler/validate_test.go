@@ -0,0 +1,139 @@
+package scheduler
+
+import (
+	"testing"
+)
+
+func TestValidateTargetParameters(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		targetARN  string
+		configured []string
+		expectErr  bool
+	}{
+		{
+			name:      "no parameters",
+			targetARN: "arn:aws:sqs:us-east-1:123456789012:queue",
+		},
+		{
+			name:       "matching service",
+			targetARN:  "arn:aws:sqs:us-east-1:123456789012:queue.fifo",
+			configured: []string{"sqs_parameters"},
+		},
+		{
+			name:       "ecs cluster",
+			targetARN:  "arn:aws:ecs:us-east-1:123456789012:cluster/test",
+			configured: []string{"ecs_parameters"},
+		},
+		{
+			name:       "event bus",
+			targetARN:  "arn:aws:events:us-east-1:123456789012:event-bus/test",
+			configured: []string{"eventbridge_parameters"},
+		},
+		{
+			name:       "mismatched service",
+			targetARN:  "arn:aws:sqs:us-east-1:123456789012:queue",
+			configured: []string{"kinesis_parameters"},
+			expectErr:  true,
+		},
+		{
+			name:       "mixed services",
+			targetARN:  "arn:aws:kinesis:us-east-1:123456789012:stream/test",
+			configured: []string{"kinesis_parameters", "sqs_parameters"},
+			expectErr:  true,
+		},
+		{
+			name:      "universal target",
+			targetARN: "arn:aws:scheduler:::aws-sdk:sqs:sendMessage",
+		},
+		{
+			name:       "universal target with parameters",
+			targetARN:  "arn:aws:scheduler:::aws-sdk:sqs:sendMessage",
+			configured: []string{"sqs_parameters"},
+			expectErr:  true,
+		},
+		{
+			name:      "universal target without action",
+			targetARN: "arn:aws:scheduler:::aws-sdk:sqs",
+			expectErr: true,
+		},
+		{
+			name:      "invalid ARN",
+			targetARN: "not-an-arn",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateTargetParameters(testCase.targetARN, testCase.configured)
+
+			if err != nil && !testCase.expectErr {
+				t.Errorf("got unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.expectErr {
+				t.Error("expected error, got none")
+			}
+		})
+	}
+}
+
+func TestValidateUniversalTargetInput(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		targetARN string
+		input     string
+		expectErr bool
+	}{
+		{
+			name:      "templated target with text input",
+			targetARN: "arn:aws:sqs:us-east-1:123456789012:queue",
+			input:     "test",
+		},
+		{
+			name:      "universal target without input",
+			targetARN: "arn:aws:scheduler:::aws-sdk:sqs:sendMessage",
+		},
+		{
+			name:      "universal target with object input",
+			targetARN: "arn:aws:scheduler:::aws-sdk:sqs:sendMessage",
+			input:     `{"MessageBody":"test","QueueUrl":"https://sqs.us-east-1.amazonaws.com/123456789012/queue"}`,
+		},
+		{
+			name:      "universal target with text input",
+			targetARN: "arn:aws:scheduler:::aws-sdk:sqs:sendMessage",
+			input:     "test",
+			expectErr: true,
+		},
+		{
+			name:      "universal target with array input",
+			targetARN: "arn:aws:scheduler:::aws-sdk:sqs:sendMessage",
+			input:     `["test"]`,
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateUniversalTargetInput(testCase.targetARN, testCase.input)
+
+			if err != nil && !testCase.expectErr {
+				t.Errorf("got unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.expectErr {
+				t.Error("expected error, got none")
+			}
+		})
+	}
+}
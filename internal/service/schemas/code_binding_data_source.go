@@ -0,0 +1,157 @@
+package schemas
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	codeBindingLanguageGo1         = "Go1"
+	codeBindingLanguageJava8       = "Java8"
+	codeBindingLanguagePython36    = "Python36"
+	codeBindingLanguageTypeScript3 = "TypeScript3"
+)
+
+func codeBindingLanguage_Values() []string {
+	return []string{
+		codeBindingLanguageGo1,
+		codeBindingLanguageJava8,
+		codeBindingLanguagePython36,
+		codeBindingLanguageTypeScript3,
+	}
+}
+
+func DataSourceCodeBinding() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCodeBindingRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(codeBindingCreatedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"language": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(codeBindingLanguage_Values(), false),
+			},
+			"last_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"registry_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"schema_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"schema_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"source_base64": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCodeBindingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SchemasConn
+
+	registryName := d.Get("registry_name").(string)
+	schemaName := d.Get("schema_name").(string)
+	language := d.Get("language").(string)
+	schemaVersion := d.Get("schema_version").(string)
+	id := strings.Join([]string{registryName, schemaName, language, schemaVersion}, ",")
+
+	output, err := FindCodeBinding(ctx, conn, registryName, schemaName, language, schemaVersion)
+
+	// Code bindings are generated on first use.
+	if tfresource.NotFound(err) || (err == nil && aws.StringValue(output.Status) == schemas.CodeGenerationStatusCreateFailed) {
+		input := &schemas.PutCodeBindingInput{
+			Language:     aws.String(language),
+			RegistryName: aws.String(registryName),
+			SchemaName:   aws.String(schemaName),
+		}
+
+		if schemaVersion != "" {
+			input.SchemaVersion = aws.String(schemaVersion)
+		}
+
+		_, err = conn.PutCodeBindingWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("generating EventBridge Schemas Code Binding (%s): %s", id, err)
+		}
+
+		output = nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading EventBridge Schemas Code Binding (%s): %s", id, err)
+	}
+
+	if output == nil || aws.StringValue(output.Status) == schemas.CodeGenerationStatusCreateInProgress {
+		output, err = waitCodeBindingCreated(ctx, conn, registryName, schemaName, language, schemaVersion, d.Timeout(schema.TimeoutRead))
+
+		if err != nil {
+			return diag.Errorf("waiting for EventBridge Schemas Code Binding (%s) create: %s", id, err)
+		}
+	}
+
+	input := &schemas.GetCodeBindingSourceInput{
+		Language:     aws.String(language),
+		RegistryName: aws.String(registryName),
+		SchemaName:   aws.String(schemaName),
+	}
+
+	if schemaVersion != "" {
+		input.SchemaVersion = aws.String(schemaVersion)
+	}
+
+	source, err := conn.GetCodeBindingSourceWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("reading EventBridge Schemas Code Binding (%s) source: %s", id, err)
+	}
+
+	d.SetId(id)
+	if output.CreationDate != nil {
+		d.Set("creation_date", aws.TimeValue(output.CreationDate).Format(time.RFC3339))
+	} else {
+		d.Set("creation_date", nil)
+	}
+	if output.LastModified != nil {
+		d.Set("last_modified", aws.TimeValue(output.LastModified).Format(time.RFC3339))
+	} else {
+		d.Set("last_modified", nil)
+	}
+	d.Set("schema_version", output.SchemaVersion)
+	d.Set("source_base64", base64.StdEncoding.EncodeToString(source.Body))
+	d.Set("status", output.Status)
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package schemas_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/schemas"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSchemasCodeBindingDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_schemas_code_binding.test"
+	resourceName := "aws_schemas_schema.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(schemas.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, schemas.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCodeBindingDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "language", "Python36"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "registry_name", resourceName, "registry_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "schema_name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "schema_version", resourceName, "version"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "creation_date"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "source_base64"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", schemas.CodeGenerationStatusCreateComplete),
+				),
+			},
+		},
+	})
+}
+
+func testAccCodeBindingDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccSchemaConfig_basic(rName), fmt.Sprintf(`
+data "aws_schemas_code_binding" "test" {
+  registry_name  = aws_schemas_schema.test.registry_name
+  schema_name    = aws_schemas_schema.test.name
+  schema_version = aws_schemas_schema.test.version
+  language       = %[1]q
+}
+`, "Python36"))
+}
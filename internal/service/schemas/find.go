@@ -110,3 +110,34 @@ func FindRegistryPolicyByName(ctx context.Context, conn *schemas.Schemas, name s
 
 	return output, nil
 }
+
+func FindCodeBinding(ctx context.Context, conn *schemas.Schemas, registryName, schemaName, language, schemaVersion string) (*schemas.DescribeCodeBindingOutput, error) {
+	input := &schemas.DescribeCodeBindingInput{
+		Language:     aws.String(language),
+		RegistryName: aws.String(registryName),
+		SchemaName:   aws.String(schemaName),
+	}
+
+	if schemaVersion != "" {
+		input.SchemaVersion = aws.String(schemaVersion)
+	}
+
+	output, err := conn.DescribeCodeBindingWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, schemas.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
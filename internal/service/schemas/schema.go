@@ -17,6 +17,13 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// Schema type missing from the AWS SDK for Go.
+const typeJSONSchemaDraft4 = "JSONSchemaDraft4"
+
+func schemaType_Values() []string {
+	return append(schemas.Type_Values(), typeJSONSchemaDraft4)
+}
+
 func ResourceSchema() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceSchemaCreate,
@@ -34,8 +41,12 @@ func ResourceSchema() *schema.Resource {
 			},
 
 			"content": {
-				Type:             schema.TypeString,
-				Required:         true,
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 100000),
+					validation.StringIsJSON,
+				),
 				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
 			},
 
@@ -69,7 +80,7 @@ func ResourceSchema() *schema.Resource {
 			"type": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: validation.StringInSlice(schemas.Type_Values(), true),
+				ValidateFunc: validation.StringInSlice(schemaType_Values(), true),
 			},
 
 			"version": {
@@ -150,7 +161,12 @@ func resourceSchemaRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("arn", output.SchemaArn)
-	d.Set("content", output.Content)
+	// Keep the configured content if semantically equivalent, as the service may reformat it.
+	content := aws.StringValue(output.Content)
+	if old := d.Get("content").(string); old != "" && verify.JSONBytesEqual([]byte(old), []byte(content)) {
+		content = old
+	}
+	d.Set("content", content)
 	d.Set("description", output.Description)
 	if output.LastModified != nil {
 		d.Set("last_modified", aws.TimeValue(output.LastModified).Format(time.RFC3339))
@@ -0,0 +1,26 @@
+package schemas
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusCodeBinding(ctx context.Context, conn *schemas.Schemas, registryName, schemaName, language, schemaVersion string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindCodeBinding(ctx, conn, registryName, schemaName, language, schemaVersion)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
@@ -0,0 +1,31 @@
+package schemas
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	codeBindingCreatedTimeout = 5 * time.Minute
+)
+
+func waitCodeBindingCreated(ctx context.Context, conn *schemas.Schemas, registryName, schemaName, language, schemaVersion string, timeout time.Duration) (*schemas.DescribeCodeBindingOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{schemas.CodeGenerationStatusCreateInProgress},
+		Target:  []string{schemas.CodeGenerationStatusCreateComplete},
+		Refresh: statusCodeBinding(ctx, conn, registryName, schemaName, language, schemaVersion),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*schemas.DescribeCodeBindingOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -1,6 +1,7 @@
 package serverlessrepo
 
 import ( // nosemgrep:ci.aws-sdk-go-multiple-service-imports
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -59,7 +60,8 @@ func ResourceCloudFormationStack() *schema.Resource {
 			},
 			"capabilities": {
 				Type:     schema.TypeSet,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
 					ValidateFunc: validation.StringInSlice(serverlessrepo.Capability_Values(), false),
@@ -186,7 +188,7 @@ func resourceCloudFormationStackRead(d *schema.ResourceData, meta interface{}) e
 
 	version := getApplicationOutput.Version
 
-	if err = d.Set("parameters", flattenNonDefaultCloudFormationParameters(stack.Parameters, version.ParameterDefinitions)); err != nil {
+	if err = d.Set("parameters", flattenNonDefaultCloudFormationParameters(stack.Parameters, version.ParameterDefinitions, d.Get("parameters").(map[string]interface{}))); err != nil {
 		return fmt.Errorf("failed to set parameters: %w", err)
 	}
 
@@ -197,13 +199,29 @@ func resourceCloudFormationStackRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func flattenNonDefaultCloudFormationParameters(cfParams []*cloudformation.Parameter, rawParameterDefinitions []*serverlessrepo.ParameterDefinition) map[string]interface{} {
+// flattenNonDefaultCloudFormationParameters returns the stack parameters whose values differ from the
+// application's defaults. Parameters that are explicitly configured are always returned, and the configured
+// value is kept for NoEcho parameters as CloudFormation masks their values.
+func flattenNonDefaultCloudFormationParameters(cfParams []*cloudformation.Parameter, rawParameterDefinitions []*serverlessrepo.ParameterDefinition, configuredParams map[string]interface{}) map[string]interface{} {
 	parameterDefinitions := flattenParameterDefinitions(rawParameterDefinitions)
 	params := make(map[string]interface{}, len(cfParams))
 	for _, p := range cfParams {
 		key := aws.StringValue(p.ParameterKey)
 		value := aws.StringValue(p.ParameterValue)
-		if value != aws.StringValue(parameterDefinitions[key].DefaultValue) {
+		configuredValue, configured := configuredParams[key]
+
+		var defaultValue string
+		if v, ok := parameterDefinitions[key]; ok {
+			if aws.BoolValue(v.NoEcho) {
+				if configured {
+					params[key] = configuredValue
+				}
+				continue
+			}
+			defaultValue = aws.StringValue(v.DefaultValue)
+		}
+
+		if configured || value != defaultValue {
 			params[key] = value
 		}
 	}
@@ -304,14 +322,29 @@ func createCloudFormationChangeSet(d *schema.ResourceData, client *conns.AWSClie
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
 	stackName := d.Get("name").(string)
+	applicationID := d.Get("application_id").(string)
 	changeSetRequest := serverlessrepo.CreateCloudFormationChangeSetRequest{
 		StackName:     aws.String(stackName),
-		ApplicationId: aws.String(d.Get("application_id").(string)),
-		Capabilities:  flex.ExpandStringSet(d.Get("capabilities").(*schema.Set)),
+		ApplicationId: aws.String(applicationID),
 		Tags:          Tags(tags.IgnoreServerlessApplicationRepository()),
 	}
+	var semanticVersion string
 	if v, ok := d.GetOk("semantic_version"); ok {
-		changeSetRequest.SemanticVersion = aws.String(v.(string))
+		semanticVersion = v.(string)
+		changeSetRequest.SemanticVersion = aws.String(semanticVersion)
+	}
+	if v := d.GetRawConfig().GetAttr("capabilities"); v.IsKnown() && !v.IsNull() {
+		changeSetRequest.Capabilities = flex.ExpandStringSet(d.Get("capabilities").(*schema.Set))
+	} else {
+		// Default to the capabilities required by the application version being deployed.
+		getApplicationOutput, err := findApplication(serverlessConn, applicationID, semanticVersion)
+		if err != nil {
+			return nil, fmt.Errorf("getting Serverless Application Repository application (%s, v%s): %w", applicationID, semanticVersion, err)
+		}
+
+		if getApplicationOutput.Version != nil {
+			changeSetRequest.Capabilities = getApplicationOutput.Version.RequiredCapabilities
+		}
 	}
 	if v, ok := d.GetOk("parameters"); ok {
 		changeSetRequest.ParameterOverrides = expandCloudFormationChangeSetParameters(v.(map[string]interface{}))
@@ -323,7 +356,7 @@ func createCloudFormationChangeSet(d *schema.ResourceData, client *conns.AWSClie
 		return nil, err
 	}
 
-	return tfcloudformation.WaitChangeSetCreated(cfConn, aws.StringValue(changeSetResponse.StackId), aws.StringValue(changeSetResponse.ChangeSetId))
+	return tfcloudformation.WaitChangeSetCreated(context.Background(), cfConn, aws.StringValue(changeSetResponse.StackId), aws.StringValue(changeSetResponse.ChangeSetId))
 }
 
 func expandCloudFormationChangeSetParameters(params map[string]interface{}) []*serverlessrepo.ParameterValue {
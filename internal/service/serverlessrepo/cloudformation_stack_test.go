@@ -74,6 +74,31 @@ func TestAccServerlessRepoCloudFormationStack_basic(t *testing.T) {
 	})
 }
 
+func TestAccServerlessRepoCloudFormationStack_capabilitiesComputed(t *testing.T) {
+	var stack cloudformation.Stack
+	stackName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	appARN := testAccCloudFormationApplicationID()
+	resourceName := "aws_serverlessapplicationrepository_cloudformation_stack.postgres-rotator"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, serverlessrepo.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCloudFormationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudFormationStackConfig_capabilitiesComputed(stackName, appARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "capabilities.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "capabilities.*", "CAPABILITY_IAM"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "capabilities.*", "CAPABILITY_RESOURCE_POLICY"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccServerlessRepoCloudFormationStack_disappears(t *testing.T) {
 	var stack cloudformation.Stack
 	stackName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -354,6 +379,24 @@ resource "aws_serverlessapplicationrepository_cloudformation_stack" "postgres-ro
 `, stackName, appARN)
 }
 
+func testAccCloudFormationStackConfig_capabilitiesComputed(stackName, appARN string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_serverlessapplicationrepository_cloudformation_stack" "postgres-rotator" {
+  name           = %[1]q
+  application_id = %[2]q
+
+  parameters = {
+    functionName = "func-%[1]s"
+    endpoint     = "secretsmanager.${data.aws_region.current.name}.${data.aws_partition.current.dns_suffix}"
+  }
+}
+`, stackName, appARN)
+}
+
 func testAccCloudFormationStackConfig_updateInitial(stackName, appARN, functionName string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
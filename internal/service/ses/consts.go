@@ -0,0 +1,9 @@
+package ses
+
+import (
+	"time"
+)
+
+const (
+	propagationTimeout = 2 * time.Minute
+)
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -82,14 +83,20 @@ func ResourceEventDestination() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"role_arn": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								verify.ValidARN,
+								validARNServiceResource("iam", "role/"),
+							),
 						},
 						"stream_arn": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								verify.ValidARN,
+								validARNServiceResource("firehose", "deliverystream/"),
+							),
 						},
 					},
 				},
@@ -178,7 +185,15 @@ func resourceEventDestinationCreate(d *schema.ResourceData, meta interface{}) er
 		log.Printf("[DEBUG] Creating sns destination: %#v", sns)
 	}
 
-	_, err := conn.CreateConfigurationSetEventDestination(createOpts)
+	// SES validates that it can assume the Firehose role, which may not have propagated yet.
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(propagationTimeout, func() (interface{}, error) {
+		return conn.CreateConfigurationSetEventDestination(createOpts)
+	}, ses.ErrCodeInvalidFirehoseDestinationException)
+
+	if v := createOpts.EventDestination.KinesisFirehoseDestination; v != nil && tfawserr.ErrCodeEquals(err, ses.ErrCodeInvalidFirehoseDestinationException) {
+		return fmt.Errorf("Error creating SES configuration set event destination: %s. Verify that IAM role (%s) trusts ses.amazonaws.com and allows firehose:PutRecordBatch on delivery stream (%s)", err, aws.StringValue(v.IAMRoleARN), aws.StringValue(v.DeliveryStreamARN))
+	}
+
 	if err != nil {
 		return fmt.Errorf("Error creating SES configuration set event destination: %s", err)
 	}
@@ -336,3 +351,28 @@ func flattenSNSDestination(destination *ses.SNSDestination) []interface{} {
 
 	return []interface{}{mDestination}
 }
+
+// validARNServiceResource validates that an ARN belongs to the given service and
+// that its resource begins with the given prefix.
+func validARNServiceResource(service, resourcePrefix string) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value, ok := v.(string)
+
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		parsedARN, err := arn.Parse(value)
+
+		if err != nil {
+			return
+		}
+
+		if parsedARN.Service != service || !strings.HasPrefix(parsedARN.Resource, resourcePrefix) {
+			errors = append(errors, fmt.Errorf("%q (%s) must be an ARN of the form arn:PARTITION:%s:REGION:ACCOUNT:%s...", k, value, service, resourcePrefix))
+		}
+
+		return
+	}
+}
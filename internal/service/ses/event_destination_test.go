@@ -2,6 +2,7 @@ package ses_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -102,6 +103,32 @@ func TestAccSESEventDestination_disappears(t *testing.T) {
 	})
 }
 
+func TestAccSESEventDestination_kinesisInvalidARNs(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	roleARN := fmt.Sprintf("arn:%s:iam::123456789012:role/%s", acctest.Partition(), rName)
+	streamARN := fmt.Sprintf("arn:%s:firehose:%s:123456789012:deliverystream/%s", acctest.Partition(), acctest.Region(), rName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ses.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventDestinationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEventDestinationConfig_kinesisARNs(rName, streamARN, streamARN),
+				ExpectError: regexp.MustCompile(`must be an ARN of the form arn:PARTITION:iam:REGION:ACCOUNT:role/`),
+			},
+			{
+				Config:      testAccEventDestinationConfig_kinesisARNs(rName, roleARN, roleARN),
+				ExpectError: regexp.MustCompile(`must be an ARN of the form arn:PARTITION:firehose:REGION:ACCOUNT:deliverystream/`),
+			},
+		},
+	})
+}
+
 func testAccCheckEventDestinationDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).SESConn
 
@@ -282,3 +309,22 @@ resource "aws_ses_event_destination" "sns" {
 }
 `, rName1, rName2, rName3)
 }
+
+func testAccEventDestinationConfig_kinesisARNs(rName, streamARN, roleARN string) string {
+	return fmt.Sprintf(`
+resource "aws_ses_configuration_set" "test" {
+  name = %[1]q
+}
+
+resource "aws_ses_event_destination" "test" {
+  name                   = %[1]q
+  configuration_set_name = aws_ses_configuration_set.test.name
+  matching_types         = ["bounce", "send"]
+
+  kinesis_destination {
+    stream_arn = %[2]q
+    role_arn   = %[3]q
+  }
+}
+`, rName, streamARN, roleARN)
+}
@@ -2,6 +2,7 @@ package ses
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -104,8 +105,9 @@ func ResourceReceiptRule() *schema.Resource {
 						},
 
 						"position": {
-							Type:     schema.TypeInt,
-							Required: true,
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
 						},
 					},
 				},
@@ -152,8 +154,9 @@ func ResourceReceiptRule() *schema.Resource {
 						},
 
 						"position": {
-							Type:     schema.TypeInt,
-							Required: true,
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
 						},
 					},
 				},
@@ -203,8 +206,9 @@ func ResourceReceiptRule() *schema.Resource {
 						},
 
 						"position": {
-							Type:     schema.TypeInt,
-							Required: true,
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
 						},
 					},
 				},
@@ -302,8 +306,9 @@ func ResourceReceiptRule() *schema.Resource {
 						},
 
 						"position": {
-							Type:     schema.TypeInt,
-							Required: true,
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
 						},
 					},
 				},
@@ -336,8 +341,9 @@ func ResourceReceiptRule() *schema.Resource {
 						},
 
 						"position": {
-							Type:     schema.TypeInt,
-							Required: true,
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
 						},
 					},
 				},
@@ -374,8 +380,9 @@ func ResourceReceiptRule() *schema.Resource {
 						},
 
 						"position": {
-							Type:     schema.TypeInt,
-							Required: true,
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
 						},
 					},
 				},
@@ -394,9 +401,55 @@ func ResourceReceiptRule() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: customizeDiffActionPositions,
 	}
 }
 
+// receiptRuleActionTypes are the typed action blocks that share a single position sequence.
+var receiptRuleActionTypes = []string{
+	"add_header_action",
+	"bounce_action",
+	"lambda_action",
+	"s3_action",
+	"sns_action",
+	"stop_action",
+	"workmail_action",
+}
+
+// customizeDiffActionPositions ensures that action positions across all typed
+// action blocks are unique and run consecutively from 1. The API only records
+// the order of actions, so duplicate positions would silently drop an action
+// and gaps would cause a perpetual difference.
+func customizeDiffActionPositions(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	positions := make(map[int]string)
+
+	for _, actionType := range receiptRuleActionTypes {
+		for _, v := range diff.Get(actionType).(*schema.Set).List() {
+			position := v.(map[string]interface{})["position"].(int)
+
+			// Position is not yet known.
+			if position == 0 {
+				return nil
+			}
+
+			if other, ok := positions[position]; ok {
+				return fmt.Errorf("%s and %s cannot both have position %d", other, actionType, position)
+			}
+
+			positions[position] = actionType
+		}
+	}
+
+	for i := 1; i <= len(positions); i++ {
+		if _, ok := positions[i]; !ok {
+			return fmt.Errorf("action positions must be consecutive starting at 1, position %d is missing", i)
+		}
+	}
+
+	return nil
+}
+
 func resourceReceiptRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	idParts := strings.Split(d.Id(), ":")
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
@@ -0,0 +1,124 @@
+package ses
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceReceiptRuleSetOrder() *schema.Resource {
+	return &schema.Resource{
+		// Both create and update reorder the rule set's rules in a single request.
+		Create: resourceReceiptRuleSetOrderPut,
+		Update: resourceReceiptRuleSetOrderPut,
+
+		Read:   resourceReceiptRuleSetOrderRead,
+		Delete: resourceReceiptRuleSetOrderDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"rule_names": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"rule_set_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+		},
+	}
+}
+
+func resourceReceiptRuleSetOrderPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESConn
+
+	ruleSetName := d.Get("rule_set_name").(string)
+	input := &ses.ReorderReceiptRuleSetInput{
+		RuleNames:   flex.ExpandStringList(d.Get("rule_names").([]interface{})),
+		RuleSetName: aws.String(ruleSetName),
+	}
+
+	log.Printf("[DEBUG] Reordering SES Receipt Rule Set: %s", input)
+	_, err := conn.ReorderReceiptRuleSet(input)
+
+	if err != nil {
+		return fmt.Errorf("reordering SES Receipt Rule Set (%s): %w", ruleSetName, err)
+	}
+
+	d.SetId(ruleSetName)
+
+	return resourceReceiptRuleSetOrderRead(d, meta)
+}
+
+func resourceReceiptRuleSetOrderRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESConn
+
+	output, err := FindReceiptRuleSetByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SES Receipt Rule Set (%s) not found, removing order from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading SES Receipt Rule Set (%s): %w", d.Id(), err)
+	}
+
+	var ruleNames []string
+	for _, v := range output.Rules {
+		ruleNames = append(ruleNames, aws.StringValue(v.Name))
+	}
+
+	d.Set("rule_names", ruleNames)
+	d.Set("rule_set_name", output.Metadata.Name)
+
+	return nil
+}
+
+func resourceReceiptRuleSetOrderDelete(d *schema.ResourceData, meta interface{}) error {
+	// Rules are left in their current order; only order management stops.
+	log.Printf("[DEBUG] Removing SES Receipt Rule Set (%s) order from state", d.Id())
+
+	return nil
+}
+
+func FindReceiptRuleSetByName(conn *ses.SES, name string) (*ses.DescribeReceiptRuleSetOutput, error) {
+	input := &ses.DescribeReceiptRuleSetInput{
+		RuleSetName: aws.String(name),
+	}
+
+	output, err := conn.DescribeReceiptRuleSet(input)
+
+	if tfawserr.ErrCodeEquals(err, ses.ErrCodeRuleSetDoesNotExistException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Metadata == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,119 @@
+package ses_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ses"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfses "github.com/hashicorp/terraform-provider-aws/internal/service/ses"
+)
+
+func TestAccSESReceiptRuleSetOrder_basic(t *testing.T) {
+	resourceName := "aws_ses_receipt_rule_set_order.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t); testAccPreCheckReceiptRule(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ses.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReceiptRuleSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReceiptRuleSetOrderConfig_basic(rName, `"first", "second", "third"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReceiptRuleSetOrder(resourceName, "first", "second", "third"),
+					resource.TestCheckResourceAttr(resourceName, "rule_set_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "rule_names.#", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccReceiptRuleSetOrderConfig_basic(rName, `"third", "first", "second"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReceiptRuleSetOrder(resourceName, "third", "first", "second"),
+					resource.TestCheckResourceAttr(resourceName, "rule_names.0", "third"),
+					resource.TestCheckResourceAttr(resourceName, "rule_names.1", "first"),
+					resource.TestCheckResourceAttr(resourceName, "rule_names.2", "second"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSESReceiptRuleSetOrder_missingRule(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t); testAccPreCheckReceiptRule(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ses.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReceiptRuleSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccReceiptRuleSetOrderConfig_basic(rName, `"first", "second"`),
+				ExpectError: regexp.MustCompile(`reordering SES Receipt Rule Set`),
+			},
+		},
+	})
+}
+
+func testAccCheckReceiptRuleSetOrder(n string, want ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SESConn
+
+		output, err := tfses.FindReceiptRuleSetByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		var got []string
+		for _, v := range output.Rules {
+			got = append(got, aws.StringValue(v.Name))
+		}
+
+		if fmt.Sprint(got) != fmt.Sprint(want) {
+			return fmt.Errorf("SES Receipt Rule Set (%s) rule order: got %v, want %v", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccReceiptRuleSetOrderConfig_basic(rName, ruleNames string) string {
+	return fmt.Sprintf(`
+resource "aws_ses_receipt_rule_set" "test" {
+  rule_set_name = %[1]q
+}
+
+resource "aws_ses_receipt_rule" "test" {
+  for_each = toset(["first", "second", "third"])
+
+  name          = each.key
+  rule_set_name = aws_ses_receipt_rule_set.test.rule_set_name
+}
+
+resource "aws_ses_receipt_rule_set_order" "test" {
+  rule_set_name = aws_ses_receipt_rule_set.test.rule_set_name
+  rule_names    = [%[2]s]
+
+  depends_on = [aws_ses_receipt_rule.test]
+}
+`, rName, ruleNames)
+}
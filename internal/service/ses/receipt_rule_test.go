@@ -2,6 +2,7 @@ package ses_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -320,6 +321,31 @@ func TestAccSESReceiptRule_actions(t *testing.T) {
 	})
 }
 
+func TestAccSESReceiptRule_actionPositions(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheck(t)
+			testAccPreCheckReceiptRule(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ses.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReceiptRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccReceiptRuleConfig_actionPositions(rName, 1, 1),
+				ExpectError: regexp.MustCompile(`cannot both have position 1`),
+			},
+			{
+				Config:      testAccReceiptRuleConfig_actionPositions(rName, 1, 3),
+				ExpectError: regexp.MustCompile(`position 2 is missing`),
+			},
+		},
+	})
+}
+
 func TestAccSESReceiptRule_disappears(t *testing.T) {
 	var rule ses.ReceiptRule
 
@@ -654,6 +680,30 @@ resource "aws_ses_receipt_rule" "test1" {
 `, rName)
 }
 
+func testAccReceiptRuleConfig_actionPositions(rName string, headerPosition, stopPosition int) string {
+	return fmt.Sprintf(`
+resource "aws_ses_receipt_rule_set" "test" {
+  rule_set_name = %[1]q
+}
+
+resource "aws_ses_receipt_rule" "test" {
+  name          = %[1]q
+  rule_set_name = aws_ses_receipt_rule_set.test.rule_set_name
+
+  add_header_action {
+    header_name  = "Added-By"
+    header_value = "Terraform"
+    position     = %[2]d
+  }
+
+  stop_action {
+    scope    = "RuleSet"
+    position = %[3]d
+  }
+}
+`, rName, headerPosition, stopPosition)
+}
+
 func testAccReceiptRuleConfig_actions(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ses_receipt_rule_set" "test" {
@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -42,9 +44,12 @@ func ResourceStateMachine() *schema.Resource {
 				Computed: true,
 			},
 			"definition": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringLenBetween(0, 1024*1024), // 1048576
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(0, 1024*1024), // 1048576
+					validStateMachineDefinition,
+				),
 			},
 			"logging_configuration": {
 				Type:     schema.TypeList,
@@ -127,8 +132,39 @@ func ResourceStateMachine() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			validateStateMachineLoggingConfiguration,
+			verify.SetTagsDiff,
+		),
+	}
+}
+
+func validateStateMachineLoggingConfiguration(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if v, ok := d.GetOk("logging_configuration"); !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return nil
+	}
+
+	level := d.Get("logging_configuration.0.level").(string)
+	if level == "" {
+		level = sfn.LogLevelOff
+	}
+
+	// The log group ARN may not be known until apply.
+	if !d.NewValueKnown("logging_configuration.0.log_destination") {
+		return nil
+	}
+
+	logDestination := d.Get("logging_configuration.0.log_destination").(string)
+
+	if level != sfn.LogLevelOff && logDestination == "" {
+		return fmt.Errorf("logging_configuration.0.log_destination must be set when logging_configuration.0.level is %s", level)
+	}
+
+	if logDestination != "" && !strings.HasSuffix(logDestination, ":*") {
+		return fmt.Errorf("logging_configuration.0.log_destination (%s) must be a CloudWatch Logs log group ARN ending with :*", logDestination)
 	}
+
+	return nil
 }
 
 func resourceStateMachineCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -161,6 +197,10 @@ func resourceStateMachineCreate(ctx context.Context, d *schema.ResourceData, met
 		return conn.CreateStateMachineWithContext(ctx, input)
 	}, sfn.ErrCodeStateMachineDeleting, "AccessDeniedException")
 
+	if isLogsResourcePolicySizeExceededError(err) {
+		return diag.Errorf("creating Step Functions State Machine (%s): %s. %s", name, err, logsResourcePolicySizeExceededHint)
+	}
+
 	if err != nil {
 		return diag.Errorf("creating Step Functions State Machine (%s): %s", name, err)
 	}
@@ -263,6 +303,10 @@ func resourceStateMachineUpdate(ctx context.Context, d *schema.ResourceData, met
 
 		_, err := conn.UpdateStateMachineWithContext(ctx, input)
 
+		if isLogsResourcePolicySizeExceededError(err) {
+			return diag.Errorf("updating Step Functions State Machine (%s): %s. %s", d.Id(), err, logsResourcePolicySizeExceededHint)
+		}
+
 		if err != nil {
 			return diag.Errorf("updating Step Functions State Machine (%s): %s", d.Id(), err)
 		}
@@ -346,6 +390,18 @@ func FindStateMachineByARN(ctx context.Context, conn *sfn.SFN, arn string) (*sfn
 	return output, nil
 }
 
+// logsResourcePolicySizeExceededHint is appended to errors returned when enabling logging fails because
+// the CloudWatch Logs resource policy that Step Functions maintains for log delivery is full.
+const logsResourcePolicySizeExceededHint = "Prefix the CloudWatch Logs log group name with /aws/vendedlogs/ so that log delivery does not require a resource policy entry"
+
+func isLogsResourcePolicySizeExceededError(err error) bool {
+	if !tfawserr.ErrCodeEquals(err, sfn.ErrCodeInvalidLoggingConfiguration) {
+		return false
+	}
+
+	return strings.Contains(strings.ToLower(err.Error()), "resource policy size")
+}
+
 func statusStateMachine(ctx context.Context, conn *sfn.SFN, stateMachineArn string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindStateMachineByARN(ctx, conn, stateMachineArn)
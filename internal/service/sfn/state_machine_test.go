@@ -361,6 +361,27 @@ func TestAccSFNStateMachine_expressLogging(t *testing.T) {
 	})
 }
 
+func TestAccSFNStateMachine_loggingValidation(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sfn.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStateMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStateMachineConfig_loggingValidation(rName, sfn.LogLevelAll, false, `null`),
+				ExpectError: regexp.MustCompile(`log_destination must be set when logging_configuration.0.level is ALL`),
+			},
+			{
+				Config:      testAccStateMachineConfig_loggingValidation(rName, sfn.LogLevelAll, false, `"arn:${data.aws_partition.current.partition}:logs:${data.aws_region.current.name}:123456789012:log-group:test"`),
+				ExpectError: regexp.MustCompile(`must be a CloudWatch Logs log group ARN ending with :\*`),
+			},
+		},
+	})
+}
+
 func testAccCheckExists(n string, v *sfn.DescribeStateMachineOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -759,6 +780,39 @@ EOF
 `, rName, rLevel))
 }
 
+func testAccStateMachineConfig_loggingValidation(rName, rLevel string, includeExecutionData bool, logDestination string) string {
+	return acctest.ConfigCompose(testAccStateMachineConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_sfn_state_machine" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.for_sfn.arn
+  type     = "EXPRESS"
+
+  definition = <<EOF
+{
+  "StartAt": "HelloWorld",
+  "States": {
+    "HelloWorld": {
+      "Type": "Task",
+      "Resource": "${aws_lambda_function.test.arn}",
+      "End": true
+    }
+  }
+}
+EOF
+
+  logging_configuration {
+    log_destination        = %[4]s
+    include_execution_data = %[3]t
+    level                  = %[2]q
+  }
+}
+`, rName, rLevel, includeExecutionData, logDestination))
+}
+
 func testAccStateMachineConfig_tracingEnable(rName string) string {
 	return acctest.ConfigCompose(testAccStateMachineConfig_base(rName), fmt.Sprintf(`
 resource "aws_sfn_state_machine" "test" {
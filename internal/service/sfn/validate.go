@@ -0,0 +1,69 @@
+package sfn
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// validStateMachineDefinition performs local checks on an Amazon States Language
+// definition so that common mistakes are reported at plan time rather than by
+// CreateStateMachine or UpdateStateMachine. Only JSON syntax and the required
+// top-level StartAt and States fields are checked.
+func validStateMachineDefinition(v interface{}, k string) (ws []string, errs []error) {
+	value, ok := v.(string)
+	if !ok {
+		errs = append(errs, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	var definition map[string]interface{}
+
+	if err := json.Unmarshal([]byte(value), &definition); err != nil {
+		var syntaxErr *json.SyntaxError
+		var typeErr *json.UnmarshalTypeError
+
+		switch {
+		case errors.As(err, &syntaxErr):
+			line, column := offsetToLineColumn(value, syntaxErr.Offset)
+			errs = append(errs, fmt.Errorf("%q contains invalid JSON at line %d, column %d: %s", k, line, column, err))
+		case errors.As(err, &typeErr):
+			errs = append(errs, fmt.Errorf("%q must be a JSON object", k))
+		default:
+			errs = append(errs, fmt.Errorf("%q contains invalid JSON: %s", k, err))
+		}
+
+		return
+	}
+
+	states, ok := definition["States"].(map[string]interface{})
+	if !ok {
+		errs = append(errs, fmt.Errorf("%q must contain a \"States\" object", k))
+	}
+
+	startAt, ok := definition["StartAt"].(string)
+	if !ok {
+		errs = append(errs, fmt.Errorf("%q must contain a \"StartAt\" string", k))
+	} else if states != nil {
+		if _, ok := states[startAt]; !ok {
+			errs = append(errs, fmt.Errorf("%q \"StartAt\" state (%s) is not defined in \"States\"", k, startAt))
+		}
+	}
+
+	return
+}
+
+// offsetToLineColumn converts a byte offset reported by encoding/json into a
+// 1-based line and column.
+func offsetToLineColumn(s string, offset int64) (int, int) {
+	if offset > int64(len(s)) {
+		offset = int64(len(s))
+	}
+
+	prefix := []byte(s[:offset])
+	line := bytes.Count(prefix, []byte("\n")) + 1
+	column := len(prefix) - bytes.LastIndexByte(prefix, '\n')
+
+	return line, column
+}
@@ -0,0 +1,56 @@
+package sfn
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidStateMachineDefinition(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+		ErrMatch string
+	}{
+		{
+			Value:    `{"StartAt": "Hello", "States": {"Hello": {"Type": "Pass", "End": true}}}`,
+			ErrCount: 0,
+		},
+		{
+			Value:    "{\n  \"StartAt\": \"Hello\",\n  \"States\": {\n    \"Hello\": {\"Type\": \"Pass\" \"End\": true}\n  }\n}",
+			ErrCount: 1,
+			ErrMatch: "line 4, column",
+		},
+		{
+			Value:    `["StartAt"]`,
+			ErrCount: 1,
+			ErrMatch: "must be a JSON object",
+		},
+		{
+			Value:    `{"States": {"Hello": {"Type": "Pass", "End": true}}}`,
+			ErrCount: 1,
+			ErrMatch: `"StartAt" string`,
+		},
+		{
+			Value:    `{"StartAt": "Hello"}`,
+			ErrCount: 1,
+			ErrMatch: `"States" object`,
+		},
+		{
+			Value:    `{"StartAt": "Goodbye", "States": {"Hello": {"Type": "Pass", "End": true}}}`,
+			ErrCount: 1,
+			ErrMatch: "(Goodbye) is not defined",
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validStateMachineDefinition(tc.Value, "definition")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d errors for %q, got %d: %v", tc.ErrCount, tc.Value, len(errors), errors)
+		}
+
+		if tc.ErrMatch != "" && !strings.Contains(errors[0].Error(), tc.ErrMatch) {
+			t.Fatalf("Expected error for %q to contain %q, got: %s", tc.Value, tc.ErrMatch, errors[0])
+		}
+	}
+}
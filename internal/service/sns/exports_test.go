@@ -0,0 +1,8 @@
+package sns
+
+// Exports for use in tests only.
+var (
+	StatusSubscriptionPendingConfirmation = statusSubscriptionPendingConfirmation
+	WaitSubscriptionConfirmed             = waitSubscriptionConfirmed
+	WaitSubscriptionDeleted               = waitSubscriptionDeleted
+)
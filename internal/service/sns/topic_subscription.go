@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"time"
 
@@ -45,9 +46,10 @@ var (
 			DiffSuppressFunc: SuppressEquivalentTopicSubscriptionDeliveryPolicy,
 		},
 		"endpoint": {
-			Type:     schema.TypeString,
-			Required: true,
-			ForceNew: true,
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			DiffSuppressFunc: suppressEquivalentTopicSubscriptionEndpoint,
 		},
 		"endpoint_auto_confirms": {
 			Type:     schema.TypeBool,
@@ -130,6 +132,8 @@ func ResourceTopicSubscription() *schema.Resource {
 		},
 
 		Schema: subscriptionSchema,
+
+		CustomizeDiff: resourceTopicSubscriptionCustomizeDiff,
 	}
 }
 
@@ -149,16 +153,22 @@ func resourceTopicSubscriptionCreate(ctx context.Context, d *schema.ResourceData
 
 	input := &sns.SubscribeInput{
 		Attributes:            aws.StringMap(attributes),
-		Endpoint:              aws.String(d.Get("endpoint").(string)),
+		Endpoint:              aws.String(NormalizeTopicSubscriptionEndpoint(d.Get("protocol").(string), d.Get("endpoint").(string))),
 		Protocol:              aws.String(d.Get("protocol").(string)),
 		ReturnSubscriptionArn: aws.Bool(true), // even if not confirmed, will get ARN
 		TopicArn:              aws.String(d.Get("topic_arn").(string)),
 	}
 
+	var diags diag.Diagnostics
+
+	if d.Get("protocol").(string) == SubscriptionProtocolSMS {
+		diags = append(diags, checkSMSEndpoint(aws.StringValue(input.Endpoint))...)
+	}
+
 	output, err := conn.SubscribeWithContext(ctx, input)
 
 	if err != nil {
-		return diag.Errorf("creating SNS Topic Subscription: %s", err)
+		return append(diags, diag.Errorf("creating SNS Topic Subscription: %s", err)...)
 	}
 
 	d.SetId(aws.StringValue(output.SubscriptionArn))
@@ -180,11 +190,11 @@ func resourceTopicSubscriptionCreate(ctx context.Context, d *schema.ResourceData
 
 	if waitForConfirmation {
 		if _, err := waitSubscriptionConfirmed(ctx, conn, d.Id(), timeout); err != nil {
-			return diag.Errorf("waiting for SNS Topic Subscription (%s) confirmation: %s", d.Id(), err)
+			return append(diags, diag.Errorf("waiting for SNS Topic Subscription (%s) confirmation: %s", d.Id(), err)...)
 		}
 	}
 
-	return resourceTopicSubscriptionRead(ctx, d, meta)
+	return append(diags, resourceTopicSubscriptionRead(ctx, d, meta)...)
 }
 
 func resourceTopicSubscriptionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -250,6 +260,79 @@ func resourceTopicSubscriptionDelete(ctx context.Context, d *schema.ResourceData
 	return nil
 }
 
+var (
+	emailEndpointRegexp = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+	smsEndpointRegexp   = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+)
+
+func resourceTopicSubscriptionCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("endpoint") {
+		return nil
+	}
+
+	protocol := diff.Get("protocol").(string)
+	endpoint := NormalizeTopicSubscriptionEndpoint(protocol, diff.Get("endpoint").(string))
+
+	switch protocol {
+	case SubscriptionProtocolEmail, SubscriptionProtocolEmailJSON:
+		if !emailEndpointRegexp.MatchString(endpoint) {
+			return fmt.Errorf("invalid endpoint for %s protocol, expected an email address: %s", protocol, endpoint)
+		}
+	}
+
+	return nil
+}
+
+// checkSMSEndpoint returns a warning if an SMS endpoint is not an E.164 phone number.
+// Such endpoints are not rejected, as SNS accepts them and existing configurations may use them,
+// but no "+" is added either as SNS may then interpret the leading digits as a different country code.
+func checkSMSEndpoint(endpoint string) diag.Diagnostics {
+	if smsEndpointRegexp.MatchString(endpoint) {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("SNS Topic Subscription endpoint (%s) is not an E.164 phone number", endpoint),
+			Detail:   "SMS endpoints should start with + and the country code, e.g. +15550100199. SNS may otherwise assume a default country code.",
+		},
+	}
+}
+
+// NormalizeTopicSubscriptionEndpoint returns the endpoint in the form stored by SNS:
+// the domain of email addresses is lowercased and separators are removed from international phone numbers.
+// Phone numbers without a leading "+" are returned unchanged as the country code cannot be inferred.
+func NormalizeTopicSubscriptionEndpoint(protocol, endpoint string) string {
+	switch protocol {
+	case SubscriptionProtocolEmail, SubscriptionProtocolEmailJSON:
+		endpoint = strings.TrimSpace(endpoint)
+
+		// Only the domain is case-insensitive (RFC 5321), the local part is kept as configured.
+		if i := strings.LastIndex(endpoint, "@"); i >= 0 {
+			endpoint = endpoint[:i] + strings.ToLower(endpoint[i:])
+		}
+
+		return endpoint
+	case SubscriptionProtocolSMS:
+		endpoint = strings.TrimSpace(endpoint)
+
+		if strings.HasPrefix(endpoint, "+") {
+			endpoint = strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "").Replace(endpoint)
+		}
+
+		return endpoint
+	}
+
+	return endpoint
+}
+
+func suppressEquivalentTopicSubscriptionEndpoint(k, old, new string, d *schema.ResourceData) bool {
+	protocol := d.Get("protocol").(string)
+
+	return NormalizeTopicSubscriptionEndpoint(protocol, old) == NormalizeTopicSubscriptionEndpoint(protocol, new)
+}
+
 func putSubscriptionAttributes(ctx context.Context, conn *sns.SNS, arn string, attributes map[string]string) error {
 	for name, value := range attributes {
 		err := putSubscriptionAttribute(ctx, conn, arn, name, value)
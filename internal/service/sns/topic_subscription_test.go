@@ -7,7 +7,9 @@ import (
 	"reflect"
 	"regexp"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/sns"
@@ -66,6 +68,57 @@ func TestSuppressEquivalentTopicSubscriptionDeliveryPolicy(t *testing.T) {
 	}
 }
 
+func TestNormalizeTopicSubscriptionEndpoint(t *testing.T) {
+	var testCases = []struct {
+		protocol string
+		endpoint string
+		expected string
+	}{
+		{
+			protocol: "email",
+			endpoint: "Someone@Example.COM",
+			expected: "Someone@example.com",
+		},
+		{
+			protocol: "email-json",
+			endpoint: " someone@example.com ",
+			expected: "someone@example.com",
+		},
+		{
+			protocol: "sms",
+			endpoint: "+1 (555) 010-0199",
+			expected: "+15550100199",
+		},
+		{
+			protocol: "sms",
+			endpoint: "+44.20.7946.0018",
+			expected: "+442079460018",
+		},
+		{
+			protocol: "sms",
+			endpoint: "(555) 010-0199",
+			expected: "(555) 010-0199",
+		},
+		{
+			protocol: "sms",
+			endpoint: "+15550100199",
+			expected: "+15550100199",
+		},
+		{
+			protocol: "https",
+			endpoint: "https://example.com/Notify",
+			expected: "https://example.com/Notify",
+		},
+	}
+
+	for i, tc := range testCases {
+		actual := tfsns.NormalizeTopicSubscriptionEndpoint(tc.protocol, tc.endpoint)
+		if actual != tc.expected {
+			t.Fatalf("Test Case %d: Got: %s Expected: %s", i, actual, tc.expected)
+		}
+	}
+}
+
 func TestAccSNSTopicSubscription_basic(t *testing.T) {
 	var attributes map[string]string
 	resourceName := "aws_sns_topic_subscription.test"
@@ -81,6 +134,7 @@ func TestAccSNSTopicSubscription_basic(t *testing.T) {
 				Config: testAccTopicSubscriptionConfig_basic(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckTopicSubscriptionExists(resourceName, &attributes),
+					testAccCheckTopicSubscriptionConfirmed(resourceName),
 					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", sns.ServiceName, regexp.MustCompile(fmt.Sprintf("%s:.+", rName))),
 					resource.TestCheckResourceAttr(resourceName, "confirmation_was_authenticated", "true"),
 					resource.TestCheckResourceAttr(resourceName, "delivery_policy", ""),
@@ -408,6 +462,38 @@ func TestAccSNSTopicSubscription_email(t *testing.T) {
 	})
 }
 
+func TestAccSNSTopicSubscription_emailNormalized(t *testing.T) {
+	var attributes map[string]string
+	resourceName := "aws_sns_topic_subscription.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	localPart, domain, _ := strings.Cut(acctest.DefaultEmailAddress, "@")
+	email := localPart + "@" + strings.ToUpper(domain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sns.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTopicSubscriptionConfig_email(rName, "not-an-email-address"),
+				ExpectError: regexp.MustCompile(`invalid endpoint for email protocol`),
+			},
+			{
+				Config: testAccTopicSubscriptionConfig_email(rName, email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicSubscriptionExists(resourceName, &attributes),
+					resource.TestCheckResourceAttr(resourceName, "endpoint", acctest.DefaultEmailAddress),
+				),
+			},
+			{
+				Config:   testAccTopicSubscriptionConfig_email(rName, email),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccSNSTopicSubscription_firehose(t *testing.T) {
 	var attributes map[string]string
 	resourceName := "aws_sns_topic_subscription.test"
@@ -536,6 +622,25 @@ func testAccCheckTopicSubscriptionExists(n string, v *map[string]string) resourc
 	}
 }
 
+func testAccCheckTopicSubscriptionConfirmed(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SNS Topic Subscription ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SNSConn
+
+		_, err := tfsns.WaitSubscriptionConfirmed(context.Background(), conn, rs.Primary.ID, 2*time.Minute)
+
+		return err
+	}
+}
+
 func testAccCheckTopicSubscriptionDeliveryPolicyAttribute(attributes *map[string]string, expectedDeliveryPolicy *tfsns.TopicSubscriptionDeliveryPolicy) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		apiDeliveryPolicyJSONString, ok := (*attributes)["DeliveryPolicy"]
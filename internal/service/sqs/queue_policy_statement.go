@@ -0,0 +1,428 @@
+package sqs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	awspolicy "github.com/hashicorp/awspolicyequivalence"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceQueuePolicyStatement() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceQueuePolicyStatementPut,
+		ReadWithoutTimeout:   resourceQueuePolicyStatementRead,
+		UpdateWithoutTimeout: resourceQueuePolicyStatementPut,
+		DeleteWithoutTimeout: resourceQueuePolicyStatementDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"queue_url": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"sid": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 100),
+					validation.StringMatch(regexp.MustCompile(`^[0-9A-Za-z]+$`), "must contain only alphanumeric characters"),
+				),
+			},
+			"statement": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentQueuePolicyStatementDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+		},
+	}
+}
+
+func resourceQueuePolicyStatementPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SQSConn
+
+	url := d.Get("queue_url").(string)
+	sid := d.Get("sid").(string)
+
+	statement, err := queuePolicyStatementWithSid(d.Get("statement").(string), sid)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Serialize all read-modify-write cycles against the queue's policy.
+	conns.GlobalMutexKV.Lock(url)
+	defer conns.GlobalMutexKV.Unlock(url)
+
+	policy, err := findQueuePolicyDocument(ctx, conn, url)
+
+	if err != nil {
+		return diag.Errorf("reading SQS Queue (%s) policy: %s", url, err)
+	}
+
+	if d.IsNewResource() && policy.findStatement(sid) != nil {
+		return diag.Errorf("SQS Queue (%s) policy already contains a statement with Sid (%s)", url, sid)
+	}
+
+	policy.putStatement(sid, statement)
+
+	if err := putQueuePolicyDocument(ctx, conn, url, policy); err != nil {
+		return diag.Errorf("setting SQS Queue (%s) policy statement (%s): %s", url, sid, err)
+	}
+
+	d.SetId(QueuePolicyStatementCreateResourceID(url, sid))
+
+	return resourceQueuePolicyStatementRead(ctx, d, meta)
+}
+
+func resourceQueuePolicyStatementRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SQSConn
+
+	url, sid, err := QueuePolicyStatementParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	outputRaw, err := tfresource.RetryWhenNotFoundContext(ctx, queueAttributeReadTimeout, func() (interface{}, error) {
+		return FindQueuePolicyStatementByTwoPartKey(ctx, conn, url, sid)
+	})
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SQS Queue (%s) policy statement (%s) not found, removing from state", url, sid)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading SQS Queue (%s) policy statement (%s): %s", url, sid, err)
+	}
+
+	statement := outputRaw.(string)
+
+	if old := d.Get("statement").(string); old != "" {
+		if equivalent, err := queuePolicyStatementsAreEquivalent(old, statement, sid); err == nil && equivalent {
+			statement = old
+		}
+	}
+
+	statement, err = structure.NormalizeJsonString(statement)
+
+	if err != nil {
+		return diag.Errorf("SQS Queue (%s) policy statement (%s) is invalid JSON: %s", url, sid, err)
+	}
+
+	d.Set("queue_url", url)
+	d.Set("sid", sid)
+	d.Set("statement", statement)
+
+	return nil
+}
+
+func resourceQueuePolicyStatementDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SQSConn
+
+	url, sid, err := QueuePolicyStatementParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	conns.GlobalMutexKV.Lock(url)
+	defer conns.GlobalMutexKV.Unlock(url)
+
+	policy, err := findQueuePolicyDocument(ctx, conn, url)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading SQS Queue (%s) policy: %s", url, err)
+	}
+
+	if !policy.removeStatement(sid) {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Deleting SQS Queue (%s) policy statement: %s", url, sid)
+	err = putQueuePolicyDocument(ctx, conn, url, policy)
+
+	if tfawserr.ErrCodeEquals(err, sqs.ErrCodeQueueDoesNotExist) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting SQS Queue (%s) policy statement (%s): %s", url, sid, err)
+	}
+
+	return nil
+}
+
+const queuePolicyStatementResourceIDSeparator = ","
+
+func QueuePolicyStatementCreateResourceID(url, sid string) string {
+	parts := []string{url, sid}
+	id := strings.Join(parts, queuePolicyStatementResourceIDSeparator)
+
+	return id
+}
+
+func QueuePolicyStatementParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, queuePolicyStatementResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected QUEUE-URL%[2]sSID", id, queuePolicyStatementResourceIDSeparator)
+}
+
+func FindQueuePolicyStatementByTwoPartKey(ctx context.Context, conn *sqs.SQS, url, sid string) (string, error) {
+	policy, err := findQueuePolicyDocument(ctx, conn, url)
+
+	if err != nil {
+		return "", err
+	}
+
+	statement := policy.findStatement(sid)
+
+	if statement == nil {
+		return "", &resource.NotFoundError{
+			Message: fmt.Sprintf("no policy statement with Sid (%s)", sid),
+		}
+	}
+
+	return string(statement), nil
+}
+
+// findQueuePolicyDocument returns the queue's current policy document.
+// A queue without a policy yields an empty document.
+func findQueuePolicyDocument(ctx context.Context, conn *sqs.SQS, url string) (*queuePolicyDocument, error) {
+	policy, err := FindQueueAttributeByURL(ctx, conn, url, sqs.QueueAttributeNamePolicy)
+
+	if errors.Is(err, tfresource.ErrEmptyResult) {
+		return &queuePolicyDocument{}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return parseQueuePolicyDocument(policy)
+}
+
+func putQueuePolicyDocument(ctx context.Context, conn *sqs.SQS, url string, policy *queuePolicyDocument) error {
+	v, err := policy.String()
+
+	if err != nil {
+		return err
+	}
+
+	attributes := map[string]string{
+		sqs.QueueAttributeNamePolicy: v,
+	}
+	input := &sqs.SetQueueAttributesInput{
+		Attributes: aws.StringMap(attributes),
+		QueueUrl:   aws.String(url),
+	}
+
+	log.Printf("[DEBUG] Setting SQS Queue attributes: %s", input)
+	if _, err := conn.SetQueueAttributesWithContext(ctx, input); err != nil {
+		return err
+	}
+
+	return waitQueueAttributesPropagated(ctx, conn, url, attributes)
+}
+
+// queuePolicyDocument is a minimal view of a queue policy that preserves
+// statements verbatim so that statements owned by other configurations are
+// never rewritten.
+type queuePolicyDocument struct {
+	Version    string            `json:",omitempty"`
+	Id         string            `json:",omitempty"`
+	Statements []json.RawMessage `json:"Statement,omitempty"`
+}
+
+func parseQueuePolicyDocument(policy string) (*queuePolicyDocument, error) {
+	doc := &queuePolicyDocument{}
+
+	if strings.TrimSpace(policy) == "" {
+		return doc, nil
+	}
+
+	var raw struct {
+		Version   string          `json:",omitempty"`
+		Id        string          `json:",omitempty"`
+		Statement json.RawMessage `json:",omitempty"`
+	}
+
+	if err := json.Unmarshal([]byte(policy), &raw); err != nil {
+		return nil, fmt.Errorf("parsing policy (%s): %w", policy, err)
+	}
+
+	doc.Version = raw.Version
+	doc.Id = raw.Id
+
+	// A policy may hold a single statement object rather than an array.
+	if v := strings.TrimSpace(string(raw.Statement)); strings.HasPrefix(v, "{") {
+		doc.Statements = []json.RawMessage{raw.Statement}
+	} else if v != "" && v != "null" {
+		if err := json.Unmarshal(raw.Statement, &doc.Statements); err != nil {
+			return nil, fmt.Errorf("parsing policy (%s) statements: %w", policy, err)
+		}
+	}
+
+	return doc, nil
+}
+
+func (doc *queuePolicyDocument) findStatement(sid string) json.RawMessage {
+	for _, v := range doc.Statements {
+		if queuePolicyStatementSid(v) == sid {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// putStatement replaces the statement with the specified Sid or appends it.
+func (doc *queuePolicyDocument) putStatement(sid string, statement json.RawMessage) {
+	if doc.Version == "" {
+		doc.Version = "2012-10-17"
+	}
+
+	for i, v := range doc.Statements {
+		if queuePolicyStatementSid(v) == sid {
+			doc.Statements[i] = statement
+			return
+		}
+	}
+
+	doc.Statements = append(doc.Statements, statement)
+}
+
+// removeStatement removes the statement with the specified Sid and reports whether it was present.
+func (doc *queuePolicyDocument) removeStatement(sid string) bool {
+	for i, v := range doc.Statements {
+		if queuePolicyStatementSid(v) == sid {
+			doc.Statements = append(doc.Statements[:i], doc.Statements[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+// String returns the policy JSON. A policy without statements is returned as
+// the empty string, which removes the policy from the queue.
+func (doc *queuePolicyDocument) String() (string, error) {
+	if len(doc.Statements) == 0 {
+		return "", nil
+	}
+
+	b, err := json.Marshal(doc)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func queuePolicyStatementSid(statement json.RawMessage) string {
+	var v struct {
+		Sid string
+	}
+
+	if err := json.Unmarshal(statement, &v); err != nil {
+		return ""
+	}
+
+	return v.Sid
+}
+
+// queuePolicyStatementWithSid returns the statement JSON with its Sid set to the specified value.
+func queuePolicyStatementWithSid(statement, sid string) (json.RawMessage, error) {
+	var m map[string]interface{}
+
+	if err := json.Unmarshal([]byte(statement), &m); err != nil {
+		return nil, fmt.Errorf("statement (%s) is invalid JSON: %w", statement, err)
+	}
+
+	if m == nil {
+		return nil, fmt.Errorf("statement (%s) must be a JSON object", statement)
+	}
+
+	if v, ok := m["Sid"]; ok && v != sid {
+		return nil, fmt.Errorf("statement Sid (%v) does not match sid (%s)", v, sid)
+	}
+
+	m["Sid"] = sid
+
+	return json.Marshal(m)
+}
+
+func queuePolicyStatementsAreEquivalent(s1, s2, sid string) (bool, error) {
+	toPolicy := func(s string) (string, error) {
+		statement, err := queuePolicyStatementWithSid(s, sid)
+
+		if err != nil {
+			return "", err
+		}
+
+		return (&queuePolicyDocument{Version: "2012-10-17", Statements: []json.RawMessage{statement}}).String()
+	}
+
+	p1, err := toPolicy(s1)
+
+	if err != nil {
+		return false, err
+	}
+
+	p2, err := toPolicy(s2)
+
+	if err != nil {
+		return false, err
+	}
+
+	return awspolicy.PoliciesAreEquivalent(p1, p2)
+}
+
+func suppressEquivalentQueuePolicyStatementDiffs(k, old, new string, d *schema.ResourceData) bool {
+	if strings.TrimSpace(old) == "" || strings.TrimSpace(new) == "" {
+		return false
+	}
+
+	equivalent, err := queuePolicyStatementsAreEquivalent(old, new, d.Get("sid").(string))
+
+	if err != nil {
+		return false
+	}
+
+	return equivalent
+}
@@ -0,0 +1,194 @@
+package sqs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sqs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsqs "github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSQSQueuePolicyStatement_basic(t *testing.T) {
+	resourceName := "aws_sqs_queue_policy_statement.test1"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sqs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueuePolicyStatementDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueuePolicyStatementConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueuePolicyStatementExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sid", "First"),
+					resource.TestCheckResourceAttrSet(resourceName, "statement"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSQSQueuePolicyStatement_disappears(t *testing.T) {
+	resourceName := "aws_sqs_queue_policy_statement.test1"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sqs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueuePolicyStatementDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueuePolicyStatementConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueuePolicyStatementExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfsqs.ResourceQueuePolicyStatement(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSQSQueuePolicyStatement_multiple(t *testing.T) {
+	resourceName1 := "aws_sqs_queue_policy_statement.test1"
+	resourceName2 := "aws_sqs_queue_policy_statement.test2"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sqs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueuePolicyStatementDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueuePolicyStatementConfig_multiple(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueuePolicyStatementExists(resourceName1),
+					testAccCheckQueuePolicyStatementExists(resourceName2),
+					resource.TestCheckResourceAttr(resourceName2, "sid", "Second"),
+				),
+			},
+			{
+				Config: testAccQueuePolicyStatementConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueuePolicyStatementExists(resourceName1),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckQueuePolicyStatementExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SQS Queue Policy Statement ID is set")
+		}
+
+		url, sid, err := tfsqs.QueuePolicyStatementParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SQSConn
+
+		_, err = tfsqs.FindQueuePolicyStatementByTwoPartKey(context.Background(), conn, url, sid)
+
+		return err
+	}
+}
+
+func testAccCheckQueuePolicyStatementDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SQSConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sqs_queue_policy_statement" {
+			continue
+		}
+
+		url, sid, err := tfsqs.QueuePolicyStatementParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfsqs.FindQueuePolicyStatementByTwoPartKey(context.Background(), conn, url, sid)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SQS Queue Policy Statement %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccQueuePolicyStatementConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+}
+
+resource "aws_sqs_queue_policy_statement" "test1" {
+  queue_url = aws_sqs_queue.test.id
+  sid       = "First"
+
+  statement = jsonencode({
+    Effect    = "Allow"
+    Principal = "*"
+    Action    = "sqs:SendMessage"
+    Resource  = aws_sqs_queue.test.arn
+    Condition = {
+      ArnEquals = {
+        "aws:SourceArn" = aws_sqs_queue.test.arn
+      }
+    }
+  })
+}
+`, rName)
+}
+
+func testAccQueuePolicyStatementConfig_basic(rName string) string {
+	return testAccQueuePolicyStatementConfig_base(rName)
+}
+
+func testAccQueuePolicyStatementConfig_multiple(rName string) string {
+	return acctest.ConfigCompose(testAccQueuePolicyStatementConfig_base(rName), `
+resource "aws_sqs_queue_policy_statement" "test2" {
+  queue_url = aws_sqs_queue.test.id
+  sid       = "Second"
+
+  statement = jsonencode({
+    Effect    = "Allow"
+    Principal = "*"
+    Action    = "sqs:ReceiveMessage"
+    Resource  = aws_sqs_queue.test.arn
+  })
+}
+`)
+}
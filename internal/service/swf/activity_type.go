@@ -0,0 +1,381 @@
+package swf
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/swf"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceActivityType() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceActivityTypeCreate,
+		ReadWithoutTimeout:   resourceActivityTypeRead,
+		DeleteWithoutTimeout: resourceActivityTypeDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_task_heartbeat_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validTimeout,
+			},
+			"default_task_list": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"default_task_priority": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"default_task_schedule_to_close_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validTimeout,
+			},
+			"default_task_schedule_to_start_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validTimeout,
+			},
+			"default_task_start_to_close_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validTimeout,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringDoesNotContainAny(":/|"),
+				),
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringDoesNotContainAny(":/|"),
+				),
+			},
+		},
+	}
+}
+
+func resourceActivityTypeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SWFConn
+
+	domain := d.Get("domain").(string)
+	name := d.Get("name").(string)
+	version := d.Get("version").(string)
+	id := TypeCreateResourceID(domain, name, version)
+	input := &swf.RegisterActivityTypeInput{
+		Domain:  aws.String(domain),
+		Name:    aws.String(name),
+		Version: aws.String(version),
+	}
+
+	if v, ok := d.GetOk("default_task_heartbeat_timeout"); ok {
+		input.DefaultTaskHeartbeatTimeout = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_list"); ok {
+		input.DefaultTaskList = &swf.TaskList{
+			Name: aws.String(v.(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("default_task_priority"); ok {
+		input.DefaultTaskPriority = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_schedule_to_close_timeout"); ok {
+		input.DefaultTaskScheduleToCloseTimeout = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_schedule_to_start_timeout"); ok {
+		input.DefaultTaskScheduleToStartTimeout = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_start_to_close_timeout"); ok {
+		input.DefaultTaskStartToCloseTimeout = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	_, err := conn.RegisterActivityTypeWithContext(ctx, input)
+
+	// A deprecated activity type cannot be registered again, only undeprecated.
+	if tfawserr.ErrCodeEquals(err, swf.ErrCodeTypeAlreadyExistsFault) {
+		err = undeprecateActivityType(ctx, conn, d, domain, name, version)
+	}
+
+	if err != nil {
+		return diag.Errorf("creating SWF Activity Type (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceActivityTypeRead(ctx, d, meta)
+}
+
+func resourceActivityTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SWFConn
+
+	domain, name, version, err := TypeParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindActivityTypeByThreePartKey(ctx, conn, domain, name, version)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SWF Activity Type (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading SWF Activity Type (%s): %s", d.Id(), err)
+	}
+
+	d.Set("creation_date", aws.TimeValue(output.TypeInfo.CreationDate).Format(time.RFC3339))
+	d.Set("default_task_heartbeat_timeout", output.Configuration.DefaultTaskHeartbeatTimeout)
+	if v := output.Configuration.DefaultTaskList; v != nil {
+		d.Set("default_task_list", v.Name)
+	} else {
+		d.Set("default_task_list", nil)
+	}
+	d.Set("default_task_priority", output.Configuration.DefaultTaskPriority)
+	d.Set("default_task_schedule_to_close_timeout", output.Configuration.DefaultTaskScheduleToCloseTimeout)
+	d.Set("default_task_schedule_to_start_timeout", output.Configuration.DefaultTaskScheduleToStartTimeout)
+	d.Set("default_task_start_to_close_timeout", output.Configuration.DefaultTaskStartToCloseTimeout)
+	d.Set("description", output.TypeInfo.Description)
+	d.Set("domain", domain)
+	d.Set("name", output.TypeInfo.ActivityType.Name)
+	d.Set("version", output.TypeInfo.ActivityType.Version)
+
+	return nil
+}
+
+func resourceActivityTypeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SWFConn
+
+	domain, name, version, err := TypeParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deprecating SWF Activity Type: %s", d.Id())
+	_, err = conn.DeprecateActivityTypeWithContext(ctx, &swf.DeprecateActivityTypeInput{
+		ActivityType: &swf.ActivityType{
+			Name:    aws.String(name),
+			Version: aws.String(version),
+		},
+		Domain: aws.String(domain),
+	})
+
+	if tfawserr.ErrCodeEquals(err, swf.ErrCodeTypeDeprecatedFault, swf.ErrCodeUnknownResourceFault) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting SWF Activity Type (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const typeResourceIDSeparator = ":"
+
+// undeprecateActivityType undeprecates the specified activity type.
+// A registered type's settings cannot be changed, so if they differ from the configured ones
+// the type is deprecated again and an error is returned.
+func undeprecateActivityType(ctx context.Context, conn *swf.SWF, d *schema.ResourceData, domain, name, version string) error {
+	activityType := &swf.ActivityType{
+		Name:    aws.String(name),
+		Version: aws.String(version),
+	}
+
+	log.Printf("[DEBUG] Undeprecating SWF Activity Type: %s", TypeCreateResourceID(domain, name, version))
+	_, err := conn.UndeprecateActivityTypeWithContext(ctx, &swf.UndeprecateActivityTypeInput{
+		ActivityType: activityType,
+		Domain:       aws.String(domain),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindActivityTypeByThreePartKey(ctx, conn, domain, name, version)
+
+	if err != nil {
+		return err
+	}
+
+	var defaultTaskList *string
+	if v := output.Configuration.DefaultTaskList; v != nil {
+		defaultTaskList = v.Name
+	}
+
+	mismatches := configuredStringMismatches(d, map[string]*string{
+		"default_task_heartbeat_timeout":         output.Configuration.DefaultTaskHeartbeatTimeout,
+		"default_task_list":                      defaultTaskList,
+		"default_task_priority":                  output.Configuration.DefaultTaskPriority,
+		"default_task_schedule_to_close_timeout": output.Configuration.DefaultTaskScheduleToCloseTimeout,
+		"default_task_schedule_to_start_timeout": output.Configuration.DefaultTaskScheduleToStartTimeout,
+		"default_task_start_to_close_timeout":    output.Configuration.DefaultTaskStartToCloseTimeout,
+		"description":                            output.TypeInfo.Description,
+	})
+
+	if len(mismatches) == 0 {
+		return nil
+	}
+
+	_, err = conn.DeprecateActivityTypeWithContext(ctx, &swf.DeprecateActivityTypeInput{
+		ActivityType: activityType,
+		Domain:       aws.String(domain),
+	})
+
+	if err != nil {
+		return fmt.Errorf("deprecating: %w", err)
+	}
+
+	return fmt.Errorf("a deprecated activity type with this name and version was registered with different %s; "+
+		"the settings of a registered type cannot be changed, use a new version instead", strings.Join(mismatches, ", "))
+}
+
+func TypeCreateResourceID(domain, name, version string) string {
+	parts := []string{domain, name, version}
+	id := strings.Join(parts, typeResourceIDSeparator)
+
+	return id
+}
+
+func TypeParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, typeResourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected domain%[2]sname%[2]sversion", id, typeResourceIDSeparator)
+}
+
+func FindActivityTypeByThreePartKey(ctx context.Context, conn *swf.SWF, domain, name, version string) (*swf.DescribeActivityTypeOutput, error) {
+	input := &swf.DescribeActivityTypeInput{
+		ActivityType: &swf.ActivityType{
+			Name:    aws.String(name),
+			Version: aws.String(version),
+		},
+		Domain: aws.String(domain),
+	}
+
+	output, err := conn.DescribeActivityTypeWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, swf.ErrCodeUnknownResourceFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Configuration == nil || output.TypeInfo == nil || output.TypeInfo.ActivityType == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.TypeInfo.Status); status == swf.RegistrationStatusDeprecated {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// validTimeout validates an SWF timeout, which is either NONE or a duration in seconds.
+func validTimeout(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if value == "NONE" {
+		return
+	}
+
+	if _, err := strconv.ParseUint(value, 10, 32); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be NONE or a non-negative number of seconds, got: %s", k, value))
+	}
+
+	return
+}
+
+// configuredStringMismatches returns, sorted, the names of the configured string arguments
+// whose values differ from the registered ones.
+// Unconfigured arguments are skipped as SWF may have registered default values for them.
+func configuredStringMismatches(d *schema.ResourceData, registered map[string]*string) []string {
+	var names []string
+
+	for k, v := range registered {
+		if c, ok := d.GetOk(k); ok && c.(string) != aws.StringValue(v) {
+			names = append(names, k)
+		}
+	}
+
+	sort.Strings(names)
+
+	return names
+}
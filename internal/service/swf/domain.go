@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/swf"
@@ -93,7 +94,12 @@ func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta inte
 
 	_, err := conn.RegisterDomainWithContext(ctx, input)
 
-	if err != nil {
+	// A deprecated domain cannot be registered again, only undeprecated.
+	if tfawserr.ErrCodeEquals(err, swf.ErrCodeDomainAlreadyExistsFault) {
+		if err := undeprecateDomain(ctx, conn, d, name, tags); err != nil {
+			return diag.Errorf("creating SWF Domain (%s): %s", name, err)
+		}
+	} else if err != nil {
 		return diag.Errorf("creating SWF Domain (%s): %s", name, err)
 	}
 
@@ -178,6 +184,55 @@ func resourceDomainDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	return nil
 }
 
+// undeprecateDomain undeprecates the named domain and then applies tags,
+// as UndeprecateDomain cannot set them.
+// If the domain's registered retention period or description differ from the configured ones
+// it is deprecated again and an error is returned.
+func undeprecateDomain(ctx context.Context, conn *swf.SWF, d *schema.ResourceData, name string, tags tftags.KeyValueTags) error {
+	log.Printf("[DEBUG] Undeprecating SWF Domain: %s", name)
+	_, err := conn.UndeprecateDomainWithContext(ctx, &swf.UndeprecateDomainInput{
+		Name: aws.String(name),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindDomainByName(ctx, conn, name)
+
+	if err != nil {
+		return err
+	}
+
+	mismatches := configuredStringMismatches(d, map[string]*string{
+		"description": output.DomainInfo.Description,
+		"workflow_execution_retention_period_in_days": output.Configuration.WorkflowExecutionRetentionPeriodInDays,
+	})
+
+	if len(mismatches) > 0 {
+		_, err := conn.DeprecateDomainWithContext(ctx, &swf.DeprecateDomainInput{
+			Name: aws.String(name),
+		})
+
+		if err != nil {
+			return fmt.Errorf("deprecating: %w", err)
+		}
+
+		return fmt.Errorf("a deprecated domain with this name was registered with different %s; "+
+			"the settings of a registered domain cannot be changed, use a new name instead", strings.Join(mismatches, ", "))
+	}
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	if err := UpdateTagsWithContext(ctx, conn, aws.StringValue(output.DomainInfo.Arn), nil, tags.IgnoreAWS().Map()); err != nil {
+		return fmt.Errorf("updating tags: %w", err)
+	}
+
+	return nil
+}
+
 func FindDomainByName(ctx context.Context, conn *swf.SWF, name string) (*swf.DescribeDomainOutput, error) {
 	input := &swf.DescribeDomainInput{
 		Name: aws.String(name),
@@ -0,0 +1,327 @@
+package swf
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/swf"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceWorkflowType() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWorkflowTypeCreate,
+		ReadWithoutTimeout:   resourceWorkflowTypeRead,
+		DeleteWithoutTimeout: resourceWorkflowTypeDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_child_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(swf.ChildPolicy_Values(), false),
+			},
+			"default_execution_start_to_close_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validTimeout,
+			},
+			"default_lambda_role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"default_task_list": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"default_task_priority": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"default_task_start_to_close_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validTimeout,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringDoesNotContainAny(":/|"),
+				),
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringDoesNotContainAny(":/|"),
+				),
+			},
+		},
+	}
+}
+
+func resourceWorkflowTypeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SWFConn
+
+	domain := d.Get("domain").(string)
+	name := d.Get("name").(string)
+	version := d.Get("version").(string)
+	id := TypeCreateResourceID(domain, name, version)
+	input := &swf.RegisterWorkflowTypeInput{
+		Domain:  aws.String(domain),
+		Name:    aws.String(name),
+		Version: aws.String(version),
+	}
+
+	if v, ok := d.GetOk("default_child_policy"); ok {
+		input.DefaultChildPolicy = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_execution_start_to_close_timeout"); ok {
+		input.DefaultExecutionStartToCloseTimeout = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_lambda_role"); ok {
+		input.DefaultLambdaRole = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_list"); ok {
+		input.DefaultTaskList = &swf.TaskList{
+			Name: aws.String(v.(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("default_task_priority"); ok {
+		input.DefaultTaskPriority = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_start_to_close_timeout"); ok {
+		input.DefaultTaskStartToCloseTimeout = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	_, err := conn.RegisterWorkflowTypeWithContext(ctx, input)
+
+	// A deprecated workflow type cannot be registered again, only undeprecated.
+	if tfawserr.ErrCodeEquals(err, swf.ErrCodeTypeAlreadyExistsFault) {
+		err = undeprecateWorkflowType(ctx, conn, d, domain, name, version)
+	}
+
+	if err != nil {
+		return diag.Errorf("creating SWF Workflow Type (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceWorkflowTypeRead(ctx, d, meta)
+}
+
+func resourceWorkflowTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SWFConn
+
+	domain, name, version, err := TypeParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindWorkflowTypeByThreePartKey(ctx, conn, domain, name, version)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SWF Workflow Type (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading SWF Workflow Type (%s): %s", d.Id(), err)
+	}
+
+	d.Set("creation_date", aws.TimeValue(output.TypeInfo.CreationDate).Format(time.RFC3339))
+	d.Set("default_child_policy", output.Configuration.DefaultChildPolicy)
+	d.Set("default_execution_start_to_close_timeout", output.Configuration.DefaultExecutionStartToCloseTimeout)
+	d.Set("default_lambda_role", output.Configuration.DefaultLambdaRole)
+	if v := output.Configuration.DefaultTaskList; v != nil {
+		d.Set("default_task_list", v.Name)
+	} else {
+		d.Set("default_task_list", nil)
+	}
+	d.Set("default_task_priority", output.Configuration.DefaultTaskPriority)
+	d.Set("default_task_start_to_close_timeout", output.Configuration.DefaultTaskStartToCloseTimeout)
+	d.Set("description", output.TypeInfo.Description)
+	d.Set("domain", domain)
+	d.Set("name", output.TypeInfo.WorkflowType.Name)
+	d.Set("version", output.TypeInfo.WorkflowType.Version)
+
+	return nil
+}
+
+func resourceWorkflowTypeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SWFConn
+
+	domain, name, version, err := TypeParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deprecating SWF Workflow Type: %s", d.Id())
+	_, err = conn.DeprecateWorkflowTypeWithContext(ctx, &swf.DeprecateWorkflowTypeInput{
+		WorkflowType: &swf.WorkflowType{
+			Name:    aws.String(name),
+			Version: aws.String(version),
+		},
+		Domain: aws.String(domain),
+	})
+
+	if tfawserr.ErrCodeEquals(err, swf.ErrCodeTypeDeprecatedFault, swf.ErrCodeUnknownResourceFault) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting SWF Workflow Type (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// undeprecateWorkflowType undeprecates the specified workflow type.
+// If its registered settings differ from the configured ones it is deprecated again and an error is returned.
+func undeprecateWorkflowType(ctx context.Context, conn *swf.SWF, d *schema.ResourceData, domain, name, version string) error {
+	workflowType := &swf.WorkflowType{
+		Name:    aws.String(name),
+		Version: aws.String(version),
+	}
+
+	log.Printf("[DEBUG] Undeprecating SWF Workflow Type: %s", TypeCreateResourceID(domain, name, version))
+	_, err := conn.UndeprecateWorkflowTypeWithContext(ctx, &swf.UndeprecateWorkflowTypeInput{
+		WorkflowType: workflowType,
+		Domain:       aws.String(domain),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindWorkflowTypeByThreePartKey(ctx, conn, domain, name, version)
+
+	if err != nil {
+		return err
+	}
+
+	var defaultTaskList *string
+	if v := output.Configuration.DefaultTaskList; v != nil {
+		defaultTaskList = v.Name
+	}
+
+	mismatches := configuredStringMismatches(d, map[string]*string{
+		"default_child_policy":                     output.Configuration.DefaultChildPolicy,
+		"default_execution_start_to_close_timeout": output.Configuration.DefaultExecutionStartToCloseTimeout,
+		"default_lambda_role":                      output.Configuration.DefaultLambdaRole,
+		"default_task_list":                        defaultTaskList,
+		"default_task_priority":                    output.Configuration.DefaultTaskPriority,
+		"default_task_start_to_close_timeout":      output.Configuration.DefaultTaskStartToCloseTimeout,
+		"description":                              output.TypeInfo.Description,
+	})
+
+	if len(mismatches) == 0 {
+		return nil
+	}
+
+	_, err = conn.DeprecateWorkflowTypeWithContext(ctx, &swf.DeprecateWorkflowTypeInput{
+		WorkflowType: workflowType,
+		Domain:       aws.String(domain),
+	})
+
+	if err != nil {
+		return fmt.Errorf("deprecating: %w", err)
+	}
+
+	return fmt.Errorf("a deprecated workflow type with this name and version was registered with different %s; "+
+		"the settings of a registered type cannot be changed, use a new version instead", strings.Join(mismatches, ", "))
+}
+
+func FindWorkflowTypeByThreePartKey(ctx context.Context, conn *swf.SWF, domain, name, version string) (*swf.DescribeWorkflowTypeOutput, error) {
+	input := &swf.DescribeWorkflowTypeInput{
+		WorkflowType: &swf.WorkflowType{
+			Name:    aws.String(name),
+			Version: aws.String(version),
+		},
+		Domain: aws.String(domain),
+	}
+
+	output, err := conn.DescribeWorkflowTypeWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, swf.ErrCodeUnknownResourceFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Configuration == nil || output.TypeInfo == nil || output.TypeInfo.WorkflowType == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.TypeInfo.Status); status == swf.RegistrationStatusDeprecated {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
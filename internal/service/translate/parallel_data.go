@@ -0,0 +1,420 @@
+package translate
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/translate"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceParallelData() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceParallelDataCreate,
+		ReadWithoutTimeout:   resourceParallelDataRead,
+		UpdateWithoutTimeout: resourceParallelDataUpdate,
+		DeleteWithoutTimeout: resourceParallelDataDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"encryption_key": encryptionKeySchema(),
+			"failed_record_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"imported_record_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"parallel_data_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"format": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(translate.ParallelDataFormat_Values(), false),
+						},
+						"s3_uri": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"skipped_record_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"source_etag": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"source_language_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"target_language_codes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			sourceETagCustomizeDiff("parallel_data_config.0.s3_uri"),
+			verify.SetTagsDiff,
+		),
+	}
+}
+
+func resourceParallelDataCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TranslateConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &translate.CreateParallelDataInput{
+		ClientToken:        aws.String(resource.UniqueId()),
+		Name:               aws.String(name),
+		ParallelDataConfig: expandParallelDataConfig(d.Get("parallel_data_config").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("encryption_key"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.EncryptionKey = expandEncryptionKey(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	etag, err := findS3ObjectETag(ctx, meta.(*conns.AWSClient).S3Conn, aws.StringValue(input.ParallelDataConfig.S3Uri))
+
+	if err != nil {
+		return diag.Errorf("creating Translate Parallel Data (%s): %s", name, err)
+	}
+
+	_, err = conn.CreateParallelDataWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Translate Parallel Data (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+	d.Set("source_etag", etag)
+
+	if _, err := waitParallelDataCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Translate Parallel Data (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceParallelDataRead(ctx, d, meta)
+}
+
+func resourceParallelDataRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TranslateConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	parallelData, err := FindParallelDataByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Translate Parallel Data (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Translate Parallel Data (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(parallelData.Arn)
+	d.Set("arn", arn)
+	d.Set("description", parallelData.Description)
+	if err := d.Set("encryption_key", flattenEncryptionKey(parallelData.EncryptionKey)); err != nil {
+		return diag.Errorf("setting encryption_key: %s", err)
+	}
+	d.Set("failed_record_count", parallelData.FailedRecordCount)
+	d.Set("imported_record_count", parallelData.ImportedRecordCount)
+	d.Set("name", parallelData.Name)
+	if err := d.Set("parallel_data_config", flattenParallelDataConfig(parallelData.ParallelDataConfig)); err != nil {
+		return diag.Errorf("setting parallel_data_config: %s", err)
+	}
+	d.Set("skipped_record_count", parallelData.SkippedRecordCount)
+	d.Set("source_language_code", parallelData.SourceLanguageCode)
+	d.Set("status", parallelData.Status)
+	d.Set("target_language_codes", aws.StringValueSlice(parallelData.TargetLanguageCodes))
+
+	tags, err := ListTagsWithContext(ctx, conn, arn)
+
+	if err != nil {
+		return diag.Errorf("listing tags for Translate Parallel Data (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceParallelDataUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TranslateConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &translate.UpdateParallelDataInput{
+			ClientToken:        aws.String(resource.UniqueId()),
+			Description:        aws.String(d.Get("description").(string)),
+			Name:               aws.String(d.Id()),
+			ParallelDataConfig: expandParallelDataConfig(d.Get("parallel_data_config").([]interface{})),
+		}
+
+		etag, err := findS3ObjectETag(ctx, meta.(*conns.AWSClient).S3Conn, aws.StringValue(input.ParallelDataConfig.S3Uri))
+
+		if err != nil {
+			return diag.Errorf("updating Translate Parallel Data (%s): %s", d.Id(), err)
+		}
+
+		_, err = conn.UpdateParallelDataWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Translate Parallel Data (%s): %s", d.Id(), err)
+		}
+
+		d.Set("source_etag", etag)
+
+		if _, err := waitParallelDataUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for Translate Parallel Data (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating Translate Parallel Data (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceParallelDataRead(ctx, d, meta)
+}
+
+func resourceParallelDataDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TranslateConn
+
+	log.Printf("[DEBUG] Deleting Translate Parallel Data: %s", d.Id())
+	_, err := conn.DeleteParallelDataWithContext(ctx, &translate.DeleteParallelDataInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, translate.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Translate Parallel Data (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitParallelDataDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Translate Parallel Data (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindParallelDataByName(ctx context.Context, conn *translate.Translate, name string) (*translate.ParallelDataProperties, error) {
+	input := &translate.GetParallelDataInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetParallelDataWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, translate.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ParallelDataProperties == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ParallelDataProperties, nil
+}
+
+func statusParallelData(ctx context.Context, conn *translate.Translate, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindParallelDataByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusParallelDataLatestUpdateAttempt(ctx context.Context, conn *translate.Translate, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindParallelDataByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.LatestUpdateAttemptStatus), nil
+	}
+}
+
+func waitParallelDataCreated(ctx context.Context, conn *translate.Translate, name string, timeout time.Duration) (*translate.ParallelDataProperties, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{translate.ParallelDataStatusCreating},
+		Target:  []string{translate.ParallelDataStatusActive},
+		Refresh: statusParallelData(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*translate.ParallelDataProperties); ok {
+		if aws.StringValue(output.Status) == translate.ParallelDataStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitParallelDataUpdated(ctx context.Context, conn *translate.Translate, name string, timeout time.Duration) (*translate.ParallelDataProperties, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{translate.ParallelDataStatusUpdating},
+		Target:  []string{translate.ParallelDataStatusActive},
+		Refresh: statusParallelDataLatestUpdateAttempt(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*translate.ParallelDataProperties); ok {
+		if aws.StringValue(output.LatestUpdateAttemptStatus) == translate.ParallelDataStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitParallelDataDeleted(ctx context.Context, conn *translate.Translate, name string, timeout time.Duration) (*translate.ParallelDataProperties, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{translate.ParallelDataStatusActive, translate.ParallelDataStatusDeleting},
+		Target:  []string{},
+		Refresh: statusParallelData(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*translate.ParallelDataProperties); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandParallelDataConfig(tfList []interface{}) *translate.ParallelDataConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &translate.ParallelDataConfig{
+		Format: aws.String(tfMap["format"].(string)),
+		S3Uri:  aws.String(tfMap["s3_uri"].(string)),
+	}
+}
+
+func flattenParallelDataConfig(apiObject *translate.ParallelDataConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"format": aws.StringValue(apiObject.Format),
+			"s3_uri": aws.StringValue(apiObject.S3Uri),
+		},
+	}
+}
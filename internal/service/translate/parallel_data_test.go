@@ -0,0 +1,158 @@
+package translate_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/translate"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftranslate "github.com/hashicorp/terraform-provider-aws/internal/service/translate"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccTranslateParallelData_basic(t *testing.T) {
+	var v translate.ParallelDataProperties
+	resourceName := "aws_translate_parallel_data.test"
+	objectResourceName := "aws_s3_object.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(translate.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, translate.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParallelDataDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParallelDataConfig_basic(rName, "Bonjour"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParallelDataExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "translate", regexp.MustCompile(`parallel-data/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "parallel_data_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parallel_data_config.0.format", translate.ParallelDataFormatTsv),
+					resource.TestCheckResourceAttrPair(resourceName, "source_etag", objectResourceName, "etag"),
+					resource.TestCheckResourceAttr(resourceName, "source_language_code", "en"),
+					resource.TestCheckResourceAttr(resourceName, "status", translate.ParallelDataStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source_etag"},
+			},
+			{
+				Config: testAccParallelDataConfig_basic(rName, "Salut"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParallelDataExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "source_etag", objectResourceName, "etag"),
+					resource.TestCheckResourceAttr(resourceName, "status", translate.ParallelDataStatusActive),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTranslateParallelData_disappears(t *testing.T) {
+	var v translate.ParallelDataProperties
+	resourceName := "aws_translate_parallel_data.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(translate.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, translate.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParallelDataDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParallelDataConfig_basic(rName, "Bonjour"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParallelDataExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tftranslate.ResourceParallelData(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckParallelDataDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).TranslateConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_translate_parallel_data" {
+			continue
+		}
+
+		_, err := tftranslate.FindParallelDataByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Translate Parallel Data %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckParallelDataExists(n string, v *translate.ParallelDataProperties) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Translate Parallel Data ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TranslateConn
+
+		output, err := tftranslate.FindParallelDataByName(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccParallelDataConfig_basic(rName, term string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "parallel-data.tsv"
+  content = "en\tfr\nHello\t%[2]s\n"
+}
+
+resource "aws_translate_parallel_data" "test" {
+  name = %[1]q
+
+  parallel_data_config {
+    format = "TSV"
+    s3_uri = "s3://${aws_s3_object.test.bucket}/${aws_s3_object.test.key}"
+  }
+
+  source_etag = aws_s3_object.test.etag
+}
+`, rName, term)
+}
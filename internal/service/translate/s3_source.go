@@ -0,0 +1,100 @@
+package translate
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// parseS3URI splits an "s3://bucket/key" URI into its bucket and key.
+func parseS3URI(uri string) (string, string, error) {
+	parts := strings.SplitN(strings.TrimPrefix(uri, "s3://"), "/", 2)
+
+	if !strings.HasPrefix(uri, "s3://") || len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format for S3 URI (%s), expected s3://BUCKET/KEY", uri)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func findS3ObjectETag(ctx context.Context, conn *s3.S3, uri string) (string, error) {
+	bucket, key, err := parseS3URI(uri)
+
+	if err != nil {
+		return "", err
+	}
+
+	output, err := conn.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("reading S3 object (%s): %w", uri, err)
+	}
+
+	return strings.Trim(aws.StringValue(output.ETag), `"`), nil
+}
+
+func readS3Object(ctx context.Context, conn *s3.S3, uri string) ([]byte, string, error) {
+	bucket, key, err := parseS3URI(uri)
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	output, err := conn.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+
+	if err != nil {
+		return nil, "", fmt.Errorf("reading S3 object (%s): %w", uri, err)
+	}
+
+	defer output.Body.Close()
+
+	body, err := io.ReadAll(output.Body)
+
+	if err != nil {
+		return nil, "", fmt.Errorf("reading S3 object (%s): %w", uri, err)
+	}
+
+	return body, strings.Trim(aws.StringValue(output.ETag), `"`), nil
+}
+
+// sourceETagCustomizeDiff plans an update when the S3 object at uriKey no longer
+// matches the ETag recorded in the source_etag attribute when the data was last imported.
+// A configured source_etag (e.g. from an aws_s3_object resource) takes precedence.
+func sourceETagCustomizeDiff(uriKey string) schema.CustomizeDiffFunc {
+	return customdiff.IfValue(uriKey,
+		func(_ context.Context, value, meta interface{}) bool {
+			return value.(string) != ""
+		},
+		func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			if diff.Id() == "" || diff.HasChange(uriKey) || !diff.GetRawConfig().GetAttr("source_etag").IsNull() {
+				return nil
+			}
+
+			conn := meta.(*conns.AWSClient).S3Conn
+			etag, err := findS3ObjectETag(ctx, conn, diff.Get(uriKey).(string))
+
+			if err != nil {
+				return err
+			}
+
+			if etag != diff.Get("source_etag").(string) {
+				return diff.SetNew("source_etag", etag)
+			}
+
+			return nil
+		},
+	)
+}
@@ -0,0 +1,391 @@
+package translate
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/translate"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTerminology() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTerminologyCreate,
+		ReadWithoutTimeout:   resourceTerminologyRead,
+		UpdateWithoutTimeout: resourceTerminologyUpdate,
+		DeleteWithoutTimeout: resourceTerminologyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"encryption_key": encryptionKeySchema(),
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"size_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"source_etag": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"source_language_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"target_language_codes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"term_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"terminology_data": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"content": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ExactlyOneOf: []string{"terminology_data.0.content", "terminology_data.0.s3_uri"},
+						},
+						"directionality": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(translate.Directionality_Values(), false),
+						},
+						"format": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(translate.TerminologyDataFormat_Values(), false),
+						},
+						"s3_uri": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"terminology_data.0.content", "terminology_data.0.s3_uri"},
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			sourceETagCustomizeDiff("terminology_data.0.s3_uri"),
+			verify.SetTagsDiff,
+		),
+	}
+}
+
+func resourceTerminologyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TranslateConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &translate.ImportTerminologyInput{
+		MergeStrategy: aws.String(translate.MergeStrategyOverwrite),
+		Name:          aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("encryption_key"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.EncryptionKey = expandEncryptionKey(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	terminologyData, etag, err := expandTerminologyData(ctx, meta, d.Get("terminology_data").([]interface{}))
+
+	if err != nil {
+		return diag.Errorf("creating Translate Terminology (%s): %s", name, err)
+	}
+
+	input.TerminologyData = terminologyData
+
+	_, err = conn.ImportTerminologyWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Translate Terminology (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+	d.Set("source_etag", etag)
+
+	return resourceTerminologyRead(ctx, d, meta)
+}
+
+func resourceTerminologyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TranslateConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	terminology, err := FindTerminologyByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Translate Terminology (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Translate Terminology (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(terminology.Arn)
+	d.Set("arn", arn)
+	d.Set("description", terminology.Description)
+	if err := d.Set("encryption_key", flattenEncryptionKey(terminology.EncryptionKey)); err != nil {
+		return diag.Errorf("setting encryption_key: %s", err)
+	}
+	d.Set("name", terminology.Name)
+	d.Set("size_bytes", terminology.SizeBytes)
+	d.Set("source_language_code", terminology.SourceLanguageCode)
+	d.Set("target_language_codes", aws.StringValueSlice(terminology.TargetLanguageCodes))
+	d.Set("term_count", terminology.TermCount)
+
+	// The terminology file itself can't be read back, so only the format and directionality are refreshed.
+	terminologyData := map[string]interface{}{
+		"directionality": aws.StringValue(terminology.Directionality),
+		"format":         aws.StringValue(terminology.Format),
+	}
+	if v, ok := d.GetOk("terminology_data"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		terminologyData["content"] = tfMap["content"]
+		terminologyData["s3_uri"] = tfMap["s3_uri"]
+	}
+	if err := d.Set("terminology_data", []interface{}{terminologyData}); err != nil {
+		return diag.Errorf("setting terminology_data: %s", err)
+	}
+
+	tags, err := ListTagsWithContext(ctx, conn, arn)
+
+	if err != nil {
+		return diag.Errorf("listing tags for Translate Terminology (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceTerminologyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TranslateConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &translate.ImportTerminologyInput{
+			Description:   aws.String(d.Get("description").(string)),
+			MergeStrategy: aws.String(translate.MergeStrategyOverwrite),
+			Name:          aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("encryption_key"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.EncryptionKey = expandEncryptionKey(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		terminologyData, etag, err := expandTerminologyData(ctx, meta, d.Get("terminology_data").([]interface{}))
+
+		if err != nil {
+			return diag.Errorf("updating Translate Terminology (%s): %s", d.Id(), err)
+		}
+
+		input.TerminologyData = terminologyData
+
+		_, err = conn.ImportTerminologyWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Translate Terminology (%s): %s", d.Id(), err)
+		}
+
+		d.Set("source_etag", etag)
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating Translate Terminology (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceTerminologyRead(ctx, d, meta)
+}
+
+func resourceTerminologyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TranslateConn
+
+	log.Printf("[DEBUG] Deleting Translate Terminology: %s", d.Id())
+	_, err := conn.DeleteTerminologyWithContext(ctx, &translate.DeleteTerminologyInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, translate.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Translate Terminology (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindTerminologyByName(ctx context.Context, conn *translate.Translate, name string) (*translate.TerminologyProperties, error) {
+	input := &translate.GetTerminologyInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetTerminologyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, translate.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.TerminologyProperties == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.TerminologyProperties, nil
+}
+
+// expandTerminologyData returns the terminology data and, if sourced from S3, the source object's ETag.
+func expandTerminologyData(ctx context.Context, meta interface{}, tfList []interface{}) (*translate.TerminologyData, string, error) {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil, "", nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &translate.TerminologyData{
+		Format: aws.String(tfMap["format"].(string)),
+	}
+
+	if v, ok := tfMap["directionality"].(string); ok && v != "" {
+		apiObject.Directionality = aws.String(v)
+	}
+
+	var etag string
+
+	if v, ok := tfMap["s3_uri"].(string); ok && v != "" {
+		body, v, err := readS3Object(ctx, meta.(*conns.AWSClient).S3Conn, v)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		apiObject.File = body
+		etag = v
+	} else {
+		apiObject.File = []byte(tfMap["content"].(string))
+	}
+
+	return apiObject, etag, nil
+}
+
+func encryptionKeySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					Default:      translate.EncryptionKeyTypeKms,
+					ValidateFunc: validation.StringInSlice(translate.EncryptionKeyType_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func expandEncryptionKey(tfMap map[string]interface{}) *translate.EncryptionKey {
+	if tfMap == nil {
+		return nil
+	}
+
+	return &translate.EncryptionKey{
+		Id:   aws.String(tfMap["id"].(string)),
+		Type: aws.String(tfMap["type"].(string)),
+	}
+}
+
+func flattenEncryptionKey(apiObject *translate.EncryptionKey) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"id":   aws.StringValue(apiObject.Id),
+			"type": aws.StringValue(apiObject.Type),
+		},
+	}
+}
+
+var validName = validation.All(
+	validation.StringLenBetween(1, 256),
+	validation.StringMatch(regexp.MustCompile(`^([A-Za-z0-9-]_?)+$`), "must contain only alphanumeric characters, hyphens and single underscores"),
+)
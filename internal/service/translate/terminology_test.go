@@ -0,0 +1,199 @@
+package translate_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/translate"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftranslate "github.com/hashicorp/terraform-provider-aws/internal/service/translate"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccTranslateTerminology_basic(t *testing.T) {
+	var v translate.TerminologyProperties
+	resourceName := "aws_translate_terminology.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(translate.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, translate.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTerminologyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTerminologyConfig_content(rName, "Bonjour"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTerminologyExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "translate", regexp.MustCompile(`terminology/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "source_etag", ""),
+					resource.TestCheckResourceAttr(resourceName, "source_language_code", "en"),
+					resource.TestCheckResourceAttr(resourceName, "target_language_codes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_language_codes.0", "fr"),
+					resource.TestCheckResourceAttr(resourceName, "term_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "terminology_data.0.format", translate.TerminologyDataFormatCsv),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"terminology_data.0.content"},
+			},
+			{
+				Config: testAccTerminologyConfig_content(rName, "Salut"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTerminologyExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "term_count", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTranslateTerminology_s3(t *testing.T) {
+	var v translate.TerminologyProperties
+	resourceName := "aws_translate_terminology.test"
+	objectResourceName := "aws_s3_object.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(translate.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, translate.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTerminologyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTerminologyConfig_s3(rName, "Bonjour"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTerminologyExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "source_etag", objectResourceName, "etag"),
+				),
+			},
+			{
+				Config: testAccTerminologyConfig_s3(rName, "Salut"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTerminologyExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "source_etag", objectResourceName, "etag"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTranslateTerminology_disappears(t *testing.T) {
+	var v translate.TerminologyProperties
+	resourceName := "aws_translate_terminology.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(translate.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, translate.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTerminologyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTerminologyConfig_content(rName, "Bonjour"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTerminologyExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tftranslate.ResourceTerminology(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTerminologyDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).TranslateConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_translate_terminology" {
+			continue
+		}
+
+		_, err := tftranslate.FindTerminologyByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Translate Terminology %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckTerminologyExists(n string, v *translate.TerminologyProperties) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Translate Terminology ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TranslateConn
+
+		output, err := tftranslate.FindTerminologyByName(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccTerminologyConfig_content(rName, term string) string {
+	return fmt.Sprintf(`
+resource "aws_translate_terminology" "test" {
+  name = %[1]q
+
+  terminology_data {
+    format  = "CSV"
+    content = "en,fr\nHello,%[2]s\n"
+  }
+}
+`, rName, term)
+}
+
+func testAccTerminologyConfig_s3(rName, term string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "terminology.csv"
+  content = "en,fr\nHello,%[2]s\n"
+}
+
+resource "aws_translate_terminology" "test" {
+  name = %[1]q
+
+  terminology_data {
+    format = "CSV"
+    s3_uri = "s3://${aws_s3_object.test.bucket}/${aws_s3_object.test.key}"
+  }
+
+  source_etag = aws_s3_object.test.etag
+}
+`, rName, term)
+}
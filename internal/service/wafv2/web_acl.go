@@ -2,6 +2,7 @@ package wafv2
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"regexp"
@@ -9,11 +10,14 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
 	"github.com/aws/aws-sdk-go/service/wafv2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -51,6 +55,10 @@ func ResourceWebACL() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"application_integration_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -90,8 +98,9 @@ func ResourceWebACL() *schema.Resource {
 				),
 			},
 			"rule": {
-				Type:     schema.TypeSet,
-				Optional: true,
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"rules_json"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"action": {
@@ -133,6 +142,17 @@ func ResourceWebACL() *schema.Resource {
 					},
 				},
 			},
+			"rules_json": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"rule"},
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
 			"scope": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -144,7 +164,10 @@ func ResourceWebACL() *schema.Resource {
 			"visibility_config": visibilityConfigSchema(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceWebACLCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -154,10 +177,16 @@ func resourceWebACLCreate(ctx context.Context, d *schema.ResourceData, meta inte
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
 	name := d.Get("name").(string)
+	rules, err := expandWebACLRulesFromResourceData(d)
+
+	if err != nil {
+		return diag.Errorf("creating WAFv2 WebACL (%s): %s", name, err)
+	}
+
 	input := &wafv2.CreateWebACLInput{
 		DefaultAction:    expandDefaultAction(d.Get("default_action").([]interface{})),
 		Name:             aws.String(name),
-		Rules:            expandWebACLRules(d.Get("rule").(*schema.Set).List()),
+		Rules:            rules,
 		Scope:            aws.String(d.Get("scope").(string)),
 		VisibilityConfig: expandVisibilityConfig(d.Get("visibility_config").([]interface{})),
 	}
@@ -209,6 +238,7 @@ func resourceWebACLRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 	webACL := output.WebACL
 	arn := aws.StringValue(webACL.ARN)
+	d.Set("application_integration_url", output.ApplicationIntegrationURL)
 	d.Set("arn", arn)
 	d.Set("capacity", webACL.Capacity)
 	if err := d.Set("custom_response_body", flattenCustomResponseBodies(webACL.CustomResponseBodies)); err != nil {
@@ -220,8 +250,20 @@ func resourceWebACLRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set("description", webACL.Description)
 	d.Set("lock_token", output.LockToken)
 	d.Set("name", webACL.Name)
-	if err := d.Set("rule", flattenWebACLRules(webACL.Rules)); err != nil {
-		return diag.Errorf("setting rule: %s", err)
+	if _, ok := d.GetOk("rules_json"); ok {
+		rulesJSON, err := jsonutil.BuildJSON(webACL.Rules)
+
+		if err != nil {
+			return diag.Errorf("setting rules_json: %s", err)
+		}
+
+		d.Set("rule", nil)
+		d.Set("rules_json", string(rulesJSON))
+	} else {
+		if err := d.Set("rule", flattenWebACLRules(webACL.Rules)); err != nil {
+			return diag.Errorf("setting rule: %s", err)
+		}
+		d.Set("rules_json", nil)
 	}
 	if err := d.Set("visibility_config", flattenVisibilityConfig(webACL.VisibilityConfig)); err != nil {
 		return diag.Errorf("setting visibility_config: %s", err)
@@ -251,12 +293,18 @@ func resourceWebACLUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 	conn := meta.(*conns.AWSClient).WAFV2Conn
 
 	if d.HasChangesExcept("tags", "tags_all") {
+		rules, err := expandWebACLRulesFromResourceData(d)
+
+		if err != nil {
+			return diag.Errorf("updating WAFv2 WebACL (%s): %s", d.Id(), err)
+		}
+
 		input := &wafv2.UpdateWebACLInput{
 			DefaultAction:    expandDefaultAction(d.Get("default_action").([]interface{})),
 			Id:               aws.String(d.Id()),
 			LockToken:        aws.String(d.Get("lock_token").(string)),
 			Name:             aws.String(d.Get("name").(string)),
-			Rules:            expandWebACLRules(d.Get("rule").(*schema.Set).List()),
+			Rules:            rules,
 			Scope:            aws.String(d.Get("scope").(string)),
 			VisibilityConfig: expandVisibilityConfig(d.Get("visibility_config").([]interface{})),
 		}
@@ -270,7 +318,7 @@ func resourceWebACLUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 
 		log.Printf("[INFO] Updating WAFv2 WebACL: %s", input)
-		_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, webACLUpdateTimeout, func() (interface{}, error) {
+		_, err = tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, webACLUpdateTimeout, func() (interface{}, error) {
 			return conn.UpdateWebACLWithContext(ctx, input)
 		}, wafv2.ErrCodeWAFUnavailableEntityException)
 
@@ -321,6 +369,63 @@ func resourceWebACLDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	return nil
 }
 
+func resourceWebACLCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Only rules supplied as JSON are checked, as rules in HCL commonly reference
+	// resources (e.g. IP sets) that are not known until apply.
+	if !diff.HasChange("rules_json") || !diff.NewValueKnown("rules_json") {
+		return nil
+	}
+
+	v, ok := diff.GetOk("rules_json")
+
+	if !ok {
+		return nil
+	}
+
+	rules, err := expandWebACLRulesJSON(v.(string))
+
+	if err != nil {
+		return err
+	}
+
+	conn := meta.(*conns.AWSClient).WAFV2Conn
+
+	output, err := conn.CheckCapacityWithContext(ctx, &wafv2.CheckCapacityInput{
+		Rules: rules,
+		Scope: aws.String(diff.Get("scope").(string)),
+	})
+
+	if err != nil {
+		return fmt.Errorf("checking WAFv2 WebACL rules capacity: %w", err)
+	}
+
+	return diff.SetNew("capacity", aws.Int64Value(output.Capacity))
+}
+
+func expandWebACLRulesFromResourceData(d *schema.ResourceData) ([]*wafv2.Rule, error) {
+	if v, ok := d.GetOk("rules_json"); ok {
+		return expandWebACLRulesJSON(v.(string))
+	}
+
+	return expandWebACLRules(d.Get("rule").(*schema.Set).List()), nil
+}
+
+func expandWebACLRulesJSON(rawRules string) ([]*wafv2.Rule, error) {
+	var rules []*wafv2.Rule
+
+	if err := json.Unmarshal([]byte(rawRules), &rules); err != nil {
+		return nil, fmt.Errorf("decoding rules_json: %w", err)
+	}
+
+	for i, v := range rules {
+		if v == nil {
+			return nil, fmt.Errorf("invalid rule supplied in rules_json at index (%d)", i)
+		}
+	}
+
+	return rules, nil
+}
+
 func FindWebACLByThreePartKey(ctx context.Context, conn *wafv2.WAFV2, id, name, scope string) (*wafv2.GetWebACLOutput, error) {
 	input := &wafv2.GetWebACLInput{
 		Id:    aws.String(id),
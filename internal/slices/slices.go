@@ -1,6 +1,10 @@
 package slices
 
-import "golang.org/x/exp/slices"
+import (
+	"fmt"
+
+	"golang.org/x/exp/slices"
+)
 
 // Reverse returns a reversed copy of the slice.
 func Reverse[S ~[]E, E any](s S) S {
@@ -52,3 +56,24 @@ func Filter[T any](s []T, f FilterFunc[T]) []T {
 
 	return slices.Clip(v)
 }
+
+// Chunks returns the consecutive sub-slices of `s`, each of at most `size` elements.
+// Chunks panics if `size` is less than 1.
+func Chunks[S ~[]E, E any](s S, size int) []S {
+	if size < 1 {
+		panic(fmt.Sprintf("slices.Chunks: invalid size %d", size))
+	}
+
+	v := make([]S, 0, (len(s)+size-1)/size)
+
+	for i := 0; i < len(s); i += size {
+		end := i + size
+		if end > len(s) {
+			end = len(s)
+		}
+
+		v = append(v, s[i:end])
+	}
+
+	return v
+}
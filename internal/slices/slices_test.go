@@ -1,6 +1,7 @@
 package slices
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 
@@ -120,3 +121,66 @@ func TestApplyToAll(t *testing.T) {
 		})
 	}
 }
+
+func TestChunks(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input    []string
+		size     int
+		expected [][]string
+	}
+	tests := map[string]testCase{
+		"three elements, size 2": {
+			input:    []string{"one", "two", "3"},
+			size:     2,
+			expected: [][]string{{"one", "two"}, {"3"}},
+		},
+		"two elements, size 2": {
+			input:    []string{"aa", "bb"},
+			size:     2,
+			expected: [][]string{{"aa", "bb"}},
+		},
+		"one element, size 3": {
+			input:    []string{"1"},
+			size:     3,
+			expected: [][]string{{"1"}},
+		},
+		"zero elements": {
+			input:    []string{},
+			size:     2,
+			expected: [][]string{},
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			got := Chunks(test.input, test.size)
+
+			if diff := cmp.Diff(got, test.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestChunksInvalidSize(t *testing.T) {
+	t.Parallel()
+
+	for _, size := range []int{0, -1} {
+		size := size
+
+		t.Run(fmt.Sprintf("size %d", size), func(t *testing.T) {
+			t.Parallel()
+
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected panic")
+				}
+			}()
+
+			Chunks([]string{"one"}, size)
+		})
+	}
+}
@@ -6,6 +6,7 @@ package sweep
 import (
 	"context"
 	"errors"
+	"flag"
 	"fmt"
 	"log"
 	"net"
@@ -28,9 +29,17 @@ import (
 const (
 	ThrottlingRetryTimeout = 10 * time.Minute
 
-	ResourcePrefix = "tf-acc-test"
+	defaultResourcePrefix = "tf-acc-test"
 )
 
+// ResourcePrefix is the name prefix of the resources deleted by sweepers that filter on resource name.
+// It defaults to the prefix used by the acceptance tests and can be changed with the -sweep-prefix flag.
+var ResourcePrefix string
+
+func init() {
+	flag.StringVar(&ResourcePrefix, "sweep-prefix", defaultResourcePrefix, "name prefix of the resources to sweep")
+}
+
 const defaultSweeperAssumeRoleDurationSeconds = 3600
 
 // SweeperClients is a shared cache of regional conns.AWSClient
@@ -13,6 +13,12 @@ func main() {
 	debugFlag := flag.Bool("debug", false, "Start provider in debug mode.")
 	flag.Parse()
 
+	// The -sweep flag is registered by the Plugin SDK's acceptance testing framework.
+	if f := flag.Lookup("sweep"); f != nil && f.Value.String() != "" {
+		runSweepers()
+		return
+	}
+
 	serverFactory, _, err := provider.ProtoV5ProviderServerFactory(context.Background())
 
 	if err != nil {
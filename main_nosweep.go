@@ -0,0 +1,12 @@
+//go:build !sweep
+// +build !sweep
+
+package main
+
+import (
+	"log"
+)
+
+func runSweepers() {
+	log.Fatal("sweepers are not included in this build, rebuild the provider with -tags=sweep")
+}
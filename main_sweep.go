@@ -0,0 +1,23 @@
+//go:build sweep
+// +build sweep
+
+package main
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+// runSweepers runs the sweepers registered by the service packages, honoring the
+// Plugin SDK's -sweep, -sweep-run and -sweep-allow-failures flags.
+func runSweepers() {
+	sweep.SweeperClients = make(map[string]interface{})
+	resource.TestMain(noTests{})
+}
+
+// noTests satisfies resource.TestMain; only the sweepers are ever run.
+type noTests struct{}
+
+func (noTests) Run() int {
+	return 0
+}